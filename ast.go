@@ -0,0 +1,92 @@
+package jsonlogic2sql
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+	"github.com/h22rana/jsonlogic2sql/internal/optimizer"
+)
+
+// Node is a node in a parsed JSON Logic expression tree.
+// Every node reports its JSONPath in the original rule and can be converted
+// back to JSON Logic with JSONLogic() or json.Marshal.
+type Node = ast.Node
+
+// VarNode is a variable reference: {"var": "name"} or {"var": ["name", default]}.
+type VarNode = ast.VarNode
+
+// LiteralNode is a primitive value: string, number, boolean or null.
+type LiteralNode = ast.LiteralNode
+
+// ArrayNode is an array literal whose elements may be any node.
+type ArrayNode = ast.ArrayNode
+
+// OpNode is an operator application such as {">": [a, b]}.
+type OpNode = ast.OpNode
+
+// Parse parses a JSON Logic string into an AST.
+// Parsing is structural: unknown operators are accepted and only reported when
+// the tree is transpiled.
+//
+// Example:
+//
+//	node, _ := jsonlogic2sql.Parse(`{">": [{"var": "amount"}, 1000]}`)
+//	op := node.(*jsonlogic2sql.OpNode)
+//	// op.Operator == ">", op.Args[0].(*jsonlogic2sql.VarNode).Name == "amount"
+func Parse(jsonLogic string) (Node, error) {
+	var logic interface{}
+	if err := json.Unmarshal([]byte(jsonLogic), &logic); err != nil {
+		return nil, tperrors.NewInvalidJSON(err)
+	}
+	return ast.Parse(logic)
+}
+
+// ParseFromInterface parses a pre-decoded JSON Logic value into an AST.
+func ParseFromInterface(logic interface{}) (Node, error) {
+	return ast.Parse(logic)
+}
+
+// Walk traverses the tree in depth-first pre-order, calling fn for every node.
+// If fn returns false, the children of that node are skipped.
+func Walk(node Node, fn func(Node) bool) {
+	ast.Walk(node, fn)
+}
+
+// Rewrite rebuilds the tree bottom-up, replacing every node with the result of fn
+// after its children have been rewritten. The input tree is not modified.
+func Rewrite(node Node, fn func(Node) Node) Node {
+	return ast.Rewrite(node, fn)
+}
+
+// Optimize returns a simplified copy of the tree, as TranspilerConfig.Optimize
+// applies it before SQL is generated. The input tree is not modified.
+//
+// Example:
+//
+//	node, _ := jsonlogic2sql.Parse(`{"and": [true, {">": [{"var": "amount"}, {"*": [10, 100]}]}]}`)
+//	optimized := jsonlogic2sql.Optimize(node)
+//	// optimized.JSONLogic(): {">": [{"var": "amount"}, 1000]}
+func Optimize(node Node) Node {
+	return optimizer.Optimize(node)
+}
+
+// TranspileNode generates a SQL WHERE clause from an AST. The tree is converted
+// back to JSON Logic with JSONLogic() and transpiled like Transpile, so it
+// behaves exactly as the equivalent rule string.
+func (t *Transpiler) TranspileNode(node Node) (string, error) {
+	if node == nil {
+		return "", fmt.Errorf("node cannot be nil")
+	}
+	return t.formatted(t.parser.Parse(node.JSONLogic()))
+}
+
+// TranspileConditionNode generates a SQL condition without the WHERE keyword from
+// an AST, transpiling the tree's JSON Logic form like TranspileCondition.
+func (t *Transpiler) TranspileConditionNode(node Node) (string, error) {
+	if node == nil {
+		return "", fmt.Errorf("node cannot be nil")
+	}
+	return t.formatted(t.parser.ParseCondition(node.JSONLogic()))
+}
@@ -0,0 +1,208 @@
+package jsonlogic2sql
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	node, err := Parse(`{">": [{"var": "amount"}, 1000]}`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	op, ok := node.(*OpNode)
+	if !ok || op.Operator != ">" {
+		t.Fatalf("Parse() = %#v, want > OpNode", node)
+	}
+	if v, ok := op.Args[0].(*VarNode); !ok || v.Name != "amount" {
+		t.Errorf("Args[0] = %#v, want VarNode amount", op.Args[0])
+	}
+
+	_, err = Parse(`{invalid`)
+	var tpErr *TranspileError
+	if !errors.As(err, &tpErr) || tpErr.Code != ErrInvalidJSON {
+		t.Errorf("Parse(invalid) error = %v, want %s", err, ErrInvalidJSON)
+	}
+}
+
+// TestTranspileNode_MatchesTranspile checks that generating SQL from the AST
+// produces exactly the same output as transpiling the original rule.
+func TestTranspileNode_MatchesTranspile(t *testing.T) {
+	rules := []string{
+		`{"==": [{"var": "status"}, "active"]}`,
+		`{"and": [{">": [{"var": "amount"}, 1000]}, {"<": [{"var": "age"}, 65]}]}`,
+		`{"!": {"var": "deleted"}}`,
+		`{"!!": [{"var": "email"}]}`,
+		`{"missing": "email"}`,
+		`{"missing_some": [1, ["a", "b"]]}`,
+		`{"in": [{"var": "country"}, ["US", "CA"]]}`,
+		`{"in": ["admin", {"var": "roles"}]}`,
+		`{"==": [{"var": ["tier", "basic"]}, "gold"]}`,
+		`{"if": [{">": [{"var": "x"}, 1]}, "big", "small"]}`,
+		`{">": [{"+": [{"var": "a"}, {"*": [{"var": "b"}, 2]}]}, 10]}`,
+		`{"==": [{"cat": ["a", {"var": "b"}]}, "ab"]}`,
+		`{"==": [{"substr": [{"var": "code"}, 0, 2]}, "US"]}`,
+		`{"some": [{"var": "items"}, {">": [{"var": "item.price"}, 10]}]}`,
+		`{"all": [{"var": "scores"}, {">=": [{"var": ""}, 50]}]}`,
+		`{">": [{"reduce": [{"var": "xs"}, {"+": [{"var": "accumulator"}, {"var": "current"}]}, 0]}, 10]}`,
+		`{"==": [{"merge": [{"var": "a"}, {"var": "b"}]}, []]}`,
+	}
+
+	for _, d := range []Dialect{DialectBigQuery, DialectSpanner, DialectPostgreSQL, DialectDuckDB, DialectClickHouse} {
+		tr, err := NewTranspiler(d)
+		if err != nil {
+			t.Fatalf("NewTranspiler() error = %v", err)
+		}
+		for _, rule := range rules {
+			t.Run(d.String()+"/"+rule, func(t *testing.T) {
+				want, wantErr := tr.Transpile(rule)
+				node, err := Parse(rule)
+				if err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+				got, gotErr := tr.TranspileNode(node)
+				if (wantErr == nil) != (gotErr == nil) {
+					t.Fatalf("TranspileNode() error = %v, Transpile() error = %v", gotErr, wantErr)
+				}
+				if got != want {
+					t.Errorf("TranspileNode() = %s, Transpile() = %s", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestTranspileNode_Rewrite(t *testing.T) {
+	tr, err := NewTranspiler(DialectPostgreSQL)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error = %v", err)
+	}
+
+	node, err := Parse(`{"and": [{"==": [{"var": "legacy_status"}, "on"]}, {">": [{"var": "amount"}, 10]}]}`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	// Rename a field and tighten a threshold without touching any strings
+	rewritten := Rewrite(node, func(n Node) Node {
+		switch v := n.(type) {
+		case *VarNode:
+			if v.Name == "legacy_status" {
+				v.Name = "status"
+			}
+		case *OpNode:
+			if v.Operator == ">" {
+				v.Args[1] = &LiteralNode{Value: 100}
+			}
+		}
+		return n
+	})
+
+	sql, err := tr.TranspileNode(rewritten)
+	if err != nil {
+		t.Fatalf("TranspileNode() error = %v", err)
+	}
+	if expected := "WHERE (status = 'on' AND amount > 100)"; sql != expected {
+		t.Errorf("TranspileNode() = %s, want %s", sql, expected)
+	}
+
+	condition, err := tr.TranspileConditionNode(rewritten)
+	if err != nil {
+		t.Fatalf("TranspileConditionNode() error = %v", err)
+	}
+	if expected := "(status = 'on' AND amount > 100)"; condition != expected {
+		t.Errorf("TranspileConditionNode() = %s, want %s", condition, expected)
+	}
+
+	encoded, err := json.Marshal(rewritten)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	// Re-emitted JSON Logic transpiles to the same SQL
+	roundTrip, err := tr.Transpile(string(encoded))
+	if err != nil {
+		t.Fatalf("Transpile(%s) error = %v", encoded, err)
+	}
+	if roundTrip != sql {
+		t.Errorf("Transpile(json.Marshal()) = %s, want %s", roundTrip, sql)
+	}
+}
+
+func TestTranspileNode_CustomOperatorAndWalk(t *testing.T) {
+	tr, err := NewTranspiler(DialectBigQuery)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error = %v", err)
+	}
+	if err := tr.RegisterOperatorFunc("startsWith", func(op string, args []interface{}) (string, error) {
+		return args[0].(string) + " LIKE " + args[1].(string), nil
+	}); err != nil {
+		t.Fatalf("RegisterOperatorFunc() error = %v", err)
+	}
+
+	node, err := Parse(`{"and": [{"startsWith": [{"var": "name"}, "A%"]}, {"==": [{"var": "active"}, true]}]}`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var fields []string
+	Walk(node, func(n Node) bool {
+		if v, ok := n.(*VarNode); ok {
+			fields = append(fields, v.Name)
+		}
+		return true
+	})
+	if len(fields) != 2 || fields[0] != "name" || fields[1] != "active" {
+		t.Errorf("Walk() fields = %v, want [name active]", fields)
+	}
+
+	sql, err := tr.TranspileNode(node)
+	if err != nil {
+		t.Fatalf("TranspileNode() error = %v", err)
+	}
+	if expected := "WHERE (name LIKE 'A%' AND active = TRUE)"; sql != expected {
+		t.Errorf("TranspileNode() = %s, want %s", sql, expected)
+	}
+}
+
+func TestTranspileNode_Errors(t *testing.T) {
+	tr, err := NewTranspiler(DialectBigQuery)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error = %v", err)
+	}
+	if _, err := tr.TranspileNode(nil); err == nil {
+		t.Error("TranspileNode(nil) expected error")
+	}
+	if _, err := tr.TranspileConditionNode(nil); err == nil {
+		t.Error("TranspileConditionNode(nil) expected error")
+	}
+
+	node, err := Parse(`{"unknownOp": [1]}`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	_, err = tr.TranspileNode(node)
+	var tpErr *TranspileError
+	if !errors.As(err, &tpErr) {
+		t.Errorf("TranspileNode() error = %v, want TranspileError", err)
+	}
+}
+
+func TestOptimize(t *testing.T) {
+	node, err := Parse(`{"and": [true, {">": [{"var": "amount"}, {"*": [10, 100]}]}]}`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	optimized := Optimize(node)
+	op, ok := optimized.(*OpNode)
+	if !ok || op.Operator != ">" {
+		t.Fatalf("Optimize() = %#v, want > OpNode", optimized)
+	}
+	if lit, ok := op.Args[1].(*LiteralNode); !ok || lit.Value != float64(1000) {
+		t.Errorf("Args[1] = %#v, want LiteralNode 1000", op.Args[1])
+	}
+	if node.(*OpNode).Operator != "and" {
+		t.Errorf("Optimize() modified its input")
+	}
+}
@@ -92,6 +92,13 @@ var dialects = []struct {
 	{jsonlogic2sql.DialectPostgreSQL, "PostgreSQL"},
 	{jsonlogic2sql.DialectDuckDB, "DuckDB"},
 	{jsonlogic2sql.DialectClickHouse, "ClickHouse"},
+	{jsonlogic2sql.DialectMySQL, "MySQL"},
+	{jsonlogic2sql.DialectSQLite, "SQLite"},
+	{jsonlogic2sql.DialectSnowflake, "Snowflake"},
+	{jsonlogic2sql.DialectSQLServer, "SQLServer"},
+	{jsonlogic2sql.DialectTrino, "Trino"},
+	{jsonlogic2sql.DialectOracle, "Oracle"},
+	{jsonlogic2sql.DialectSparkSQL, "SparkSQL"},
 }
 
 // currentDialect holds the currently selected dialect.
@@ -103,7 +110,7 @@ func selectDialect(scanner *bufio.Scanner) jsonlogic2sql.Dialect {
 	for i, d := range dialects {
 		fmt.Printf("  %d. %s\n", i+1, d.name)
 	}
-	fmt.Print("\nEnter choice [1-5] (default: 1 for BigQuery): ")
+	fmt.Printf("\nEnter choice [1-%d] (default: 1 for BigQuery): ", len(dialects))
 
 	if !scanner.Scan() {
 		return jsonlogic2sql.DialectBigQuery
@@ -416,7 +423,7 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 	// Dialect-Aware Custom Operators
 	// ========================================================================
 	// These operators demonstrate how to register operators that generate
-	// different SQL based on the target dialect (BigQuery, Spanner, PostgreSQL, DuckDB, or ClickHouse).
+	// different SQL based on the target dialect (BigQuery, Spanner, PostgreSQL, DuckDB, ClickHouse, MySQL, SQLite, Snowflake, SQLServer, Trino, Oracle, or SparkSQL).
 
 	// currentTimestamp operator returns the current timestamp.
 	// BigQuery: CURRENT_TIMESTAMP()
@@ -424,6 +431,13 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 	// PostgreSQL: CURRENT_TIMESTAMP
 	// DuckDB: CURRENT_TIMESTAMP
 	// ClickHouse: now()
+	// MySQL: CURRENT_TIMESTAMP
+	// SQLite: CURRENT_TIMESTAMP
+	// Snowflake: CURRENT_TIMESTAMP()
+	// SQLServer: CURRENT_TIMESTAMP
+	// Trino: CURRENT_TIMESTAMP
+	// Oracle: CURRENT_TIMESTAMP
+	// SparkSQL: CURRENT_TIMESTAMP
 	// Example: {"==": [{"currentTimestamp": []}, {"var": "created_at"}]}
 	_ = transpiler.RegisterDialectAwareOperatorFunc("currentTimestamp",
 		func(_ string, args []any, dialect jsonlogic2sql.Dialect) (string, error) {
@@ -431,9 +445,11 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 				return "", fmt.Errorf("currentTimestamp takes no arguments")
 			}
 			switch dialect {
-			case jsonlogic2sql.DialectBigQuery, jsonlogic2sql.DialectSpanner:
+			case jsonlogic2sql.DialectBigQuery, jsonlogic2sql.DialectSpanner, jsonlogic2sql.DialectSnowflake:
 				return "CURRENT_TIMESTAMP()", nil
-			case jsonlogic2sql.DialectPostgreSQL, jsonlogic2sql.DialectDuckDB:
+			case jsonlogic2sql.DialectPostgreSQL, jsonlogic2sql.DialectDuckDB, jsonlogic2sql.DialectMySQL,
+				jsonlogic2sql.DialectSQLite, jsonlogic2sql.DialectSQLServer, jsonlogic2sql.DialectTrino, jsonlogic2sql.DialectOracle,
+				jsonlogic2sql.DialectSparkSQL:
 				return "CURRENT_TIMESTAMP", nil
 			case jsonlogic2sql.DialectClickHouse:
 				return "now()", nil
@@ -448,6 +464,13 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 	// PostgreSQL: (date1 - date2) -- returns integer days
 	// DuckDB: date_diff('day', date2, date1) -- note: part first, then dates
 	// ClickHouse: dateDiff('day', date2, date1) -- same as DuckDB
+	// MySQL: DATEDIFF(date1, date2)
+	// SQLite: CAST(julianday(date1) - julianday(date2) AS INTEGER)
+	// Snowflake: DATEDIFF('day', date2, date1)
+	// SQLServer: DATEDIFF(day, date2, date1)
+	// Trino: date_diff('day', date2, date1)
+	// Oracle: (TRUNC(date1) - TRUNC(date2)) -- subtracting dates returns days
+	// SparkSQL: datediff(date1, date2)
 	// Example: {">": [{"dateDiff": [{"var": "end_date"}, {"var": "start_date"}]}, 30]}
 	_ = transpiler.RegisterDialectAwareOperatorFunc("dateDiff",
 		func(_ string, args []any, dialect jsonlogic2sql.Dialect) (string, error) {
@@ -465,6 +488,20 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 			case jsonlogic2sql.DialectDuckDB, jsonlogic2sql.DialectClickHouse:
 				// DuckDB/ClickHouse: dateDiff('part', start, end) - note different argument order
 				return fmt.Sprintf("dateDiff('day', %s, %s)", date2, date1), nil
+			case jsonlogic2sql.DialectMySQL:
+				return fmt.Sprintf("DATEDIFF(%s, %s)", date1, date2), nil
+			case jsonlogic2sql.DialectSQLite:
+				return fmt.Sprintf("CAST(julianday(%s) - julianday(%s) AS INTEGER)", date1, date2), nil
+			case jsonlogic2sql.DialectSnowflake:
+				return fmt.Sprintf("DATEDIFF('day', %s, %s)", date2, date1), nil
+			case jsonlogic2sql.DialectSQLServer:
+				return fmt.Sprintf("DATEDIFF(day, %s, %s)", date2, date1), nil
+			case jsonlogic2sql.DialectTrino:
+				return fmt.Sprintf("date_diff('day', %s, %s)", date2, date1), nil
+			case jsonlogic2sql.DialectOracle:
+				return fmt.Sprintf("(TRUNC(%s) - TRUNC(%s))", date1, date2), nil
+			case jsonlogic2sql.DialectSparkSQL:
+				return fmt.Sprintf("datediff(%s, %s)", date1, date2), nil
 			default:
 				return "", fmt.Errorf("unsupported dialect: %v", dialect)
 			}
@@ -476,6 +513,13 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 	// PostgreSQL: CARDINALITY(array)
 	// DuckDB: ARRAY_LENGTH(array)
 	// ClickHouse: length(array)
+	// MySQL: JSON_LENGTH(array)
+	// SQLite: json_array_length(array)
+	// Snowflake: ARRAY_SIZE(array)
+	// SQLServer: (SELECT COUNT(*) FROM OPENJSON(array))
+	// Trino: cardinality(array)
+	// Oracle: JSON_VALUE(array, '$.size()' RETURNING NUMBER)
+	// SparkSQL: size(array)
 	// Example: {">": [{"arrayLength": [{"var": "tags"}]}, 0]}
 	_ = transpiler.RegisterDialectAwareOperatorFunc("arrayLength",
 		func(_ string, args []any, dialect jsonlogic2sql.Dialect) (string, error) {
@@ -490,6 +534,20 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 				return fmt.Sprintf("CARDINALITY(%s)", arr), nil
 			case jsonlogic2sql.DialectClickHouse:
 				return fmt.Sprintf("length(%s)", arr), nil
+			case jsonlogic2sql.DialectMySQL:
+				return fmt.Sprintf("JSON_LENGTH(%s)", arr), nil
+			case jsonlogic2sql.DialectSQLite:
+				return fmt.Sprintf("json_array_length(%s)", arr), nil
+			case jsonlogic2sql.DialectSnowflake:
+				return fmt.Sprintf("ARRAY_SIZE(%s)", arr), nil
+			case jsonlogic2sql.DialectSQLServer:
+				return fmt.Sprintf("(SELECT COUNT(*) FROM OPENJSON(%s))", arr), nil
+			case jsonlogic2sql.DialectTrino:
+				return fmt.Sprintf("cardinality(%s)", arr), nil
+			case jsonlogic2sql.DialectOracle:
+				return fmt.Sprintf("JSON_VALUE(%s, '$.size()' RETURNING NUMBER)", arr), nil
+			case jsonlogic2sql.DialectSparkSQL:
+				return fmt.Sprintf("size(%s)", arr), nil
 			default:
 				return "", fmt.Errorf("unsupported dialect: %v", dialect)
 			}
@@ -501,6 +559,13 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 	// PostgreSQL: string ~ pattern
 	// DuckDB: regexp_matches(string, pattern)
 	// ClickHouse: match(string, pattern)
+	// MySQL: REGEXP_LIKE(string, pattern)
+	// SQLite: string REGEXP pattern (needs a regexp() function, e.g. from the REGEXP extension)
+	// Snowflake: REGEXP_LIKE(string, pattern)
+	// SQLServer: not supported (no regular expressions before SQL Server 2025)
+	// Trino: regexp_like(string, pattern)
+	// Oracle: REGEXP_LIKE(string, pattern)
+	// SparkSQL: regexp_like(string, pattern)
 	// Example: {"regexpContains": [{"var": "email"}, "^[a-z]+@example\\.com$"]}
 	_ = transpiler.RegisterDialectAwareOperatorFunc("regexpContains",
 		func(_ string, args []any, dialect jsonlogic2sql.Dialect) (string, error) {
@@ -520,6 +585,12 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 				return fmt.Sprintf("regexp_matches(%s, %s)", str, pattern), nil
 			case jsonlogic2sql.DialectClickHouse:
 				return fmt.Sprintf("match(%s, %s)", str, pattern), nil
+			case jsonlogic2sql.DialectMySQL, jsonlogic2sql.DialectSnowflake, jsonlogic2sql.DialectOracle:
+				return fmt.Sprintf("REGEXP_LIKE(%s, %s)", str, pattern), nil
+			case jsonlogic2sql.DialectSQLite:
+				return fmt.Sprintf("%s REGEXP %s", str, pattern), nil
+			case jsonlogic2sql.DialectTrino, jsonlogic2sql.DialectSparkSQL:
+				return fmt.Sprintf("regexp_like(%s, %s)", str, pattern), nil
 			default:
 				return "", fmt.Errorf("unsupported dialect: %v", dialect)
 			}
@@ -532,6 +603,13 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 	// PostgreSQL: CASE WHEN denominator = 0 THEN NULL ELSE numerator / denominator END
 	// DuckDB: CASE WHEN denominator = 0 THEN NULL ELSE numerator / denominator END
 	// ClickHouse: if(denominator = 0, NULL, numerator / denominator)
+	// MySQL: CASE WHEN denominator = 0 THEN NULL ELSE numerator / denominator END
+	// SQLite: CASE WHEN denominator = 0 THEN NULL ELSE numerator / denominator END
+	// Snowflake: IFF(denominator = 0, NULL, numerator / denominator)
+	// SQLServer: CASE WHEN denominator = 0 THEN NULL ELSE numerator / denominator END
+	// Trino: CASE WHEN denominator = 0 THEN NULL ELSE numerator / denominator END
+	// Oracle: CASE WHEN denominator = 0 THEN NULL ELSE numerator / denominator END
+	// SparkSQL: try_divide(numerator, denominator) - built-in function
 	// Example: {"safeDivide": [{"var": "total"}, {"var": "count"}]}
 	_ = transpiler.RegisterDialectAwareOperatorFunc("safeDivide",
 		func(_ string, args []any, dialect jsonlogic2sql.Dialect) (string, error) {
@@ -544,12 +622,18 @@ func registerCustomOperators(transpiler *jsonlogic2sql.Transpiler) {
 			case jsonlogic2sql.DialectBigQuery:
 				// BigQuery has built-in SAFE_DIVIDE that returns NULL on division by zero
 				return fmt.Sprintf("SAFE_DIVIDE(%s, %s)", numerator, denominator), nil
-			case jsonlogic2sql.DialectSpanner, jsonlogic2sql.DialectPostgreSQL, jsonlogic2sql.DialectDuckDB:
-				// Spanner, PostgreSQL, and DuckDB don't have SAFE_DIVIDE, use CASE expression
+			case jsonlogic2sql.DialectSpanner, jsonlogic2sql.DialectPostgreSQL, jsonlogic2sql.DialectDuckDB, jsonlogic2sql.DialectMySQL,
+				jsonlogic2sql.DialectSQLite, jsonlogic2sql.DialectSQLServer, jsonlogic2sql.DialectTrino, jsonlogic2sql.DialectOracle:
+				// Spanner, PostgreSQL, DuckDB, MySQL, SQLite, SQL Server, Trino and Oracle don't have SAFE_DIVIDE, use CASE expression
 				return fmt.Sprintf("CASE WHEN %s = 0 THEN NULL ELSE %s / %s END", denominator, numerator, denominator), nil
 			case jsonlogic2sql.DialectClickHouse:
 				// ClickHouse uses if() function for conditional expressions
 				return fmt.Sprintf("if(%s = 0, NULL, %s / %s)", denominator, numerator, denominator), nil
+			case jsonlogic2sql.DialectSnowflake:
+				return fmt.Sprintf("IFF(%s = 0, NULL, %s / %s)", denominator, numerator, denominator), nil
+			case jsonlogic2sql.DialectSparkSQL:
+				// Spark SQL has built-in try_divide that returns NULL on division by zero
+				return fmt.Sprintf("try_divide(%s, %s)", numerator, denominator), nil
 			default:
 				return "", fmt.Errorf("unsupported dialect: %v", dialect)
 			}
@@ -0,0 +1,59 @@
+package jsonlogic2sql
+
+import (
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+	"github.com/h22rana/jsonlogic2sql/internal/operators"
+)
+
+// DialectSpec describes how a SQL dialect renders the constructs that differ
+// between engines: string concatenation, substrings, string position, array
+// membership, the array operators, date arithmetic, boolean literals,
+// truthiness checks, conditionals, identifier and string quoting, numeric
+// casts, modulo and GREATEST/LEAST.
+//
+// Every built-in dialect implements DialectSpec. To target an engine that is
+// not built in, implement it and add it with RegisterDialect. A spec can start
+// from a built-in one by embedding it and overriding only what differs:
+//
+//	type exasolSpec struct {
+//	    jsonlogic2sql.DialectSpec
+//	}
+//
+//	func (exasolSpec) Name() string { return "Exasol" }
+//
+//	func (exasolSpec) StringPosition(haystack, needle string) string {
+//	    return fmt.Sprintf("INSTR(%s, %s)", haystack, needle)
+//	}
+//
+//	exasol, _ := jsonlogic2sql.RegisterDialect(exasolSpec{jsonlogic2sql.DialectSpecFor(jsonlogic2sql.DialectPostgreSQL)})
+//	transpiler, _ := jsonlogic2sql.NewTranspiler(exasol)
+//
+// Operands are SQL fragments that are already rendered. Array bodies refer to
+// the current element as rendered by ArrayElement, and reduce bodies refer to
+// the running value as rendered by ArrayAccumulator.
+type DialectSpec = dialect.Spec
+
+// DateOperand is a rendered date operand passed to the date methods of
+// DialectSpec, with its temporal type: date, datetime or timestamp.
+type DateOperand = dialect.DateOperand
+
+// DateInterval is the number of date units that DialectSpec.DateAdd shifts a
+// date by.
+type DateInterval = dialect.DateInterval
+
+// RegisterDialect adds a dialect described by spec and returns the Dialect
+// value that selects it in NewTranspiler or TranspilerConfig. The spec's name
+// must not match a built-in or already registered dialect, ignoring case.
+// Registration is global and is meant to happen once, at program start.
+//
+// Registered dialects use ? placeholders in parameterized SQL. Constructs
+// outside DialectSpec, such as temporal literals, use their standard SQL form.
+func RegisterDialect(spec DialectSpec) (Dialect, error) {
+	return dialect.Register(spec)
+}
+
+// DialectSpecFor returns the spec of a built-in or registered dialect, or nil
+// if the dialect is unspecified or unknown.
+func DialectSpecFor(d Dialect) DialectSpec {
+	return operators.SpecFor(d)
+}
@@ -13,6 +13,13 @@ func TestDialectComplianceAllOperators(t *testing.T) {
 		DialectPostgreSQL,
 		DialectDuckDB,
 		DialectClickHouse,
+		DialectMySQL,
+		DialectSQLite,
+		DialectSnowflake,
+		DialectSQLServer,
+		DialectTrino,
+		DialectOracle,
+		DialectSparkSQL,
 	}
 
 	// Common test cases that should work across all dialects
@@ -131,6 +138,13 @@ func TestDialectSpecificArrayOperators(t *testing.T) {
 				DialectPostgreSQL: "WHERE ARRAY(SELECT (elem * 2) FROM UNNEST(numbers) AS elem)",
 				DialectDuckDB:     "WHERE ARRAY(SELECT (elem * 2) FROM UNNEST(numbers) AS elem)",
 				DialectClickHouse: "WHERE arrayMap(elem -> (elem * 2), numbers)",
+				DialectMySQL:      "WHERE COALESCE((SELECT JSON_ARRAYAGG((elem * 2)) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt), JSON_ARRAY())",
+				DialectSQLite:     "WHERE (SELECT json_group_array((je.value * 2)) FROM json_each(numbers) AS je)",
+				DialectSparkSQL:   "WHERE transform(numbers, elem -> (elem * 2))",
+				DialectOracle:     "WHERE COALESCE((SELECT JSON_ARRAYAGG((elem * 2) ORDER BY elem_pos NULL ON NULL) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt), '[]')",
+				DialectTrino:      "WHERE transform(numbers, elem -> (elem * 2))",
+				DialectSQLServer:  "WHERE (SELECT CONCAT('[', STRING_AGG(SUBSTRING(m.j, 2, LEN(m.j) - 2), ',') WITHIN GROUP (ORDER BY CAST(je.[key] AS INT)), ']') FROM OPENJSON(numbers) AS je CROSS APPLY (SELECT JSON_ARRAY((je.value * 2) NULL ON NULL) AS j) AS m)",
+				DialectSnowflake:  "WHERE TRANSFORM(numbers, elem -> (elem * 2))",
 			},
 		},
 		{
@@ -142,6 +156,13 @@ func TestDialectSpecificArrayOperators(t *testing.T) {
 				DialectPostgreSQL: "WHERE ARRAY(SELECT elem FROM UNNEST(scores) AS elem WHERE elem > 70)",
 				DialectDuckDB:     "WHERE ARRAY(SELECT elem FROM UNNEST(scores) AS elem WHERE elem > 70)",
 				DialectClickHouse: "WHERE arrayFilter(elem -> elem > 70, scores)",
+				DialectMySQL:      "WHERE COALESCE((SELECT JSON_ARRAYAGG(elem) FROM JSON_TABLE(scores, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE elem > 70), JSON_ARRAY())",
+				DialectSQLite:     "WHERE (SELECT json_group_array(je.value) FROM json_each(scores) AS je WHERE je.value > 70)",
+				DialectSparkSQL:   "WHERE filter(scores, elem -> elem > 70)",
+				DialectOracle:     "WHERE COALESCE((SELECT JSON_ARRAYAGG(SUBSTR(elem_json, 2, LENGTH(elem_json) - 2) FORMAT JSON ORDER BY elem_pos NULL ON NULL) FROM JSON_TABLE(scores, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE elem > 70), '[]')",
+				DialectTrino:      "WHERE filter(scores, elem -> elem > 70)",
+				DialectSQLServer:  `WHERE (SELECT CONCAT('[', STRING_AGG(CASE je.type WHEN 0 THEN 'null' WHEN 1 THEN CONCAT('"', STRING_ESCAPE(je.value, 'json'), '"') ELSE je.value END, ',') WITHIN GROUP (ORDER BY CAST(je.[key] AS INT)), ']') FROM OPENJSON(scores) AS je WHERE je.value > 70)`,
+				DialectSnowflake:  "WHERE FILTER(scores, elem -> elem > 70)",
 			},
 		},
 		{
@@ -153,6 +174,13 @@ func TestDialectSpecificArrayOperators(t *testing.T) {
 				DialectPostgreSQL: "WHERE NOT EXISTS (SELECT 1 FROM UNNEST(ages) AS elem WHERE NOT (elem >= 18))",
 				DialectDuckDB:     "WHERE NOT EXISTS (SELECT 1 FROM UNNEST(ages) AS elem WHERE NOT (elem >= 18))",
 				DialectClickHouse: "WHERE arrayAll(elem -> elem >= 18, ages)",
+				DialectMySQL:      "WHERE NOT EXISTS (SELECT 1 FROM JSON_TABLE(ages, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE NOT (elem >= 18))",
+				DialectSQLite:     "WHERE NOT EXISTS (SELECT 1 FROM json_each(ages) AS je WHERE NOT (je.value >= 18))",
+				DialectSparkSQL:   "WHERE forall(ages, elem -> elem >= 18)",
+				DialectOracle:     "WHERE NOT EXISTS (SELECT 1 FROM JSON_TABLE(ages, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE NOT (elem >= 18))",
+				DialectTrino:      "WHERE all_match(ages, elem -> elem >= 18)",
+				DialectSQLServer:  "WHERE NOT EXISTS (SELECT 1 FROM OPENJSON(ages) AS je WHERE NOT (je.value >= 18))",
+				DialectSnowflake:  "WHERE ARRAY_SIZE(FILTER(ages, elem -> NOT (elem >= 18))) = 0",
 			},
 		},
 		{
@@ -164,6 +192,13 @@ func TestDialectSpecificArrayOperators(t *testing.T) {
 				DialectPostgreSQL: "WHERE EXISTS (SELECT 1 FROM UNNEST(items) AS elem WHERE elem = 'active')",
 				DialectDuckDB:     "WHERE EXISTS (SELECT 1 FROM UNNEST(items) AS elem WHERE elem = 'active')",
 				DialectClickHouse: "WHERE arrayExists(elem -> elem = 'active', items)",
+				DialectMySQL:      "WHERE EXISTS (SELECT 1 FROM JSON_TABLE(items, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE elem = 'active')",
+				DialectSQLite:     "WHERE EXISTS (SELECT 1 FROM json_each(items) AS je WHERE je.value = 'active')",
+				DialectSparkSQL:   "WHERE exists(items, elem -> elem = 'active')",
+				DialectOracle:     "WHERE EXISTS (SELECT 1 FROM JSON_TABLE(items, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE elem = 'active')",
+				DialectTrino:      "WHERE any_match(items, elem -> elem = 'active')",
+				DialectSQLServer:  "WHERE EXISTS (SELECT 1 FROM OPENJSON(items) AS je WHERE je.value = 'active')",
+				DialectSnowflake:  "WHERE ARRAY_SIZE(FILTER(items, elem -> elem = 'active')) > 0",
 			},
 		},
 		{
@@ -175,6 +210,13 @@ func TestDialectSpecificArrayOperators(t *testing.T) {
 				DialectPostgreSQL: "WHERE NOT EXISTS (SELECT 1 FROM UNNEST(values) AS elem WHERE elem = 'error')",
 				DialectDuckDB:     "WHERE NOT EXISTS (SELECT 1 FROM UNNEST(values) AS elem WHERE elem = 'error')",
 				DialectClickHouse: "WHERE NOT arrayExists(elem -> elem = 'error', values)",
+				DialectMySQL:      "WHERE NOT EXISTS (SELECT 1 FROM JSON_TABLE(`values`, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE elem = 'error')",
+				DialectSQLite:     `WHERE NOT EXISTS (SELECT 1 FROM json_each("values") AS je WHERE je.value = 'error')`,
+				DialectSparkSQL:   "WHERE NOT exists(values, elem -> elem = 'error')",
+				DialectOracle:     `WHERE NOT EXISTS (SELECT 1 FROM JSON_TABLE("values", '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE elem = 'error')`,
+				DialectTrino:      `WHERE none_match("values", elem -> elem = 'error')`,
+				DialectSQLServer:  "WHERE NOT EXISTS (SELECT 1 FROM OPENJSON([values]) AS je WHERE je.value = 'error')",
+				DialectSnowflake:  `WHERE ARRAY_SIZE(FILTER("values", elem -> elem = 'error')) = 0`,
 			},
 		},
 		{
@@ -186,6 +228,28 @@ func TestDialectSpecificArrayOperators(t *testing.T) {
 				DialectPostgreSQL: "WHERE 0 + COALESCE((SELECT SUM(elem) FROM UNNEST(numbers) AS elem), 0)",
 				DialectDuckDB:     "WHERE 0 + COALESCE((SELECT SUM(elem) FROM UNNEST(numbers) AS elem), 0)",
 				DialectClickHouse: "WHERE 0 + coalesce(arrayReduce('sum', numbers), 0)",
+				DialectMySQL:      "WHERE 0 + COALESCE((SELECT SUM(elem) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem DOUBLE PATH '$')) AS jt), 0)",
+				DialectSQLite:     "WHERE 0 + COALESCE((SELECT SUM(je.value) FROM json_each(numbers) AS je), 0)",
+				DialectSparkSQL:   "WHERE aggregate(numbers, CAST(0 AS DOUBLE), (acc, elem) -> acc + elem)",
+				DialectOracle:     "WHERE 0 + NVL((SELECT SUM(elem) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem NUMBER PATH '$')) jt), 0)",
+				DialectTrino:      "WHERE reduce(numbers, 0, (acc, elem) -> acc + elem, acc -> acc)",
+				DialectSQLServer:  "WHERE 0 + COALESCE((SELECT SUM(CAST(je.value AS FLOAT)) FROM OPENJSON(numbers) AS je), 0)",
+				DialectSnowflake:  "WHERE REDUCE(numbers, 0, (acc, elem) -> acc + elem)",
+			},
+		},
+		{
+			name:  "in array column",
+			input: `{"in": [{"var": "tag"}, {"var": "tags"}]}`,
+			expected: map[Dialect]string{
+				DialectBigQuery:   "WHERE tag IN tags",
+				DialectPostgreSQL: "WHERE tag IN tags",
+				DialectMySQL:      "WHERE JSON_CONTAINS(tags, JSON_ARRAY(tag))",
+				DialectSQLite:     "WHERE tag IN (SELECT value FROM json_each(tags))",
+				DialectSparkSQL:   "WHERE array_contains(tags, tag)",
+				DialectOracle:     `WHERE JSON_EXISTS(tags, '$[*]?(@ == $v)' PASSING tag AS "v")`,
+				DialectTrino:      "WHERE contains(tags, tag)",
+				DialectSQLServer:  "WHERE tag IN (SELECT value FROM OPENJSON(tags))",
+				DialectSnowflake:  "WHERE ARRAY_CONTAINS(tag::VARIANT, tags)",
 			},
 		},
 		{
@@ -197,6 +261,13 @@ func TestDialectSpecificArrayOperators(t *testing.T) {
 				DialectPostgreSQL: "WHERE (arr1 || arr2)",
 				DialectDuckDB:     "WHERE ARRAY_CONCAT(arr1, arr2)",
 				DialectClickHouse: "WHERE arrayConcat(arr1, arr2)",
+				DialectMySQL:      "WHERE JSON_MERGE_PRESERVE(arr1, arr2)",
+				DialectSQLite:     "WHERE (SELECT json_group_array(je.value) FROM json_each(json_array(json(arr1), json(arr2))) AS src, json_each(src.value) AS je)",
+				DialectSparkSQL:   "WHERE concat(arr1, arr2)",
+				DialectOracle:     "WHERE COALESCE((SELECT JSON_ARRAYAGG(SUBSTR(elem_json, 2, LENGTH(elem_json) - 2) FORMAT JSON ORDER BY src.n, elem_pos NULL ON NULL) FROM (SELECT 1 AS n, arr1 AS arr FROM DUAL UNION ALL SELECT 2 AS n, arr2 AS arr FROM DUAL) src, JSON_TABLE(src.arr, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt), '[]')",
+				DialectTrino:      "WHERE concat(arr1, arr2)",
+				DialectSQLServer:  `WHERE (SELECT CONCAT('[', STRING_AGG(CASE je.type WHEN 0 THEN 'null' WHEN 1 THEN CONCAT('"', STRING_ESCAPE(je.value, 'json'), '"') ELSE je.value END, ',') WITHIN GROUP (ORDER BY src.n, CAST(je.[key] AS INT)), ']') FROM (VALUES (1, arr1), (2, arr2)) AS src(n, arr) CROSS APPLY OPENJSON(src.arr) AS je)`,
+				DialectSnowflake:  "WHERE ARRAY_CAT(arr1, arr2)",
 			},
 		},
 	}
@@ -242,6 +313,13 @@ func TestDialectSpecificStringFunctions(t *testing.T) {
 				DialectPostgreSQL: "WHERE POSITION('test' IN description) > 0",
 				DialectDuckDB:     "WHERE STRPOS(description, 'test') > 0",
 				DialectClickHouse: "WHERE position(description, 'test') > 0",
+				DialectMySQL:      "WHERE LOCATE('test', description) > 0",
+				DialectSQLite:     "WHERE instr(description, 'test') > 0",
+				DialectSparkSQL:   "WHERE instr(description, 'test') > 0",
+				DialectOracle:     "WHERE INSTR(description, 'test') > 0",
+				DialectTrino:      "WHERE STRPOS(description, 'test') > 0",
+				DialectSQLServer:  "WHERE CHARINDEX('test', description) > 0",
+				DialectSnowflake:  "WHERE POSITION('test' IN description) > 0",
 			},
 		},
 		{
@@ -253,6 +331,13 @@ func TestDialectSpecificStringFunctions(t *testing.T) {
 				DialectPostgreSQL: "WHERE SUBSTR(text, 6, 10)",
 				DialectDuckDB:     "WHERE SUBSTR(text, 6, 10)",
 				DialectClickHouse: "WHERE substring(text, 6, 10)",
+				DialectMySQL:      "WHERE SUBSTRING(text, 6, 10)",
+				DialectSQLite:     "WHERE substr(text, 6, 10)",
+				DialectSparkSQL:   "WHERE SUBSTR(text, 6, 10)",
+				DialectOracle:     "WHERE SUBSTR(text, 6, 10)",
+				DialectTrino:      "WHERE SUBSTR(text, 6, 10)",
+				DialectSQLServer:  "WHERE SUBSTRING(text, 6, 10)",
+				DialectSnowflake:  "WHERE SUBSTR(text, 6, 10)",
 			},
 		},
 	}
@@ -354,6 +439,63 @@ func TestEdgeCasesBooleanValues(t *testing.T) {
 	}
 }
 
+// TestNumericBooleanConditions verifies that dialects without boolean values
+// compare boolean vars with 1 wherever a condition is expected, and turn
+// predicates used as values into 1 or 0.
+func TestNumericBooleanConditions(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		input    string
+		expected string
+	}{
+		{"SQLServer vars in and", DialectSQLServer, `{"and": [{"var": "x"}, {"var": "y"}]}`, "WHERE (x = 1 AND y = 1)"},
+		{"SQLServer var in or", DialectSQLServer, `{"or": [{"var": "x"}, {">": [{"var": "a"}, 1]}]}`, "WHERE (x = 1 OR a > 1)"},
+		{"SQLServer literal in and", DialectSQLServer, `{"and": [true, {"var": "x"}]}`, "WHERE (1 = 1 AND x = 1)"},
+		{"SQLServer root var", DialectSQLServer, `{"var": "x"}`, "WHERE x = 1"},
+		{"SQLServer root if", DialectSQLServer, `{"if": [{"var": "x"}, {">": [{"var": "a"}, 1]}, false]}`, "WHERE CASE WHEN x = 1 THEN CASE WHEN a > 1 THEN 1 ELSE 0 END ELSE 0 END = 1"},
+		{"SQLServer predicate compared", DialectSQLServer, `{"==": [{">": [{"var": "a"}, 1]}, true]}`, "WHERE CASE WHEN (a > 1) THEN 1 ELSE 0 END = 1"},
+		{"SQLServer element condition", DialectSQLServer, `{"some": [{"var": "flags"}, {"var": ""}]}`, "WHERE EXISTS (SELECT 1 FROM OPENJSON(flags) AS je WHERE je.value = 1)"},
+		{"Oracle vars in and", DialectOracle, `{"and": [{"var": "x"}, {"var": "y"}]}`, "WHERE (x = 1 AND y = 1)"},
+		{"Oracle var in or", DialectOracle, `{"or": [{"var": "x"}, {">": [{"var": "a"}, 1]}]}`, "WHERE (x = 1 OR a > 1)"},
+		{"Oracle root var", DialectOracle, `{"var": "x"}`, "WHERE x = 1"},
+		{"Oracle predicate compared", DialectOracle, `{"==": [{">": [{"var": "a"}, 1]}, true]}`, "WHERE CASE WHEN (a > 1) THEN 1 ELSE 0 END = 1"},
+		{"Oracle not of and", DialectOracle, `{"!": {"and": [{"var": "x"}, true]}}`, "WHERE NOT ((x = 1 AND 1 = 1))"},
+		{"PostgreSQL vars in and", DialectPostgreSQL, `{"and": [{"var": "x"}, {"var": "y"}]}`, "WHERE (x AND y)"},
+		{"PostgreSQL predicate compared", DialectPostgreSQL, `{"==": [{">": [{"var": "a"}, 1]}, true]}`, "WHERE (a > 1) = TRUE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpile(tt.dialect, tt.input)
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+
+	tr, err := NewTranspiler(DialectSQLServer)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error = %v", err)
+	}
+	condition, err := tr.TranspileCondition(`{"var": "x"}`)
+	if err != nil || condition != "x = 1" {
+		t.Errorf("TranspileCondition() = %q, %v, want %q", condition, err, "x = 1")
+	}
+
+	tr, err = NewTranspiler(DialectOracle)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error = %v", err)
+	}
+	condition, err = tr.TranspileCondition(`{"or": [{"var": "x"}, {"var": "y"}]}`)
+	if err != nil || condition != "(x = 1 OR y = 1)" {
+		t.Errorf("TranspileCondition() = %q, %v, want %q", condition, err, "(x = 1 OR y = 1)")
+	}
+}
+
 // TestEdgeCasesEmptyInputs tests handling of empty or minimal inputs.
 func TestEdgeCasesEmptyInputs(t *testing.T) {
 	tr, err := NewTranspiler(DialectBigQuery)
@@ -393,6 +535,115 @@ func TestEdgeCasesEmptyInputs(t *testing.T) {
 	}
 }
 
+// TestStringLiteralEscapingByDialect tests that string literals are escaped with each dialect's rules.
+func TestStringLiteralEscapingByDialect(t *testing.T) {
+	input := `{"==": [{"var": "name"}, "O'Brien \\ \"x\"\n"]}`
+
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectBigQuery, `WHERE name = 'O\'Brien \\ "x"\n'`},
+		{DialectSpanner, `WHERE name = 'O\'Brien \\ "x"\n'`},
+		{DialectClickHouse, `WHERE name = 'O\'Brien \\ "x"\n'`},
+		{DialectPostgreSQL, "WHERE name = 'O''Brien \\ \"x\"\n'"},
+		{DialectDuckDB, "WHERE name = 'O''Brien \\ \"x\"\n'"},
+		{DialectMySQL, `WHERE name = 'O\'Brien \\ "x"\n'`},
+		{DialectSQLite, "WHERE name = 'O''Brien \\ \"x\"\n'"},
+		{DialectSnowflake, `WHERE name = 'O\'Brien \\ "x"\n'`},
+		{DialectSQLServer, "WHERE name = 'O''Brien \\ \"x\"\n'"},
+		{DialectTrino, "WHERE name = 'O''Brien \\ \"x\"\n'"},
+		{DialectOracle, "WHERE name = 'O''Brien \\ \"x\"\n'"},
+		{DialectSparkSQL, `WHERE name = 'O\'Brien \\ "x"\n'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			result, err := Transpile(tt.dialect, input)
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestIdentifierQuotingByDialect verifies that reserved words and unsafe var names
+// are quoted with each dialect's identifier quotes, segment by segment.
+func TestIdentifierQuotingByDialect(t *testing.T) {
+	input := `{"and": [{"==": [{"var": "order.group"}, 1]}, {"==": [{"var": "user.first name"}, "x"]}, {"missing": "my\"col"}]}`
+
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectBigQuery, "WHERE (`order`.`group` = 1 AND user.`first name` = 'x' AND `my\"col` IS NULL)"},
+		{DialectSpanner, "WHERE (`order`.`group` = 1 AND user.`first name` = 'x' AND `my\"col` IS NULL)"},
+		{DialectClickHouse, "WHERE (`order`.`group` = 1 AND user.`first name` = 'x' AND `my\"col` IS NULL)"},
+		{DialectPostgreSQL, `WHERE ("order"."group" = 1 AND user."first name" = 'x' AND "my""col" IS NULL)`},
+		{DialectDuckDB, `WHERE ("order"."group" = 1 AND user."first name" = 'x' AND "my""col" IS NULL)`},
+		{DialectMySQL, "WHERE (`order`.`group` = 1 AND user.`first name` = 'x' AND `my\"col` IS NULL)"},
+		{DialectSQLite, `WHERE ("order"."group" = 1 AND user."first name" = 'x' AND "my""col" IS NULL)`},
+		{DialectSnowflake, `WHERE ("order"."group" = 1 AND user."first name" = 'x' AND "my""col" IS NULL)`},
+		{DialectSQLServer, `WHERE ([order].[group] = 1 AND [user].[first name] = 'x' AND [my"col] IS NULL)`},
+		{DialectTrino, `WHERE ("order"."group" = 1 AND user."first name" = 'x' AND "my""col" IS NULL)`},
+		{DialectOracle, `WHERE ("order"."group" = 1 AND "user"."first name" = 'x' AND "my""col" IS NULL)`},
+		{DialectSparkSQL, "WHERE (`order`.`group` = 1 AND `user`.`first name` = 'x' AND `my\"col` IS NULL)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			result, err := Transpile(tt.dialect, input)
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestStringLiteralEscapingAllOperators verifies that every operator emitting string
+// literals goes through the dialect encoder.
+func TestStringLiteralEscapingAllOperators(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"comparison", `{"!=": [{"var": "a"}, "it's"]}`, `WHERE a != 'it\'s'`},
+		{"in list", `{"in": [{"var": "a"}, ["it's", "x"]]}`, `WHERE a IN ('it\'s', 'x')`},
+		{"in string containment", `{"in": ["it's", {"var": "a"}]}`, `WHERE STRPOS(a, 'it\'s') > 0`},
+		{"var default", `{"==": [{"var": ["a", "it's"]}, "x"]}`, `WHERE COALESCE(a, 'it\'s') = 'x'`},
+		{"cat", `{"==": [{"cat": ["it's", {"var": "a"}]}, "x"]}`, `WHERE CONCAT('it\'s', a) = 'x'`},
+		{"if", `{"if": [{"var": "a"}, "it's", "no"]}`, `WHERE CASE WHEN a THEN 'it\'s' ELSE 'no' END`},
+		{"array operator", `{"some": [{"var": "tags"}, {"==": [{"var": ""}, "it's"]}]}`, `WHERE EXISTS (SELECT 1 FROM UNNEST(tags) AS elem WHERE elem = 'it\'s')`},
+		{"numeric string operand", `{">": [{"+": [{"var": "a"}, "1) OR (1=1"]}, 0]}`, `WHERE (a + '1) OR (1=1') > 0`},
+		{"top-level numeric string operand", `{"max": ["x'); --", {"var": "a"}]}`, `WHERE GREATEST('x\'); --', a)`},
+		{"comparison nested in arithmetic", `{"+": [{"==": [{"var": "a"}, "x') OR ('1'='1"]}, 1]}`, `WHERE (a = 'x\') OR (\'1\'=\'1' + 1)`},
+	}
+
+	tr, err := NewTranspiler(DialectBigQuery)
+	if err != nil {
+		t.Fatalf("Failed to create transpiler: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tr.Transpile(tt.input)
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
 // TestEdgeCasesSpecialCharacters tests handling of special characters in strings.
 func TestEdgeCasesSpecialCharacters(t *testing.T) {
 	tr, err := NewTranspiler(DialectBigQuery)
@@ -405,12 +656,15 @@ func TestEdgeCasesSpecialCharacters(t *testing.T) {
 		input    string
 		expected string
 	}{
-		{"single quote in string", `{"==": [{"var": "name"}, "O'Brien"]}`, "WHERE name = 'O''Brien'"},
+		{"single quote in string", `{"==": [{"var": "name"}, "O'Brien"]}`, `WHERE name = 'O\'Brien'`},
+		{"backslash in string", `{"==": [{"var": "path"}, "C:\\temp"]}`, `WHERE path = 'C:\\temp'`},
+		{"newline in string", `{"==": [{"var": "text"}, "a\nb"]}`, `WHERE text = 'a\nb'`},
+		{"quote escape attempt", `{"==": [{"var": "name"}, "x\\' OR 1=1 --"]}`, `WHERE name = 'x\\\' OR 1=1 --'`},
 		{"unicode characters", `{"==": [{"var": "text"}, "日本語"]}`, "WHERE text = '日本語'"},
 		{"unicode with parentheses", `{"==": [{"var": "shop"}, "SPA(スパ)"]}`, "WHERE shop = 'SPA(スパ)'"},
 		{"empty string", `{"==": [{"var": "value"}, ""]}`, "WHERE value = ''"},
 		{"string with spaces", `{"==": [{"var": "name"}, "John Doe"]}`, "WHERE name = 'John Doe'"},
-		{"string with SQL keywords", `{"==": [{"var": "desc"}, "SELECT * FROM users"]}`, "WHERE desc = 'SELECT * FROM users'"},
+		{"string with SQL keywords", `{"==": [{"var": "desc"}, "SELECT * FROM users"]}`, "WHERE `desc` = 'SELECT * FROM users'"},
 		{"string with comparison operators", `{"==": [{"var": "formula"}, "a > b AND c < d"]}`, "WHERE formula = 'a > b AND c < d'"},
 	}
 
@@ -520,6 +774,13 @@ func TestANSIComplianceCommonOperators(t *testing.T) {
 		DialectPostgreSQL,
 		DialectDuckDB,
 		DialectClickHouse,
+		DialectMySQL,
+		DialectSQLite,
+		DialectSnowflake,
+		DialectSQLServer,
+		DialectTrino,
+		DialectOracle,
+		DialectSparkSQL,
 	}
 
 	// These SQL constructs should be identical across all dialects
@@ -527,6 +788,7 @@ func TestANSIComplianceCommonOperators(t *testing.T) {
 		name        string
 		input       string
 		mustContain []string
+		overrides   map[Dialect][]string // replaces mustContain for dialects without the ANSI function
 		mustNotFail bool
 		description string
 	}{
@@ -534,6 +796,7 @@ func TestANSIComplianceCommonOperators(t *testing.T) {
 			name:        "CASE WHEN for if",
 			input:       `{"if": [{">": [{"var": "x"}, 0]}, "pos", "neg"]}`,
 			mustContain: []string{"CASE", "WHEN", "THEN", "ELSE", "END"},
+			overrides:   map[Dialect][]string{DialectSnowflake: {"IFF("}},
 			description: "IF should translate to CASE WHEN THEN ELSE END",
 		},
 		{
@@ -582,18 +845,21 @@ func TestANSIComplianceCommonOperators(t *testing.T) {
 			name:        "GREATEST for max",
 			input:       `{"max": [{"var": "a"}, {"var": "b"}]}`,
 			mustContain: []string{"GREATEST"},
+			overrides:   map[Dialect][]string{DialectSQLite: {"max("}},
 			description: "Max should use GREATEST function",
 		},
 		{
 			name:        "LEAST for min",
 			input:       `{"min": [{"var": "a"}, {"var": "b"}]}`,
 			mustContain: []string{"LEAST"},
+			overrides:   map[Dialect][]string{DialectSQLite: {"min("}},
 			description: "Min should use LEAST function",
 		},
 		{
 			name:        "CONCAT for string concatenation",
 			input:       `{"cat": ["a", "b", "c"]}`,
 			mustContain: []string{"CONCAT"},
+			overrides:   map[Dialect][]string{DialectSQLite: {"||"}, DialectSnowflake: {"||"}, DialectOracle: {"||"}},
 			description: "String concatenation should use CONCAT",
 		},
 	}
@@ -613,7 +879,11 @@ func TestANSIComplianceCommonOperators(t *testing.T) {
 						return
 					}
 
-					for _, expected := range tt.mustContain {
+					mustContain := tt.mustContain
+					if override, ok := tt.overrides[d]; ok {
+						mustContain = override
+					}
+					for _, expected := range mustContain {
 						if !containsString(result, expected) {
 							t.Errorf("[%s] %s: result %q should contain %q", d.String(), tt.description, result, expected)
 						}
@@ -646,6 +916,13 @@ func TestTranspileConditionAllDialects(t *testing.T) {
 		DialectPostgreSQL,
 		DialectDuckDB,
 		DialectClickHouse,
+		DialectMySQL,
+		DialectSQLite,
+		DialectSnowflake,
+		DialectSQLServer,
+		DialectTrino,
+		DialectOracle,
+		DialectSparkSQL,
 	}
 
 	input := `{">": [{"var": "amount"}, 1000]}`
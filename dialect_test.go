@@ -0,0 +1,319 @@
+package jsonlogic2sql
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// exasolSpec adds a dialect on top of the PostgreSQL spec, overriding only
+// what differs, as described in the DialectSpec documentation.
+type exasolSpec struct {
+	DialectSpec
+}
+
+func (exasolSpec) Name() string {
+	return "Exasol"
+}
+
+func (exasolSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("INSTR(%s, %s)", haystack, needle)
+}
+
+func (exasolSpec) ArrayContains(string, string) (string, error) {
+	return "", fmt.Errorf("arrays are not supported by Exasol")
+}
+
+func (exasolSpec) DateAdd(date DateOperand, interval DateInterval) (string, error) {
+	amount, err := interval.Amount(interval.Sign)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ADD_%sS(%s, %s)", strings.ToUpper(interval.Unit), date.SQL, amount), nil
+}
+
+// dialectExasol is registered once per test binary, since registration is global.
+var dialectExasol = func() Dialect {
+	d, err := RegisterDialect(exasolSpec{DialectSpecFor(DialectPostgreSQL)})
+	if err != nil {
+		panic(err)
+	}
+	return d
+}()
+
+func TestRegisterDialect(t *testing.T) {
+	if got := dialectExasol.String(); got != "Exasol" {
+		t.Errorf("String() = %q, want %q", got, "Exasol")
+	}
+	if err := dialectExasol.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		spec DialectSpec
+	}{
+		{"built-in name", renamedSpec{DialectSpecFor(DialectTrino), "trino"}},
+		{"duplicate name", renamedSpec{DialectSpecFor(DialectBigQuery), "EXASOL"}},
+		{"nil spec", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if d, err := RegisterDialect(tt.spec); err == nil {
+				t.Errorf("RegisterDialect() = %s, want error", d)
+			}
+		})
+	}
+}
+
+// renamedSpec overrides the name of another spec.
+type renamedSpec struct {
+	DialectSpec
+	name string
+}
+
+func (s renamedSpec) Name() string {
+	return s.name
+}
+
+func TestDialectSpecFor(t *testing.T) {
+	for _, d := range []Dialect{DialectBigQuery, DialectMySQL, DialectSparkSQL, dialectExasol} {
+		spec := DialectSpecFor(d)
+		if spec == nil || spec.Name() != d.String() {
+			t.Errorf("DialectSpecFor(%s) = %v", d, spec)
+		}
+	}
+	if spec := DialectSpecFor(Dialect(0)); spec != nil {
+		t.Errorf("DialectSpecFor(Unspecified) = %v, want nil", spec)
+	}
+}
+
+func TestTranspile_RegisteredDialect(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "comparison",
+			input:    `{"and": [{"==": [{"var": "status"}, "active"]}, {">": [{"var": "amount"}, 10]}]}`,
+			expected: "WHERE (status = 'active' AND amount > 10)",
+		},
+		{
+			name:     "reserved identifier is quoted",
+			input:    `{"==": [{"var": "order"}, 1]}`,
+			expected: `WHERE "order" = 1`,
+		},
+		{
+			name:     "inherited concat",
+			input:    `{"==": [{"cat": [{"var": "first"}, " ", {"var": "last"}]}, "Ada Lovelace"]}`,
+			expected: "WHERE CONCAT(first, ' ', last) = 'Ada Lovelace'",
+		},
+		{
+			name:     "overridden string position",
+			input:    `{"in": ["@example.com", {"var": "email"}]}`,
+			expected: "WHERE INSTR(email, '@example.com') > 0",
+		},
+		{
+			name:   "unsupported array membership",
+			input:  `{"in": [{"var": "tag"}, {"var": "tags"}]}`,
+			errMsg: "arrays are not supported by Exasol",
+		},
+		{
+			name:     "inherited reduce aggregate",
+			input:    `{">": [{"reduce": [{"var": "xs"}, {"+": [{"var": "accumulator"}, {"var": "current"}]}, 0]}, 10]}`,
+			expected: "WHERE 0 + COALESCE((SELECT SUM(elem) FROM UNNEST(xs) AS elem), 0) > 10",
+		},
+		{
+			name:     "overridden date arithmetic",
+			input:    `{">": [{"var": "created_at"}, {"days_ago": 7}]}`,
+			expected: "WHERE created_at > ADD_DAYS(CURRENT_TIMESTAMP, -7)",
+		},
+		{
+			name:     "inherited date truncation",
+			input:    `{"<": [{"date_trunc": [{"var": "created_at"}, "month"]}, {"now": []}]}`,
+			expected: "WHERE DATE_TRUNC('month', created_at) < CURRENT_TIMESTAMP",
+		},
+	}
+
+	transpiler, err := NewTranspiler(dialectExasol)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transpiler.Transpile(tt.input)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Transpile() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// dialectFabric is registered once per test binary and inherits everything
+// from SQL Server, which has no general fold.
+var dialectFabric = func() Dialect {
+	d, err := RegisterDialect(renamedSpec{DialectSpecFor(DialectSQLServer), "Fabric"})
+	if err != nil {
+		panic(err)
+	}
+	return d
+}()
+
+func TestTranspile_RegisteredDialectReduce(t *testing.T) {
+	transpiler, err := NewTranspiler(dialectFabric)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error: %v", err)
+	}
+
+	got, err := transpiler.Transpile(`{">": [{"reduce": [{"var": "xs"}, {"max": [{"var": "accumulator"}, {"var": "current.price"}]}, 0]}, 10]}`)
+	if err != nil {
+		t.Fatalf("Transpile() unexpected error: %v", err)
+	}
+	want := "WHERE (SELECT MAX(v) FROM (VALUES (0), ((SELECT MAX(CAST(JSON_VALUE(je.value, '$.price') AS FLOAT)) FROM OPENJSON(xs) AS je))) AS t(v)) > 10"
+	if got != want {
+		t.Errorf("Transpile() = %q, want %q", got, want)
+	}
+
+	if _, err := transpiler.Transpile(`{">": [{"reduce": [{"var": "xs"}, {"*": [{"var": "accumulator"}, {"var": "current"}]}, 1]}, 10]}`); err == nil {
+		t.Errorf("Transpile() of a general reduce body should fail")
+	}
+}
+
+// dialectLibSQL is registered once per test binary and inherits everything
+// from SQLite, which has no GREATEST or LEAST.
+var dialectLibSQL = func() Dialect {
+	d, err := RegisterDialect(renamedSpec{DialectSpecFor(DialectSQLite), "libSQL"})
+	if err != nil {
+		panic(err)
+	}
+	return d
+}()
+
+func TestTranspile_RegisteredDialectInheritsSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		input    string
+		expected string
+	}{
+		{
+			name:     "SQL Server truthiness",
+			dialect:  dialectFabric,
+			input:    `{"!!": {"var": "score"}}`,
+			expected: "WHERE (score IS NOT NULL AND score != 0 AND score != '')",
+		},
+		{
+			name:     "SQL Server date arithmetic",
+			dialect:  dialectFabric,
+			input:    `{">": [{"var": "created_at"}, {"days_ago": 7}]}`,
+			expected: "WHERE created_at > DATEADD(day, -7, CURRENT_TIMESTAMP)",
+		},
+		{
+			name:     "SQLite date difference",
+			dialect:  dialectLibSQL,
+			input:    `{">": [{"date_diff": [{"now": []}, {"var": "created_at"}, "day"]}, 30]}`,
+			expected: "WHERE CAST(julianday(date(CURRENT_TIMESTAMP)) - julianday(date(created_at)) AS INTEGER) > 30",
+		},
+		{
+			name:     "SQLite max",
+			dialect:  dialectLibSQL,
+			input:    `{">": [{"max": [{"var": "a"}, {"var": "b"}]}, 10]}`,
+			expected: "WHERE max(a, b) > 10",
+		},
+		{
+			name:     "SQLite min",
+			dialect:  dialectLibSQL,
+			input:    `{"<": [{"min": [{"var": "a"}, {"var": "b"}]}, 10]}`,
+			expected: "WHERE min(a, b) < 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Transpile(tt.dialect, tt.input)
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTranspileParameterized_RegisteredDialect(t *testing.T) {
+	sql, args, err := TranspileParameterized(dialectExasol, `{"and": [{"==": [{"var": "status"}, "active"]}, {"in": ["x", {"var": "name"}]}]}`)
+	if err != nil {
+		t.Fatalf("TranspileParameterized() unexpected error: %v", err)
+	}
+	if want := "WHERE (status = ? AND INSTR(name, ?) > 0)"; sql != want {
+		t.Errorf("TranspileParameterized() sql = %q, want %q", sql, want)
+	}
+	if want := []any{"active", "x"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("TranspileParameterized() args = %v, want %v", args, want)
+	}
+}
+
+func TestTranspile_RegisteredDialectSchema(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "attrs", Type: FieldTypeJSON},
+		{Name: "attrs.age", Type: FieldTypeInteger},
+		{Name: "tags", Type: FieldTypeArray},
+		{Name: "created_at", Type: FieldTypeDate},
+	})
+	transpiler, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: dialectExasol, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "JSON root var",
+			input:    `{"==": [{"var": "attrs.address.city"}, "Paris"]}`,
+			expected: "WHERE attrs->'address'->>'city' = 'Paris'",
+		},
+		{
+			name:     "typed JSON leaf",
+			input:    `{">": [{"var": "attrs.age"}, 18]}`,
+			expected: "WHERE CAST(attrs->>'age' AS BIGINT) > 18",
+		},
+		{
+			name:     "array truthiness",
+			input:    `{"!!": {"var": "tags"}}`,
+			expected: "WHERE (tags IS NOT NULL AND CARDINALITY(tags) > 0)",
+		},
+		{
+			name:     "standard date literal",
+			input:    `{">": [{"var": "created_at"}, "2024-01-31"]}`,
+			expected: "WHERE created_at > DATE '2024-01-31'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transpiler.Transpile(tt.input)
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -41,11 +41,13 @@ const (
 	ErrArrayNotAllowed     = tperrors.ErrArrayNotAllowed
 	ErrValidation          = tperrors.ErrValidation
 	ErrInvalidJSON         = tperrors.ErrInvalidJSON
+	ErrInvalidSQL          = tperrors.ErrInvalidSQL
 
 	// Operator-specific errors (E100-E199).
 	ErrUnsupportedOperator   = tperrors.ErrUnsupportedOperator
 	ErrOperatorRequiresArray = tperrors.ErrOperatorRequiresArray
 	ErrCustomOperatorFailed  = tperrors.ErrCustomOperatorFailed
+	ErrUnsupportedSQL        = tperrors.ErrUnsupportedSQL
 
 	// Type/schema errors (E200-E299).
 	ErrTypeMismatch     = tperrors.ErrTypeMismatch
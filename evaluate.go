@@ -0,0 +1,63 @@
+package jsonlogic2sql
+
+import (
+	"encoding/json"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+	"github.com/h22rana/jsonlogic2sql/internal/eval"
+	"github.com/h22rana/jsonlogic2sql/internal/validator"
+)
+
+// Evaluate evaluates a JSON Logic rule in memory against a single record.
+// Each key of data plays the role of a column; dotted var names look into
+// nested maps. The result agrees with what the generated SQL returns for a row
+// holding the same values:
+//
+//   - nil is SQL NULL, and and/or/! use three-valued logic
+//   - {"==": [x, null]} is x IS NULL
+//   - "in" is array membership for arrays and substring containment for strings
+//   - "substr" uses the same 1-based start position as the generated SUBSTR
+//
+// The result is nil, a bool, a float64, a string or a []any.
+//
+// Example:
+//
+//	result, _ := jsonlogic2sql.Evaluate(`{">": [{"var": "amount"}, 1000]}`,
+//		map[string]any{"amount": 1500})
+//	// result == true
+func Evaluate(jsonLogic string, data map[string]any) (any, error) {
+	var logic interface{}
+	if err := json.Unmarshal([]byte(jsonLogic), &logic); err != nil {
+		return nil, tperrors.NewInvalidJSON(err)
+	}
+	return EvaluateFromInterface(logic, data)
+}
+
+// EvaluateFromInterface evaluates a pre-decoded JSON Logic value against a single record.
+func EvaluateFromInterface(logic interface{}, data map[string]any) (any, error) {
+	if err := validator.NewValidator().Validate(logic); err != nil {
+		return nil, tperrors.NewValidationError(err)
+	}
+	node, err := ast.Parse(logic)
+	if err != nil {
+		return nil, err
+	}
+	return eval.Evaluate(node, data)
+}
+
+// EvaluateNode evaluates an AST against a single record.
+func EvaluateNode(node Node, data map[string]any) (any, error) {
+	return eval.Evaluate(node, data)
+}
+
+// Matches reports whether a record satisfies a rule used as a WHERE clause:
+// only a TRUE result matches, so a NULL result excludes the record just as the
+// database would.
+func Matches(jsonLogic string, data map[string]any) (bool, error) {
+	result, err := Evaluate(jsonLogic, data)
+	if err != nil {
+		return false, err
+	}
+	return result == true, nil
+}
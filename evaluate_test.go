@@ -0,0 +1,123 @@
+package jsonlogic2sql
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	data := map[string]any{
+		"amount":  1500,
+		"status":  "active",
+		"country": nil,
+		"tags":    []string{"vip"},
+	}
+
+	tests := []struct {
+		name  string
+		logic string
+		want  any
+	}{
+		{"comparison", `{">": [{"var": "amount"}, 1000]}`, true},
+		{"in array", `{"in": [{"var": "status"}, ["active", "pending"]]}`, true},
+		{"in string", `{"in": ["act", {"var": "status"}]}`, true},
+		{"in array var", `{"in": ["vip", {"var": "tags"}]}`, true},
+		{"null comparison", `{"==": [{"var": "country"}, "US"]}`, nil},
+		{"is null", `{"==": [{"var": "country"}, null]}`, true},
+		{"three-valued and", `{"and": [{"==": [{"var": "country"}, "US"]}, {">": [{"var": "amount"}, 1000]}]}`, nil},
+		{"three-valued or", `{"or": [{"==": [{"var": "country"}, "US"]}, {">": [{"var": "amount"}, 1000]}]}`, true},
+		{"substr", `{"substr": [{"var": "status"}, 1, 3]}`, "cti"},
+		{"arithmetic", `{"*": [{"var": "amount"}, 2]}`, float64(3000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Evaluate(tt.logic, data)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		logic string
+		code  ErrorCode
+	}{
+		{"invalid JSON", `{">": [`, ErrInvalidJSON},
+		{"validation", `{"unknown_op": [1, 2]}`, ErrValidation},
+		{"type mismatch", `{">": [{"var": "status"}, 1]}`, ErrTypeMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Evaluate(tt.logic, map[string]any{"status": "active"})
+			var tpErr *TranspileError
+			if !errors.As(err, &tpErr) {
+				t.Fatalf("Evaluate() error = %v, want TranspileError", err)
+			}
+			if tpErr.Code != tt.code {
+				t.Errorf("error code = %s, want %s", tpErr.Code, tt.code)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]any
+		want bool
+	}{
+		{"true", map[string]any{"amount": 2000}, true},
+		{"false", map[string]any{"amount": 10}, false},
+		{"null excludes row", map[string]any{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Matches(`{">": [{"var": "amount"}, 1000]}`, tt.data)
+			if err != nil {
+				t.Fatalf("Matches() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// NOT of an unknown stays unknown and still excludes the row.
+	got, err := Matches(`{"!": {">": [{"var": "amount"}, 1000]}}`, map[string]any{})
+	if err != nil || got {
+		t.Errorf("Matches(NOT NULL) = %v, %v; want false, nil", got, err)
+	}
+}
+
+func TestEvaluateFromInterfaceAndNode(t *testing.T) {
+	logic := map[string]interface{}{"==": []interface{}{map[string]interface{}{"var": "a"}, 1.0}}
+	data := map[string]any{"a": 1}
+
+	got, err := EvaluateFromInterface(logic, data)
+	if err != nil || got != true {
+		t.Errorf("EvaluateFromInterface() = %v, %v; want true, nil", got, err)
+	}
+
+	node, err := ParseFromInterface(logic)
+	if err != nil {
+		t.Fatalf("ParseFromInterface() error = %v", err)
+	}
+	got, err = EvaluateNode(node, data)
+	if err != nil || got != true {
+		t.Errorf("EvaluateNode() = %v, %v; want true, nil", got, err)
+	}
+
+	if _, err := EvaluateNode(nil, data); err == nil {
+		t.Error("EvaluateNode(nil) expected error")
+	}
+}
@@ -0,0 +1,46 @@
+package jsonlogic2sql
+
+import (
+	"github.com/h22rana/jsonlogic2sql/internal/format"
+)
+
+// FormatOptions controls the multi-line layout of generated SQL, as set by
+// TranspilerConfig.Format:
+//
+//   - Indent is the number of spaces per nesting level (default 2).
+//   - KeywordCase selects the case of keywords such as AND, CASE and SELECT
+//     (default: as generated). Function names are kept as generated.
+//   - LineWidth is the width that lines are kept within where the SQL can be
+//     broken (default 80).
+//
+// SQL that fits in the line width stays on one line. Longer SQL puts each
+// AND/OR of a chain, each WHEN/ELSE of a CASE and each clause of a subquery
+// on its own line, and the contents of parentheses on indented lines:
+//
+//	WHERE (
+//	  status = 'active'
+//	  AND EXISTS (
+//	    SELECT 1
+//	    FROM UNNEST(tags) AS elem
+//	    WHERE elem = 'vip'
+//	  )
+//	)
+type FormatOptions = format.Options
+
+// KeywordCase selects how FormatOptions writes SQL keywords.
+type KeywordCase = format.KeywordCase
+
+// Keyword cases for FormatOptions.
+const (
+	KeywordCasePreserve = format.KeywordCasePreserve
+	KeywordCaseUpper    = format.KeywordCaseUpper
+	KeywordCaseLower    = format.KeywordCaseLower
+)
+
+// FormatSQL lays out SQL generated for dialect d over several lines, as
+// TranspilerConfig.Format does. It can format the output of a transpiler
+// configured without Format. SQL with unbalanced parentheses or quotes is
+// returned unchanged.
+func FormatSQL(sql string, d Dialect, opts FormatOptions) string {
+	return format.SQL(sql, d, opts)
+}
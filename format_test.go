@@ -0,0 +1,141 @@
+package jsonlogic2sql
+
+import (
+	"reflect"
+	"testing"
+)
+
+// formatRule has a boolean tree, an if and an array operator.
+const formatRule = `{"and": [
+	{"==": [{"var": "status"}, "active"]},
+	{"or": [{">": [{"var": "amount"}, 1000]}, {"some": [{"var": "tags"}, {"==": [{"var": ""}, "vip"]}]}]},
+	{"==": [{"if": [{">": [{"var": "age"}, 18]}, "adult", "minor"]}, "adult"]}
+]}`
+
+func TestNewTranspilerWithConfig_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		format   FormatOptions
+		expected string
+	}{
+		{
+			name:    "BigQuery",
+			dialect: DialectBigQuery,
+			format:  FormatOptions{LineWidth: 50},
+			expected: `WHERE (
+  status = 'active'
+  AND (
+    amount > 1000
+    OR EXISTS (
+      SELECT 1
+      FROM UNNEST(tags) AS elem
+      WHERE elem = 'vip'
+    )
+  )
+  AND CASE
+    WHEN age > 18 THEN 'adult'
+    ELSE 'minor'
+  END = 'adult'
+)`,
+		},
+		{
+			name:    "ClickHouse lower case",
+			dialect: DialectClickHouse,
+			format:  FormatOptions{Indent: 4, KeywordCase: KeywordCaseLower},
+			expected: `where (
+    status = 'active'
+    and (amount > 1000 or arrayExists(elem -> elem = 'vip', tags))
+    and case when age > 18 then 'adult' else 'minor' end = 'adult'
+)`,
+		},
+		{
+			name:     "wide line",
+			dialect:  DialectPostgreSQL,
+			format:   FormatOptions{LineWidth: 1000},
+			expected: "WHERE (status = 'active' AND (amount > 1000 OR EXISTS (SELECT 1 FROM UNNEST(tags) AS elem WHERE elem = 'vip')) AND CASE WHEN age > 18 THEN 'adult' ELSE 'minor' END = 'adult')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format := tt.format
+			tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: tt.dialect, Format: &format})
+			if err != nil {
+				t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+			}
+			got, err := tr.Transpile(formatRule)
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() =\n%s\nwant\n%s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewTranspilerWithConfig_FormatOutputs(t *testing.T) {
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{
+		Dialect: DialectPostgreSQL,
+		Format:  &FormatOptions{LineWidth: 20},
+	})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+	rule := `{"or": [{"==": [{"var": "status"}, "active"]}, {"<": [{"var": "amount"}, 10]}]}`
+
+	condition, err := tr.TranspileCondition(rule)
+	if err != nil {
+		t.Fatalf("TranspileCondition() unexpected error: %v", err)
+	}
+	if want := "(\n  status = 'active'\n  OR amount < 10\n)"; condition != want {
+		t.Errorf("TranspileCondition() = %q, want %q", condition, want)
+	}
+
+	sql, args, err := tr.TranspileParameterized(rule)
+	if err != nil {
+		t.Fatalf("TranspileParameterized() unexpected error: %v", err)
+	}
+	if want := "WHERE (\n  status = $1\n  OR amount < $2\n)"; sql != want {
+		t.Errorf("TranspileParameterized() sql = %q, want %q", sql, want)
+	}
+	if want := []any{"active", float64(10)}; !reflect.DeepEqual(args, want) {
+		t.Errorf("TranspileParameterized() args = %v, want %v", args, want)
+	}
+
+	node, err := Parse(rule)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	fromNode, err := tr.TranspileNode(node)
+	if err != nil {
+		t.Fatalf("TranspileNode() unexpected error: %v", err)
+	}
+	if want := "WHERE (\n  status = 'active'\n  OR amount < 10\n)"; fromNode != want {
+		t.Errorf("TranspileNode() = %q, want %q", fromNode, want)
+	}
+
+	if _, err := tr.Transpile(`{"unknown": [1]}`); err == nil {
+		t.Error("Transpile() expected an error for an unknown operator")
+	}
+}
+
+func TestNewTranspilerWithConfig_FormatValidation(t *testing.T) {
+	for _, format := range []FormatOptions{{Indent: -1}, {LineWidth: -1}} {
+		if _, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectPostgreSQL, Format: &format}); err == nil {
+			t.Errorf("NewTranspilerWithConfig() with %+v expected an error", format)
+		}
+	}
+}
+
+func TestFormatSQL(t *testing.T) {
+	sql, err := Transpile(DialectSQLServer, `{"and": [{"==": [{"var": "order"}, 1]}, {"!=": [{"var": "name"}, "x"]}]}`)
+	if err != nil {
+		t.Fatalf("Transpile() unexpected error: %v", err)
+	}
+	got := FormatSQL(sql, DialectSQLServer, FormatOptions{KeywordCase: KeywordCaseLower, LineWidth: 20})
+	if want := "where (\n  [order] = 1\n  and name != 'x'\n)"; got != want {
+		t.Errorf("FormatSQL() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+// Package ast defines a typed intermediate representation for JSON Logic rules.
+package ast
+
+import "encoding/json"
+
+// Node is a node in a parsed JSON Logic expression tree.
+type Node interface {
+	// Path returns the JSONPath of the node in the original rule (e.g. "$.and[0]").
+	// Nodes built by hand have an empty path.
+	Path() string
+	// JSONLogic converts the node back to its JSON Logic form.
+	JSONLogic() any
+	isNode()
+}
+
+// VarNode is a variable reference: {"var": "name"} or {"var": ["name", default]}.
+type VarNode struct {
+	Name     string
+	Default  Node // nil when no default value is given
+	JSONPath string
+}
+
+// LiteralNode is a primitive value: string, number, boolean or null.
+type LiteralNode struct {
+	Value    any
+	JSONPath string
+}
+
+// ArrayNode is an array literal whose elements may be any node.
+type ArrayNode struct {
+	Elements []Node
+	JSONPath string
+}
+
+// OpNode is an operator application such as {">": [a, b]}.
+// Operators written with a single non-array argument ({"!": x}, {"missing": "a"})
+// have Scalar set and exactly one element in Args.
+type OpNode struct {
+	Operator string
+	Args     []Node
+	Scalar   bool
+	JSONPath string
+}
+
+// Path returns the JSONPath of the node.
+func (n *VarNode) Path() string { return n.JSONPath }
+
+// Path returns the JSONPath of the node.
+func (n *LiteralNode) Path() string { return n.JSONPath }
+
+// Path returns the JSONPath of the node.
+func (n *ArrayNode) Path() string { return n.JSONPath }
+
+// Path returns the JSONPath of the node.
+func (n *OpNode) Path() string { return n.JSONPath }
+
+func (*VarNode) isNode()     {}
+func (*LiteralNode) isNode() {}
+func (*ArrayNode) isNode()   {}
+func (*OpNode) isNode()      {}
+
+// JSONLogic converts the node back to JSON Logic.
+func (n *VarNode) JSONLogic() any {
+	if n.Default == nil {
+		return map[string]any{"var": n.Name}
+	}
+	return map[string]any{"var": []any{n.Name, n.Default.JSONLogic()}}
+}
+
+// JSONLogic converts the node back to JSON Logic.
+func (n *LiteralNode) JSONLogic() any {
+	return n.Value
+}
+
+// JSONLogic converts the node back to JSON Logic.
+func (n *ArrayNode) JSONLogic() any {
+	elements := make([]any, len(n.Elements))
+	for i, elem := range n.Elements {
+		elements[i] = elem.JSONLogic()
+	}
+	return elements
+}
+
+// JSONLogic converts the node back to JSON Logic.
+func (n *OpNode) JSONLogic() any {
+	if n.Scalar && len(n.Args) == 1 {
+		return map[string]any{n.Operator: n.Args[0].JSONLogic()}
+	}
+	args := make([]any, len(n.Args))
+	for i, arg := range n.Args {
+		args[i] = arg.JSONLogic()
+	}
+	return map[string]any{n.Operator: args}
+}
+
+// MarshalJSON encodes the node as JSON Logic.
+func (n *VarNode) MarshalJSON() ([]byte, error) { return json.Marshal(n.JSONLogic()) }
+
+// MarshalJSON encodes the node as JSON Logic.
+func (n *LiteralNode) MarshalJSON() ([]byte, error) { return json.Marshal(n.JSONLogic()) }
+
+// MarshalJSON encodes the node as JSON Logic.
+func (n *ArrayNode) MarshalJSON() ([]byte, error) { return json.Marshal(n.JSONLogic()) }
+
+// MarshalJSON encodes the node as JSON Logic.
+func (n *OpNode) MarshalJSON() ([]byte, error) { return json.Marshal(n.JSONLogic()) }
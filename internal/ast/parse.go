@@ -0,0 +1,98 @@
+package ast
+
+import (
+	"fmt"
+
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// Parse converts a decoded JSON Logic value into an AST.
+// Parsing is purely structural: operators are not checked against the set of
+// supported operators, so custom operators parse like built-ins. Var forms
+// that are not a string name (e.g. {"var": 1}) are kept as an OpNode so no
+// information is lost; the SQL pass reports them.
+func Parse(logic any) (Node, error) {
+	return parseNode(logic, "$")
+}
+
+// parseNode converts a single value at the given JSONPath.
+func parseNode(value any, path string) (Node, error) {
+	switch v := value.(type) {
+	case nil, string, bool, float64, float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return &LiteralNode{Value: v, JSONPath: path}, nil
+	case []any:
+		elements := make([]Node, len(v))
+		for i, elem := range v {
+			node, err := parseNode(elem, tperrors.BuildArrayPath(path, i))
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = node
+		}
+		return &ArrayNode{Elements: elements, JSONPath: path}, nil
+	case map[string]any:
+		if len(v) != 1 {
+			return nil, tperrors.NewMultipleKeys(path)
+		}
+		for operator, args := range v {
+			return parseOperator(operator, args, path)
+		}
+	}
+	return nil, tperrors.New(tperrors.ErrInvalidExpression, "", path,
+		fmt.Sprintf("invalid expression type: %T", value))
+}
+
+// parseOperator converts a single-key operator object.
+func parseOperator(operator string, args any, path string) (Node, error) {
+	if operator == "var" {
+		if node, ok, err := parseVar(args, path); ok || err != nil {
+			return node, err
+		}
+	}
+
+	arr, isArray := args.([]any)
+	if !isArray {
+		arg, err := parseNode(args, tperrors.BuildPath(path, operator, -1))
+		if err != nil {
+			return nil, err
+		}
+		return &OpNode{Operator: operator, Args: []Node{arg}, Scalar: true, JSONPath: path}, nil
+	}
+
+	nodes := make([]Node, len(arr))
+	for i, arg := range arr {
+		node, err := parseNode(arg, tperrors.BuildPath(path, operator, i))
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	return &OpNode{Operator: operator, Args: nodes, JSONPath: path}, nil
+}
+
+// parseVar recognizes {"var": "name"}, {"var": ["name"]} and {"var": ["name", default]}.
+// It returns ok=false for any other shape.
+func parseVar(args any, path string) (Node, bool, error) {
+	if name, ok := args.(string); ok {
+		return &VarNode{Name: name, JSONPath: path}, true, nil
+	}
+
+	arr, ok := args.([]any)
+	if !ok || len(arr) == 0 || len(arr) > 2 {
+		return nil, false, nil
+	}
+	name, ok := arr[0].(string)
+	if !ok {
+		return nil, false, nil
+	}
+
+	node := &VarNode{Name: name, JSONPath: path}
+	if len(arr) == 2 {
+		def, err := parseNode(arr[1], tperrors.BuildPath(path, "var", 1))
+		if err != nil {
+			return nil, false, err
+		}
+		node.Default = def
+	}
+	return node, true, nil
+}
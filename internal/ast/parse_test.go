@@ -0,0 +1,130 @@
+package ast
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+func mustDecode(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("invalid test JSON %s: %v", s, err)
+	}
+	return v
+}
+
+func TestParse_NodeTypes(t *testing.T) {
+	node, err := Parse(mustDecode(t, `{"and": [{">": [{"var": "amount"}, 1000]}, {"in": [{"var": ["status", "new"]}, ["a", "b"]]}, {"!": {"var": "flag"}}]}`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	and, ok := node.(*OpNode)
+	if !ok || and.Operator != "and" || len(and.Args) != 3 || and.Path() != "$" {
+		t.Fatalf("root = %#v, want and OpNode with 3 args at $", node)
+	}
+
+	gt := and.Args[0].(*OpNode)
+	if gt.Operator != ">" || gt.Path() != "$.and[0]" {
+		t.Errorf("gt = %+v", gt)
+	}
+	amount := gt.Args[0].(*VarNode)
+	if amount.Name != "amount" || amount.Default != nil || amount.Path() != "$.and[0].>[0]" {
+		t.Errorf("amount = %+v", amount)
+	}
+	if lit := gt.Args[1].(*LiteralNode); lit.Value != float64(1000) {
+		t.Errorf("literal = %+v", lit)
+	}
+
+	in := and.Args[1].(*OpNode)
+	status := in.Args[0].(*VarNode)
+	if status.Name != "status" || status.Default.(*LiteralNode).Value != "new" {
+		t.Errorf("status = %+v", status)
+	}
+	list := in.Args[1].(*ArrayNode)
+	if len(list.Elements) != 2 || list.Elements[1].Path() != "$.and[1].in[1][1]" {
+		t.Errorf("list = %+v", list)
+	}
+
+	not := and.Args[2].(*OpNode)
+	if !not.Scalar || len(not.Args) != 1 || not.Args[0].Path() != "$.and[2].!" {
+		t.Errorf("not = %+v", not)
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	rules := []string{
+		`{"==": [{"var": "a"}, 1]}`,
+		`{"!": {"var": "a"}}`,
+		`{"!!": [{"var": "a"}]}`,
+		`{"missing": "a"}`,
+		`{"missing_some": [1, ["a", "b"]]}`,
+		`{"var": ["a", null]}`,
+		`{"in": [{"var": "a"}, ["x", 1, true, null]]}`,
+		`{"some": [{"var": "items"}, {">": [{"var": "item.price"}, 10]}]}`,
+		`{"reduce": [{"var": "xs"}, {"+": [{"var": "accumulator"}, {"var": "current"}]}, 0]}`,
+		`{"myCustom": [{"var": "a"}, "x"]}`,
+		`{"var": 1}`,
+		`{"var": []}`,
+	}
+
+	for _, rule := range rules {
+		t.Run(rule, func(t *testing.T) {
+			logic := mustDecode(t, rule)
+			node, err := Parse(logic)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := node.JSONLogic(); !reflect.DeepEqual(got, logic) {
+				t.Errorf("JSONLogic() = %#v, want %#v", got, logic)
+			}
+			encoded, err := json.Marshal(node)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if got := mustDecode(t, string(encoded)); !reflect.DeepEqual(got, logic) {
+				t.Errorf("json.Marshal() = %s, want %s", encoded, rule)
+			}
+		})
+	}
+}
+
+func TestParse_UnusualVarKeptAsOpNode(t *testing.T) {
+	node, err := Parse(mustDecode(t, `{"var": 1}`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if op, ok := node.(*OpNode); !ok || op.Operator != "var" || !op.Scalar {
+		t.Errorf("Parse() = %#v, want scalar var OpNode", node)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		rule any
+		code tperrors.ErrorCode
+		path string
+	}{
+		{"multiple keys", mustDecode(t, `{"and": [{"==": [1, 1], "!=": [1, 2]}]}`), tperrors.ErrMultipleKeys, "$.and[0]"},
+		{"invalid type", map[string]any{"==": []any{struct{}{}, 1}}, tperrors.ErrInvalidExpression, "$.==[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.rule)
+			var tpErr *tperrors.TranspileError
+			if !errors.As(err, &tpErr) {
+				t.Fatalf("Parse() error = %v, want TranspileError", err)
+			}
+			if tpErr.Code != tt.code || tpErr.Path != tt.path {
+				t.Errorf("Parse() error code/path = %s/%s, want %s/%s", tpErr.Code, tpErr.Path, tt.code, tt.path)
+			}
+		})
+	}
+}
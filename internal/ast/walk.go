@@ -0,0 +1,67 @@
+package ast
+
+// Children returns the direct child nodes of n.
+func Children(n Node) []Node {
+	switch v := n.(type) {
+	case *OpNode:
+		return v.Args
+	case *ArrayNode:
+		return v.Elements
+	case *VarNode:
+		if v.Default != nil {
+			return []Node{v.Default}
+		}
+	}
+	return nil
+}
+
+// Walk traverses the tree in depth-first pre-order, calling fn for every node.
+// If fn returns false, the children of that node are skipped.
+func Walk(n Node, fn func(Node) bool) {
+	if n == nil || !fn(n) {
+		return
+	}
+	for _, child := range Children(n) {
+		Walk(child, fn)
+	}
+}
+
+// Rewrite rebuilds the tree bottom-up, replacing every node with the result
+// of fn after its children have been rewritten. The input tree is not modified.
+func Rewrite(n Node, fn func(Node) Node) Node {
+	if n == nil {
+		return nil
+	}
+
+	switch v := n.(type) {
+	case *OpNode:
+		clone := *v
+		clone.Args = rewriteAll(v.Args, fn)
+		return fn(&clone)
+	case *ArrayNode:
+		clone := *v
+		clone.Elements = rewriteAll(v.Elements, fn)
+		return fn(&clone)
+	case *VarNode:
+		clone := *v
+		clone.Default = Rewrite(v.Default, fn)
+		return fn(&clone)
+	case *LiteralNode:
+		clone := *v
+		return fn(&clone)
+	default:
+		return fn(n)
+	}
+}
+
+// rewriteAll rewrites a slice of nodes into a new slice.
+func rewriteAll(nodes []Node, fn func(Node) Node) []Node {
+	if nodes == nil {
+		return nil
+	}
+	out := make([]Node, len(nodes))
+	for i, node := range nodes {
+		out[i] = Rewrite(node, fn)
+	}
+	return out
+}
@@ -0,0 +1,63 @@
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	node, err := Parse(mustDecode(t, `{"and": [{"==": [{"var": ["a", 1]}, 2]}, {"in": [{"var": "b"}, ["x", "y"]]}]}`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var vars []string
+	count := 0
+	Walk(node, func(n Node) bool {
+		count++
+		if v, ok := n.(*VarNode); ok {
+			vars = append(vars, v.Name)
+		}
+		return true
+	})
+	if !reflect.DeepEqual(vars, []string{"a", "b"}) {
+		t.Errorf("Walk() vars = %v, want [a b]", vars)
+	}
+	// and, ==, var a, default 1, 2, in, var b, array, x, y
+	if count != 10 {
+		t.Errorf("Walk() visited %d nodes, want 10", count)
+	}
+
+	skipped := 0
+	Walk(node, func(n Node) bool {
+		skipped++
+		_, isIn := n.(*OpNode)
+		return !isIn || n.(*OpNode).Operator == "and"
+	})
+	if skipped != 3 {
+		t.Errorf("Walk() with skipping visited %d nodes, want 3", skipped)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	original := mustDecode(t, `{"or": [{"==": [{"var": "old"}, 1]}, {"==": [{"var": "other"}, 2]}]}`)
+	node, err := Parse(original)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	rewritten := Rewrite(node, func(n Node) Node {
+		if v, ok := n.(*VarNode); ok && v.Name == "old" {
+			v.Name = "new"
+		}
+		return n
+	})
+
+	want := mustDecode(t, `{"or": [{"==": [{"var": "new"}, 1]}, {"==": [{"var": "other"}, 2]}]}`)
+	if got := rewritten.JSONLogic(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Rewrite() = %#v, want %#v", got, want)
+	}
+	if got := node.JSONLogic(); !reflect.DeepEqual(got, original) {
+		t.Errorf("Rewrite() modified the input tree: %#v", got)
+	}
+}
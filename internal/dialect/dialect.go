@@ -25,6 +25,29 @@ const (
 
 	// DialectClickHouse targets ClickHouse SQL syntax.
 	DialectClickHouse
+
+	// DialectMySQL targets MySQL 8 and MariaDB SQL syntax.
+	DialectMySQL
+
+	// DialectSQLite targets SQLite 3 SQL syntax with the JSON1 functions.
+	DialectSQLite
+
+	// DialectSnowflake targets Snowflake SQL syntax.
+	DialectSnowflake
+
+	// DialectSQLServer targets Microsoft SQL Server 2022 (T-SQL) syntax.
+	DialectSQLServer
+
+	// DialectTrino targets Trino SQL syntax, which is also used by Presto and
+	// Amazon Athena.
+	DialectTrino
+
+	// DialectOracle targets Oracle Database 19c and later SQL syntax.
+	DialectOracle
+
+	// DialectSparkSQL targets Apache Spark SQL 3.4 and later, as used by
+	// Databricks SQL warehouses.
+	DialectSparkSQL
 )
 
 // String returns the string representation of the dialect.
@@ -40,22 +63,44 @@ func (d Dialect) String() string {
 		return "DuckDB"
 	case DialectClickHouse:
 		return "ClickHouse"
+	case DialectMySQL:
+		return "MySQL"
+	case DialectSQLite:
+		return "SQLite"
+	case DialectSnowflake:
+		return "Snowflake"
+	case DialectSQLServer:
+		return "SQLServer"
+	case DialectTrino:
+		return "Trino"
+	case DialectOracle:
+		return "Oracle"
+	case DialectSparkSQL:
+		return "SparkSQL"
 	case DialectUnspecified:
 		return "Unspecified"
 	default:
+		if spec, ok := CustomSpec(d); ok {
+			return spec.Name()
+		}
 		return fmt.Sprintf("Unknown(%d)", int(d))
 	}
 }
 
-// IsValid returns true if the dialect is a valid, specified dialect.
+// IsValid returns true if the dialect is a built-in or registered dialect.
 func (d Dialect) IsValid() bool {
-	return d == DialectBigQuery || d == DialectSpanner || d == DialectPostgreSQL || d == DialectDuckDB || d == DialectClickHouse
+	for _, builtin := range builtinDialects {
+		if d == builtin {
+			return true
+		}
+	}
+	return d.IsCustom()
 }
 
 // Validate returns an error if the dialect is not valid.
 func (d Dialect) Validate() error {
 	if d == DialectUnspecified {
-		return fmt.Errorf("dialect not specified: must set Dialect in TranspilerConfig (use DialectBigQuery, DialectSpanner, DialectPostgreSQL, DialectDuckDB, or DialectClickHouse)")
+		return fmt.Errorf("dialect not specified: must set Dialect in TranspilerConfig (use DialectBigQuery, DialectSpanner, DialectPostgreSQL, DialectDuckDB, DialectClickHouse, DialectMySQL, DialectSQLite, DialectSnowflake, DialectSQLServer, DialectTrino, DialectOracle, DialectSparkSQL, or a registered dialect)")
 	}
 	if !d.IsValid() {
 		return fmt.Errorf("unsupported dialect: %s", d.String())
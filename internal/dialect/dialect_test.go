@@ -14,6 +14,13 @@ func TestDialect_String(t *testing.T) {
 		{DialectPostgreSQL, "PostgreSQL"},
 		{DialectDuckDB, "DuckDB"},
 		{DialectClickHouse, "ClickHouse"},
+		{DialectMySQL, "MySQL"},
+		{DialectSQLite, "SQLite"},
+		{DialectSnowflake, "Snowflake"},
+		{DialectSQLServer, "SQLServer"},
+		{DialectTrino, "Trino"},
+		{DialectOracle, "Oracle"},
+		{DialectSparkSQL, "SparkSQL"},
 		{DialectUnspecified, "Unspecified"},
 		{Dialect(999), "Unknown(999)"},
 	}
@@ -38,6 +45,13 @@ func TestDialect_IsValid(t *testing.T) {
 		{"PostgreSQL is valid", DialectPostgreSQL, true},
 		{"DuckDB is valid", DialectDuckDB, true},
 		{"ClickHouse is valid", DialectClickHouse, true},
+		{"MySQL is valid", DialectMySQL, true},
+		{"SQLite is valid", DialectSQLite, true},
+		{"Snowflake is valid", DialectSnowflake, true},
+		{"SQLServer is valid", DialectSQLServer, true},
+		{"Trino is valid", DialectTrino, true},
+		{"Oracle is valid", DialectOracle, true},
+		{"SparkSQL is valid", DialectSparkSQL, true},
 		{"Unspecified is not valid", DialectUnspecified, false},
 		{"Unknown dialect is not valid", Dialect(999), false},
 	}
@@ -62,6 +76,13 @@ func TestDialect_Validate(t *testing.T) {
 		{"PostgreSQL validates", DialectPostgreSQL, false},
 		{"DuckDB validates", DialectDuckDB, false},
 		{"ClickHouse validates", DialectClickHouse, false},
+		{"MySQL validates", DialectMySQL, false},
+		{"SQLite validates", DialectSQLite, false},
+		{"Snowflake validates", DialectSnowflake, false},
+		{"SQLServer validates", DialectSQLServer, false},
+		{"Trino validates", DialectTrino, false},
+		{"Oracle validates", DialectOracle, false},
+		{"SparkSQL validates", DialectSparkSQL, false},
 		{"Unspecified returns error", DialectUnspecified, true},
 		{"Unknown dialect returns error", Dialect(999), true},
 	}
@@ -0,0 +1,249 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// reservedKeywords contains words that are reserved in at least one supported
+// dialect and therefore cannot be used as bare column names.
+var reservedKeywords = map[string]bool{
+	"ALL": true, "AND": true, "ANY": true, "ARRAY": true, "AS": true, "ASC": true,
+	"BETWEEN": true, "BY": true, "CASE": true, "CAST": true, "COLLATE": true,
+	"CREATE": true, "CROSS": true, "DEFAULT": true, "DESC": true,
+	"DISTINCT": true, "ELSE": true, "END": true, "ENUM": true, "EXCEPT": true,
+	"EXISTS": true, "EXTRACT": true, "FALSE": true, "FETCH": true, "FOR": true,
+	"FROM": true, "FULL": true, "GROUP": true, "HAVING": true, "IF": true,
+	"IN": true, "INNER": true, "INTERSECT": true, "INTERVAL": true, "INTO": true,
+	"IS": true, "JOIN": true, "LATERAL": true, "LEFT": true, "LIKE": true,
+	"LIMIT": true, "NATURAL": true, "NOT": true, "NULL": true, "OFFSET": true,
+	"ON": true, "OR": true, "ORDER": true, "OUTER": true, "OVER": true,
+	"PARTITION": true, "RANGE": true, "RIGHT": true, "ROWS": true, "SELECT": true,
+	"SET": true, "SOME": true, "TABLE": true, "THEN": true, "TO": true,
+	"TRUE": true, "UNION": true, "UNNEST": true, "USING": true, "WHEN": true,
+	"WHERE": true, "WINDOW": true, "WITH": true,
+}
+
+// dialectReservedKeywords contains words that are only reserved in a single
+// dialect. They are quoted for that dialect alone so that common column names
+// such as "key" or "values" stay bare everywhere else.
+var dialectReservedKeywords = map[Dialect]map[string]bool{
+	DialectMySQL: {
+		"ADD": true, "ALTER": true, "BOTH": true, "CHANGE": true, "CHECK": true,
+		"COLUMN": true, "CONDITION": true, "CONSTRAINT": true, "CONVERT": true,
+		"CURRENT_DATE": true, "CURRENT_TIME": true, "CURRENT_TIMESTAMP": true,
+		"DATABASE": true, "DELETE": true, "DIV": true, "DROP": true, "DUAL": true,
+		"FORCE": true, "FOREIGN": true, "GROUPS": true, "IGNORE": true,
+		"INDEX": true, "INSERT": true, "KEY": true, "KEYS": true, "LEADING": true,
+		"LOAD": true, "LOCK": true, "MATCH": true, "MOD": true, "OPTION": true,
+		"PRIMARY": true, "RANK": true, "READ": true, "REFERENCES": true,
+		"REGEXP": true, "RENAME": true, "REPLACE": true, "RLIKE": true, "ROW": true,
+		"SCHEMA": true, "SHOW": true, "TRAILING": true, "UNIQUE": true,
+		"UPDATE": true, "USAGE": true, "USE": true, "VALUES": true, "WRITE": true,
+		"XOR": true,
+	},
+	DialectSQLite: {
+		"ABORT": true, "ADD": true, "ALTER": true, "AUTOINCREMENT": true,
+		"CHECK": true, "COLUMN": true, "COMMIT": true, "CONSTRAINT": true,
+		"DEFERRABLE": true, "DELETE": true, "DROP": true, "ESCAPE": true,
+		"FOREIGN": true, "GLOB": true, "INDEX": true, "INSERT": true,
+		"ISNULL": true, "NOTHING": true, "NOTNULL": true, "PRIMARY": true,
+		"RAISE": true, "REFERENCES": true, "REGEXP": true, "RETURNING": true,
+		"TRANSACTION": true, "TRIGGER": true, "UNIQUE": true, "UPDATE": true,
+		"VACUUM": true, "VALUES": true, "VIEW": true, "VIRTUAL": true,
+	},
+	DialectSnowflake: {
+		"ACCOUNT": true, "ALTER": true, "CHECK": true, "COLUMN": true,
+		"CONNECT": true, "CONNECTION": true, "CONSTRAINT": true, "CURRENT": true,
+		"CURRENT_DATE": true, "CURRENT_TIME": true, "CURRENT_TIMESTAMP": true,
+		"CURRENT_USER": true, "DATABASE": true, "DELETE": true, "DROP": true,
+		"FOLLOWING": true, "GRANT": true, "GSCLUSTER": true, "ILIKE": true,
+		"INCREMENT": true, "INSERT": true, "ISSUE": true, "LOCALTIME": true,
+		"LOCALTIMESTAMP": true, "MINUS": true, "OF": true, "ORGANIZATION": true,
+		"QUALIFY": true, "REGEXP": true, "REVOKE": true, "RLIKE": true, "ROW": true,
+		"SAMPLE": true, "SCHEMA": true, "START": true, "TABLESAMPLE": true,
+		"TRIGGER": true, "TRY_CAST": true, "UNIQUE": true, "UPDATE": true,
+		"VALUES": true, "VIEW": true, "WHENEVER": true,
+	},
+	DialectSQLServer: {
+		"ADD": true, "ALTER": true, "BACKUP": true, "BEGIN": true, "BREAK": true,
+		"BROWSE": true, "BULK": true, "CHECK": true, "CHECKPOINT": true,
+		"CLOSE": true, "CLUSTERED": true, "COLUMN": true, "COMMIT": true,
+		"COMPUTE": true, "CONSTRAINT": true, "CONTAINS": true, "CONTINUE": true,
+		"CONVERT": true, "CURRENT": true, "CURRENT_DATE": true,
+		"CURRENT_TIME": true, "CURRENT_TIMESTAMP": true, "CURRENT_USER": true,
+		"CURSOR": true, "DATABASE": true, "DEALLOCATE": true, "DECLARE": true,
+		"DELETE": true, "DENY": true, "DISK": true, "DOUBLE": true, "DROP": true,
+		"DUMP": true, "ESCAPE": true, "EXEC": true, "EXECUTE": true, "EXIT": true,
+		"EXTERNAL": true, "FILE": true, "FOREIGN": true, "FUNCTION": true,
+		"GOTO": true, "GRANT": true, "IDENTITY": true, "INDEX": true,
+		"INSERT": true, "KEY": true, "KILL": true, "LOAD": true, "MERGE": true,
+		"NATIONAL": true, "OF": true, "OFF": true, "OPEN": true, "OPENJSON": true,
+		"OPTION": true, "PERCENT": true, "PIVOT": true, "PLAN": true,
+		"PRECISION": true, "PRIMARY": true, "PRINT": true, "PROC": true,
+		"PROCEDURE": true, "PUBLIC": true, "READ": true, "REFERENCES": true,
+		"RESTORE": true, "RESTRICT": true, "RETURN": true, "REVOKE": true,
+		"ROLLBACK": true, "ROWCOUNT": true, "RULE": true, "SAVE": true,
+		"SCHEMA": true, "SESSION_USER": true, "STATISTICS": true,
+		"SYSTEM_USER": true, "TABLESAMPLE": true, "TOP": true, "TRAN": true,
+		"TRANSACTION": true, "TRIGGER": true, "TRUNCATE": true, "UNIQUE": true,
+		"UNPIVOT": true, "UPDATE": true, "USE": true, "USER": true,
+		"VALUES": true, "VIEW": true, "WHILE": true, "WITHIN": true,
+	},
+	DialectTrino: {
+		"ALTER": true, "CONSTRAINT": true, "CUBE": true, "CURRENT_CATALOG": true,
+		"CURRENT_DATE": true, "CURRENT_PATH": true, "CURRENT_ROLE": true,
+		"CURRENT_SCHEMA": true, "CURRENT_TIME": true, "CURRENT_TIMESTAMP": true,
+		"CURRENT_USER": true, "DEALLOCATE": true, "DELETE": true, "DESCRIBE": true,
+		"DROP": true, "ESCAPE": true, "EXECUTE": true, "GROUPING": true,
+		"INSERT": true, "JSON_ARRAY": true, "JSON_EXISTS": true,
+		"JSON_OBJECT": true, "JSON_QUERY": true, "JSON_TABLE": true,
+		"JSON_VALUE": true, "LISTAGG": true, "LOCALTIME": true,
+		"LOCALTIMESTAMP": true, "NORMALIZE": true, "PREPARE": true,
+		"RECURSIVE": true, "ROLLUP": true, "SKIP": true, "TRIM": true,
+		"UESCAPE": true, "VALUES": true,
+	},
+	DialectOracle: {
+		"ACCESS": true, "ADD": true, "ALTER": true, "AUDIT": true,
+		"CHECK": true, "CLUSTER": true, "COLUMN": true, "COMMENT": true,
+		"COMPRESS": true, "CONNECT": true, "CURRENT": true, "DATE": true,
+		"DECIMAL": true, "DELETE": true, "DROP": true, "EXCLUSIVE": true,
+		"FILE": true, "FLOAT": true, "GRANT": true, "IDENTIFIED": true,
+		"IMMEDIATE": true, "INCREMENT": true, "INDEX": true, "INITIAL": true,
+		"INSERT": true, "INTEGER": true, "LEVEL": true, "LOCK": true,
+		"LONG": true, "MAXEXTENTS": true, "MINUS": true, "MLSLABEL": true,
+		"MODE": true, "MODIFY": true, "NOAUDIT": true, "NOCOMPRESS": true,
+		"NOWAIT": true, "NUMBER": true, "OF": true, "OFFLINE": true,
+		"ONLINE": true, "OPTION": true, "PCTFREE": true, "PRIOR": true,
+		"PUBLIC": true, "RAW": true, "RENAME": true, "RESOURCE": true,
+		"REVOKE": true, "ROW": true, "ROWID": true, "ROWNUM": true,
+		"SESSION": true, "SHARE": true, "SIZE": true, "SMALLINT": true,
+		"START": true, "SUCCESSFUL": true, "SYNONYM": true, "SYSDATE": true,
+		"TRIGGER": true, "UID": true, "UNIQUE": true, "UPDATE": true,
+		"USER": true, "VALIDATE": true, "VALUES": true, "VARCHAR": true,
+		"VARCHAR2": true, "VIEW": true, "WHENEVER": true,
+	},
+	DialectSparkSQL: {
+		"AUTHORIZATION": true, "BOTH": true, "CHECK": true, "COLUMN": true,
+		"CONSTRAINT": true, "CURRENT_DATE": true, "CURRENT_TIME": true,
+		"CURRENT_TIMESTAMP": true, "CURRENT_USER": true, "ESCAPE": true,
+		"FILTER": true, "FOREIGN": true, "GRANT": true, "LEADING": true,
+		"ONLY": true, "OVERLAPS": true, "PRIMARY": true, "REFERENCES": true,
+		"SESSION_USER": true, "TIME": true, "TRAILING": true, "UNIQUE": true,
+		"UNKNOWN": true, "USER": true,
+	},
+}
+
+// IsReservedKeyword reports whether word is a reserved SQL keyword that must be
+// quoted to be used as an identifier.
+func IsReservedKeyword(word string) bool {
+	return reservedKeywords[strings.ToUpper(word)]
+}
+
+// IsReservedKeyword reports whether word must be quoted to be used as an
+// identifier in dialect d: either it is reserved in every dialect or it is
+// reserved by d specifically.
+func (d Dialect) IsReservedKeyword(word string) bool {
+	upper := strings.ToUpper(word)
+	if reservedKeywords[upper] {
+		return true
+	}
+	if spec, ok := CustomSpec(d); ok {
+		return spec.IsReservedKeyword(word)
+	}
+	return dialectReservedKeywords[d][upper]
+}
+
+// IsSafeIdentifier reports whether s matches the safe identifier grammar
+// [A-Za-z_][A-Za-z0-9_]* and can be emitted without quoting in every dialect
+// (provided it is not a reserved keyword).
+func IsSafeIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// SplitPath splits a dotted JSON Logic path into identifier segments.
+// Every dot is a separator, so "user.name" yields ["user", "name"]; empty
+// segments (leading, trailing or doubled dots) are rejected.
+func SplitPath(path string) ([]string, error) {
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid identifier %q: empty path segment", path)
+		}
+	}
+	return segments, nil
+}
+
+// QuoteIdentifier quotes a single identifier segment for the dialect.
+// BigQuery, Spanner and ClickHouse use backticks with backslash escapes;
+// MySQL and Spark SQL use backticks where embedded backticks are doubled;
+// SQL Server uses [brackets] where embedded closing brackets are doubled;
+// PostgreSQL, DuckDB, SQLite, Snowflake, Trino, Oracle and unspecified
+// dialects use standard double quotes where embedded quotes are doubled.
+// Registered dialects quote with their Spec.
+func (d Dialect) QuoteIdentifier(name string) (string, error) {
+	if err := validateIdentifierChars(name); err != nil {
+		return "", err
+	}
+	if spec, ok := CustomSpec(d); ok {
+		return spec.QuoteIdentifier(name)
+	}
+
+	//nolint:exhaustive // default handles PostgreSQL/DuckDB/SQLite/Snowflake/Trino/Oracle/unspecified
+	switch d {
+	case DialectBigQuery, DialectSpanner, DialectClickHouse:
+		escaped := strings.ReplaceAll(name, `\`, `\\`)
+		escaped = strings.ReplaceAll(escaped, "`", "\\`")
+		return "`" + escaped + "`", nil
+	case DialectMySQL, DialectSparkSQL:
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`", nil
+	case DialectSQLServer:
+		return "[" + strings.ReplaceAll(name, "]", "]]") + "]", nil
+	default:
+		return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`, nil
+	}
+}
+
+// FoldIdentifier returns the name under which the dialect stores an unquoted
+// identifier. Oracle and Snowflake fold unquoted names to upper case, so
+// quoting a name that was safe to leave bare must upper-case it to keep
+// referring to the same column. Other dialects return name unchanged.
+func (d Dialect) FoldIdentifier(name string) string {
+	//nolint:exhaustive // default handles the dialects that keep or lower-case names
+	switch d {
+	case DialectOracle, DialectSnowflake:
+		return strings.ToUpper(name)
+	default:
+		return name
+	}
+}
+
+// validateIdentifierChars rejects identifiers that cannot name a column in any
+// dialect: empty names, invalid UTF-8 and control characters.
+func validateIdentifierChars(name string) error {
+	if name == "" {
+		return fmt.Errorf("identifier cannot be empty")
+	}
+	if !utf8.ValidString(name) {
+		return fmt.Errorf("identifier is not valid UTF-8: %q", name)
+	}
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("identifier %q contains control characters", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package dialect
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsSafeIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"amount", true},
+		{"_private", true},
+		{"Col_9", true},
+		{"", false},
+		{"9lives", false},
+		{"first name", false},
+		{"a-b", false},
+		{"x;DROP", false},
+		{"naïve", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsSafeIdentifier(tt.input); got != tt.expected {
+				t.Errorf("IsSafeIdentifier(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsReservedKeyword(t *testing.T) {
+	for _, word := range []string{"order", "GROUP", "Select", "desc"} {
+		if !IsReservedKeyword(word) {
+			t.Errorf("IsReservedKeyword(%q) = false, want true", word)
+		}
+	}
+	for _, word := range []string{"amount", "item", "current", "status"} {
+		if IsReservedKeyword(word) {
+			t.Errorf("IsReservedKeyword(%q) = true, want false", word)
+		}
+	}
+}
+
+func TestDialect_IsReservedKeyword(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		word     string
+		expected bool
+	}{
+		{DialectMySQL, "order", true},
+		{DialectMySQL, "key", true},
+		{DialectMySQL, "Values", true},
+		{DialectMySQL, "amount", false},
+		{DialectSQLite, "glob", true},
+		{DialectSQLite, "key", false},
+		{DialectSnowflake, "qualify", true},
+		{DialectSnowflake, "Sample", true},
+		{DialectSnowflake, "index", false},
+		{DialectSQLServer, "key", true},
+		{DialectSQLServer, "Top", true},
+		{DialectSQLServer, "glob", false},
+		{DialectTrino, "values", true},
+		{DialectTrino, "Current_Date", true},
+		{DialectTrino, "key", false},
+		{DialectOracle, "level", true},
+		{DialectOracle, "Number", true},
+		{DialectOracle, "key", false},
+		{DialectSparkSQL, "filter", true},
+		{DialectSparkSQL, "User", true},
+		{DialectSparkSQL, "values", false},
+		{DialectPostgreSQL, "order", true},
+		{DialectPostgreSQL, "key", false},
+		{DialectBigQuery, "values", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String()+"/"+tt.word, func(t *testing.T) {
+			if got := tt.dialect.IsReservedKeyword(tt.word); got != tt.expected {
+				t.Errorf("IsReservedKeyword(%q) = %v, want %v", tt.word, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+		hasError bool
+	}{
+		{"amount", []string{"amount"}, false},
+		{"user.address.city", []string{"user", "address", "city"}, false},
+		{"first name.last", []string{"first name", "last"}, false},
+		{".leading", nil, true},
+		{"trailing.", nil, true},
+		{"double..dot", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := SplitPath(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("SplitPath(%q) expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SplitPath(%q) unexpected error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("SplitPath(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDialect_FoldIdentifier(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectOracle, "USER_ID"},
+		{DialectSnowflake, "USER_ID"},
+		{DialectPostgreSQL, "user_Id"},
+		{DialectBigQuery, "user_Id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			if got := tt.dialect.FoldIdentifier("user_Id"); got != tt.expected {
+				t.Errorf("FoldIdentifier() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDialect_QuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		input    string
+		expected string
+		hasError bool
+	}{
+		{DialectBigQuery, "order", "`order`", false},
+		{DialectBigQuery, "a`b", "`a\\`b`", false},
+		{DialectSpanner, `back\slash`, "`back\\\\slash`", false},
+		{DialectClickHouse, "first name", "`first name`", false},
+		{DialectPostgreSQL, "order", `"order"`, false},
+		{DialectPostgreSQL, `a"b`, `"a""b"`, false},
+		{DialectDuckDB, "first name", `"first name"`, false},
+		{DialectMySQL, "order", "`order`", false},
+		{DialectMySQL, "a`b", "`a``b`", false},
+		{DialectMySQL, `back\slash`, "`back\\slash`", false},
+		{DialectSQLite, "order", `"order"`, false},
+		{DialectSQLite, `a"b`, `"a""b"`, false},
+		{DialectSnowflake, "first name", `"first name"`, false},
+		{DialectSQLServer, "order", "[order]", false},
+		{DialectSQLServer, "a]b", "[a]]b]", false},
+		{DialectSQLServer, `a"b`, `[a"b]`, false},
+		{DialectTrino, "delete", `"delete"`, false},
+		{DialectTrino, `a"b`, `"a""b"`, false},
+		{DialectOracle, "comment", `"comment"`, false},
+		{DialectOracle, `a"b`, `"a""b"`, false},
+		{DialectSparkSQL, "filter", "`filter`", false},
+		{DialectSparkSQL, "a`b", "`a``b`", false},
+		{DialectSparkSQL, `back\slash`, "`back\\slash`", false},
+		{DialectUnspecified, "group", `"group"`, false},
+		{DialectBigQuery, "", "", true},
+		{DialectPostgreSQL, "nul\x00", "", true},
+		{DialectBigQuery, "line\nbreak", "", true},
+		{DialectDuckDB, "bad\xff", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String()+"/"+tt.input, func(t *testing.T) {
+			got, err := tt.dialect.QuoteIdentifier(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("QuoteIdentifier(%q) expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("QuoteIdentifier(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.expected {
+				t.Errorf("QuoteIdentifier(%q) = %s, want %s", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
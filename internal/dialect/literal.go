@@ -0,0 +1,157 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// QuoteString encodes s as a single SQL string literal for the dialect.
+// The returned literal is always closed by its final quote: no input can
+// terminate it early or inject SQL after it.
+//
+// BigQuery/Spanner (GoogleSQL), ClickHouse and Snowflake use backslash escapes.
+// Spanner shares the GoogleSQL escapes of BigQuery instead of doubling quotes.
+// MySQL uses backslash escapes as well, assuming the default sql_mode without
+// NO_BACKSLASH_ESCAPES. Spark SQL uses backslash escapes with \u escapes for
+// control characters.
+// PostgreSQL, DuckDB, SQLite, Trino and Oracle use standard SQL literals where
+// quotes are doubled and backslashes have no special meaning. SQL Server does
+// the same and adds the N prefix to literals with non-ASCII characters so they
+// are not narrowed to the database code page:
+//
+//	BigQuery:   'O\'Brien'  'C:\\temp'  'line1\nline2'
+//	PostgreSQL: 'O''Brien'  'C:\temp'
+//	SQL Server: 'O''Brien'  N'日本語'
+//
+// Registered dialects quote with their Spec.
+func (d Dialect) QuoteString(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", fmt.Errorf("string literal is not valid UTF-8: %q", s)
+	}
+	if spec, ok := CustomSpec(d); ok {
+		return spec.QuoteString(s)
+	}
+
+	//nolint:exhaustive // default handles PostgreSQL/DuckDB/SQLite/SQLServer/Trino/Oracle/unspecified
+	switch d {
+	case DialectBigQuery, DialectSpanner, DialectClickHouse, DialectSnowflake:
+		return quoteBackslashEscaped(s), nil
+	case DialectMySQL:
+		return quoteMySQL(s), nil
+	case DialectSparkSQL:
+		return quoteSparkSQL(s), nil
+	default:
+		if strings.ContainsRune(s, 0) {
+			return "", fmt.Errorf("string literal cannot contain NUL characters for dialect %s", d)
+		}
+		quoted := "'" + strings.ReplaceAll(s, "'", "''") + "'"
+		if d == DialectSQLServer && !isASCII(s) {
+			return "N" + quoted, nil
+		}
+		return quoted, nil
+	}
+}
+
+// isASCII reports whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// quoteBackslashEscaped quotes s using C-style backslash escapes.
+// Quotes, backslashes and all ASCII control characters are escaped; other
+// Unicode characters are emitted as-is since all target engines use UTF-8.
+func quoteBackslashEscaped(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\x%02x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+// quoteMySQL quotes s using the escape sequences MySQL recognizes.
+// MySQL has no \x escape, so NUL and Ctrl+Z use \0 and \Z and the remaining
+// control characters are emitted as-is, which MySQL accepts inside literals.
+func quoteMySQL(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case 0:
+			b.WriteString(`\0`)
+		case 0x1a:
+			b.WriteString(`\Z`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+// quoteSparkSQL quotes s using the escape sequences Spark SQL recognizes.
+// Control characters use \uXXXX escapes: a short form such as \0 would be
+// read as an octal escape when digits follow it.
+func quoteSparkSQL(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
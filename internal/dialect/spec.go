@@ -0,0 +1,203 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Spec describes how a dialect renders the SQL constructs that differ between
+// engines. Every built-in dialect has a Spec, and callers can implement one
+// for an engine the transpiler does not ship with and add it with Register.
+//
+// Operands are SQL fragments that have already been rendered, so a Spec only
+// arranges them; it never sees JSON Logic. Array bodies refer to the current
+// element as rendered by ArrayElement, and reduce bodies refer to the running
+// value as rendered by ArrayAccumulator.
+type Spec interface {
+	// Name returns the dialect name used by Dialect.String and in error messages.
+	Name() string
+
+	// QuoteIdentifier quotes a single identifier segment. The name is never
+	// empty and contains no control characters.
+	QuoteIdentifier(name string) (string, error)
+	// IsReservedKeyword reports whether word must be quoted to be used as an
+	// identifier. Words reserved in every dialect are quoted regardless.
+	IsReservedKeyword(word string) bool
+	// QuoteString encodes s as a single string literal. No input may
+	// terminate the literal early.
+	QuoteString(s string) (string, error)
+	// BoolLiteral returns the literal for a boolean value. A dialect whose true
+	// literal is 1 is treated as having no boolean type in conditions, so var
+	// conditions are compared with 1 and constant conditions become 1 = 1.
+	BoolLiteral(v bool) string
+	// CastToNumber casts operand to a numeric type that keeps the fractional part.
+	CastToNumber(operand string) string
+	// Modulo returns the remainder of the operands divided left to right.
+	Modulo(operands []string) string
+	// Greatest returns the largest of two or more operands.
+	Greatest(operands []string) string
+	// Least returns the smallest of two or more operands.
+	Least(operands []string) string
+
+	// Truthiness tests operand for JSON Logic truthiness: not NULL, not false,
+	// not zero and not the empty string.
+	Truthiness(operand string) string
+	// IsTrue tests whether a boolean operand is true, treating NULL as false.
+	IsTrue(operand string) string
+	// StringNotEmpty tests whether a string operand is neither NULL nor empty.
+	StringNotEmpty(operand string) string
+	// Conditional returns then when condition holds and otherwise when it does
+	// not. Otherwise is NULL when the rule has no else value.
+	Conditional(condition, then, otherwise string) string
+
+	// Concat concatenates one or more string operands.
+	Concat(operands []string) string
+	// Substring returns the part of str from the 1-based position start, with
+	// at most length characters. Length is empty to take the rest of the string.
+	Substring(str, start, length string) string
+	// StringPosition returns the 1-based position of needle in haystack, or 0
+	// when it does not occur.
+	StringPosition(haystack, needle string) string
+
+	// ArrayLiteral builds an array from rendered elements.
+	ArrayLiteral(elements []string) (string, error)
+	// ArrayContains tests whether value is an element of array.
+	ArrayContains(array, value string) (string, error)
+	// ArrayElement refers to the current element inside an array body, or to
+	// the field at path within it. Path holds the field names as written and
+	// quotedPath the same names quoted as identifiers; both are empty for the
+	// element itself.
+	ArrayElement(path, quotedPath []string) (string, error)
+	// ArrayMap evaluates body for every element of array.
+	ArrayMap(array, body string) (string, error)
+	// ArrayFilter keeps the elements of array for which condition holds.
+	ArrayFilter(array, condition string) (string, error)
+	// ArrayAll tests whether condition holds for every element of array.
+	ArrayAll(array, condition string) (string, error)
+	// ArraySome tests whether condition holds for at least one element of array.
+	ArraySome(array, condition string) (string, error)
+	// ArrayNone tests whether condition holds for no element of array.
+	ArrayNone(array, condition string) (string, error)
+	// ArrayAccumulator refers to the running value inside the body of a reduce
+	// that starts from initial.
+	ArrayAccumulator(initial string) string
+	// ArrayReduce folds array into a single value, starting from initial.
+	ArrayReduce(array, initial, body string) (string, error)
+	// ArrayAggregate folds array into a single value, starting from initial,
+	// for a reduce body that adds the element to the accumulator or takes the
+	// smaller or larger of the two. Function is SUM, MIN or MAX. Path and
+	// quotedPath name the field of the element that is read, as for
+	// ArrayElement, and are empty when the element itself is read.
+	ArrayAggregate(array, initial, function string, path, quotedPath []string) (string, error)
+	// ArrayMerge concatenates one or more arrays.
+	ArrayMerge(arrays []string) (string, error)
+	// ArrayNotEmpty tests whether a non-NULL array has at least one element.
+	ArrayNotEmpty(array string) (string, error)
+
+	// CurrentDate returns the current date, datetime or timestamp, as selected
+	// by kind.
+	CurrentDate(kind string) string
+	// DateAdd shifts date by interval.
+	DateAdd(date DateOperand, interval DateInterval) (string, error)
+	// DateDiff returns the number of whole units from start to end, compared
+	// as the temporal type kind.
+	DateDiff(end, start DateOperand, unit, kind string) (string, error)
+	// DateTrunc truncates date to the start of unit. Weeks start on Monday.
+	DateTrunc(date DateOperand, unit string) (string, error)
+
+	// JSONPath extracts the value at path from a JSON column. Path segments
+	// are object keys or array indexes. Scalar leaves are returned as the SQL
+	// type of leafType (string, integer, number or boolean), and array,
+	// object and json leaves as JSON.
+	JSONPath(column string, path []string, leafType string) (string, error)
+}
+
+// DateOperand is a rendered date operand and its temporal type: date,
+// datetime or timestamp.
+type DateOperand struct {
+	SQL  string
+	Kind string
+}
+
+// DateInterval is a number of date units that a date is shifted by. Units are
+// second, minute, hour, day, week, month and year.
+type DateInterval struct {
+	Unit string
+	// Sign is 1 to shift the date forward and -1 to shift it back.
+	Sign int64
+	// Amount renders the number of units multiplied by factor, such as Sign
+	// for a signed amount or 7 to count weeks in days.
+	Amount func(factor int64) (string, error)
+	// Modifier renders the number of units multiplied by factor as a string
+	// literal formatted with format, as by fmt.Sprintf, for engines that shift
+	// dates with text modifiers. It reports false when the number of units is
+	// not a literal.
+	Modifier func(format string, factor int64) (string, bool, error)
+}
+
+// firstCustomDialect is the first value handed out by Register. Values below
+// it are reserved for built-in dialects.
+const firstCustomDialect Dialect = 1000
+
+// builtinDialects lists every built-in dialect in declaration order.
+var builtinDialects = []Dialect{
+	DialectBigQuery, DialectSpanner, DialectPostgreSQL, DialectDuckDB, DialectClickHouse, DialectMySQL,
+	DialectSQLite, DialectSnowflake, DialectSQLServer, DialectTrino, DialectOracle, DialectSparkSQL,
+}
+
+var (
+	registryMu  sync.RWMutex
+	customSpecs = map[Dialect]Spec{}
+)
+
+// Register adds a custom dialect described by spec and returns the Dialect
+// value that selects it. The name must be non-empty and must not match a
+// built-in or already registered dialect, ignoring case.
+func Register(spec Spec) (Dialect, error) {
+	if spec == nil {
+		return DialectUnspecified, fmt.Errorf("dialect spec cannot be nil")
+	}
+	name := spec.Name()
+	if strings.TrimSpace(name) == "" {
+		return DialectUnspecified, fmt.Errorf("dialect name cannot be empty")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if strings.EqualFold(name, DialectUnspecified.String()) {
+		return DialectUnspecified, fmt.Errorf("dialect name %q is reserved", name)
+	}
+	for _, d := range builtinDialects {
+		if strings.EqualFold(name, d.String()) {
+			return DialectUnspecified, fmt.Errorf("dialect %q is already built in", name)
+		}
+	}
+	for _, registered := range customSpecs {
+		if strings.EqualFold(name, registered.Name()) {
+			return DialectUnspecified, fmt.Errorf("dialect %q is already registered", name)
+		}
+	}
+
+	d := firstCustomDialect + Dialect(len(customSpecs))
+	customSpecs[d] = spec
+	return d, nil
+}
+
+// CustomSpec returns the spec of a dialect added with Register.
+func CustomSpec(d Dialect) (Spec, bool) {
+	if d < firstCustomDialect {
+		return nil, false
+	}
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	spec, ok := customSpecs[d]
+	return spec, ok
+}
+
+// IsCustom reports whether the dialect was added with Register.
+func (d Dialect) IsCustom() bool {
+	_, ok := CustomSpec(d)
+	return ok
+}
@@ -0,0 +1,205 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// stubSpec is a minimal Spec that marks its output so dispatch can be checked.
+// It has no array support.
+type stubSpec struct {
+	name string
+}
+
+func (s stubSpec) Name() string {
+	return s.name
+}
+
+func (stubSpec) QuoteIdentifier(name string) (string, error) {
+	return "<" + name + ">", nil
+}
+
+func (stubSpec) IsReservedKeyword(word string) bool {
+	return strings.EqualFold(word, "period")
+}
+
+func (stubSpec) QuoteString(s string) (string, error) {
+	if strings.Contains(s, "'") {
+		return "", fmt.Errorf("quotes are not supported")
+	}
+	return "'" + s + "'", nil
+}
+
+func (stubSpec) BoolLiteral(v bool) string {
+	return fmt.Sprint(v)
+}
+
+func (stubSpec) CastToNumber(operand string) string {
+	return "NUM(" + operand + ")"
+}
+
+func (stubSpec) Modulo(operands []string) string {
+	return strings.Join(operands, " MOD ")
+}
+
+func (stubSpec) Greatest(operands []string) string {
+	return fmt.Sprintf("MAX(%s)", strings.Join(operands, ", "))
+}
+
+func (stubSpec) Least(operands []string) string {
+	return fmt.Sprintf("MIN(%s)", strings.Join(operands, ", "))
+}
+
+func (stubSpec) Truthiness(operand string) string     { return "TRUTHY(" + operand + ")" }
+func (stubSpec) IsTrue(operand string) string         { return operand + " = true" }
+func (stubSpec) StringNotEmpty(operand string) string { return "LEN(" + operand + ") > 0" }
+
+func (stubSpec) Conditional(condition, then, otherwise string) string {
+	return fmt.Sprintf("IF(%s, %s, %s)", condition, then, otherwise)
+}
+
+func (stubSpec) Concat(operands []string) string {
+	return strings.Join(operands, " + ")
+}
+
+func (stubSpec) Substring(str, start, length string) string {
+	return fmt.Sprintf("MID(%s, %s, %s)", str, start, length)
+}
+
+func (stubSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("POS(%s, %s)", haystack, needle)
+}
+
+func (stubSpec) ArrayLiteral([]string) (string, error)              { return "", errNoArrays }
+func (stubSpec) ArrayContains(string, string) (string, error)       { return "", errNoArrays }
+func (stubSpec) ArrayElement([]string, []string) (string, error)    { return "", errNoArrays }
+func (stubSpec) ArrayMap(string, string) (string, error)            { return "", errNoArrays }
+func (stubSpec) ArrayFilter(string, string) (string, error)         { return "", errNoArrays }
+func (stubSpec) ArrayAll(string, string) (string, error)            { return "", errNoArrays }
+func (stubSpec) ArraySome(string, string) (string, error)           { return "", errNoArrays }
+func (stubSpec) ArrayNone(string, string) (string, error)           { return "", errNoArrays }
+func (stubSpec) ArrayReduce(string, string, string) (string, error) { return "", errNoArrays }
+func (stubSpec) ArrayAccumulator(initial string) string             { return initial }
+func (stubSpec) ArrayAggregate(string, string, string, []string, []string) (string, error) {
+	return "", errNoArrays
+}
+func (stubSpec) ArrayMerge([]string) (string, error)  { return "", errNoArrays }
+func (stubSpec) ArrayNotEmpty(string) (string, error) { return "", errNoArrays }
+
+func (stubSpec) JSONPath(column string, path []string, _ string) (string, error) {
+	return fmt.Sprintf("JSON(%s, %s)", column, strings.Join(path, ".")), nil
+}
+
+func (stubSpec) CurrentDate(string) string { return "NOW()" }
+
+func (stubSpec) DateAdd(date DateOperand, interval DateInterval) (string, error) {
+	amount, err := interval.Amount(interval.Sign)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ADD_%sS(%s, %s)", strings.ToUpper(interval.Unit), date.SQL, amount), nil
+}
+
+func (stubSpec) DateDiff(end, start DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("%sS_BETWEEN(%s, %s)", strings.ToUpper(unit), start.SQL, end.SQL), nil
+}
+
+func (stubSpec) DateTrunc(date DateOperand, unit string) (string, error) {
+	return fmt.Sprintf("TRUNC(%s, '%s')", date.SQL, unit), nil
+}
+
+var errNoArrays = fmt.Errorf("arrays are not supported")
+
+// stubDialect is registered once per test binary, since registration is global.
+var stubDialect = mustRegister(stubSpec{name: "Stub"})
+
+func mustRegister(spec Spec) Dialect {
+	d, err := Register(spec)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestRegister(t *testing.T) {
+	if stubDialect < firstCustomDialect {
+		t.Fatalf("registered dialect = %d, want at least %d", int(stubDialect), int(firstCustomDialect))
+	}
+	if got := stubDialect.String(); got != "Stub" {
+		t.Errorf("String() = %q, want %q", got, "Stub")
+	}
+	if !stubDialect.IsValid() || !stubDialect.IsCustom() {
+		t.Errorf("registered dialect should be valid and custom")
+	}
+	if err := stubDialect.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if spec, ok := CustomSpec(stubDialect); !ok || spec.Name() != "Stub" {
+		t.Errorf("CustomSpec() = %v, %v", spec, ok)
+	}
+	for _, d := range builtinDialects {
+		if d.IsCustom() {
+			t.Errorf("%s.IsCustom() = true, want false", d)
+		}
+		if _, ok := CustomSpec(d); ok {
+			t.Errorf("CustomSpec(%s) found a spec for a built-in dialect", d)
+		}
+	}
+}
+
+func TestRegister_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec Spec
+		want string
+	}{
+		{"nil spec", nil, "cannot be nil"},
+		{"empty name", stubSpec{name: " "}, "cannot be empty"},
+		{"built-in name", stubSpec{name: "postgresql"}, "already built in"},
+		{"unspecified name", stubSpec{name: "Unspecified"}, "reserved"},
+		{"duplicate name", stubSpec{name: "STUB"}, "already registered"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Register(tt.spec)
+			if err == nil {
+				t.Fatalf("Register() = %s, want error", d)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Register() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomDialect_Quoting(t *testing.T) {
+	if got, err := stubDialect.QuoteIdentifier("order"); err != nil || got != "<order>" {
+		t.Errorf("QuoteIdentifier() = %q, %v", got, err)
+	}
+	if _, err := stubDialect.QuoteIdentifier("bad\x01name"); err == nil {
+		t.Errorf("QuoteIdentifier() should reject control characters before calling the spec")
+	}
+	if got, err := stubDialect.QuoteString("abc"); err != nil || got != "'abc'" {
+		t.Errorf("QuoteString() = %q, %v", got, err)
+	}
+	if _, err := stubDialect.QuoteString("O'Brien"); err == nil {
+		t.Errorf("QuoteString() should return the spec's error")
+	}
+
+	tests := []struct {
+		word     string
+		expected bool
+	}{
+		{"period", true},  // reserved by the spec
+		{"select", true},  // reserved in every dialect
+		{"values", false}, // only reserved by some built-in dialects
+		{"amount", false},
+	}
+	for _, tt := range tests {
+		if got := stubDialect.IsReservedKeyword(tt.word); got != tt.expected {
+			t.Errorf("IsReservedKeyword(%q) = %v, want %v", tt.word, got, tt.expected)
+		}
+	}
+}
@@ -27,6 +27,8 @@ const (
 	ErrValidation ErrorCode = "E006"
 	// ErrInvalidJSON indicates the input is not valid JSON.
 	ErrInvalidJSON ErrorCode = "E007"
+	// ErrInvalidSQL indicates SQL input could not be parsed.
+	ErrInvalidSQL ErrorCode = "E008"
 )
 
 // Operator-specific error codes (E100-E199).
@@ -37,6 +39,8 @@ const (
 	ErrOperatorRequiresArray ErrorCode = "E101"
 	// ErrCustomOperatorFailed indicates a custom operator returned an error.
 	ErrCustomOperatorFailed ErrorCode = "E102"
+	// ErrUnsupportedSQL indicates a SQL construct has no JSON Logic equivalent.
+	ErrUnsupportedSQL ErrorCode = "E103"
 )
 
 // Type/schema error codes (E200-E299).
@@ -74,6 +78,9 @@ type TranspileError struct {
 	Operator string
 	// Path is the JSONPath to the error location (e.g., "$.and[0].>").
 	Path string
+	// Line and Column locate the error in SQL input (1-based, 0 when not applicable).
+	Line   int
+	Column int
 	// Message is the human-readable error message.
 	Message string
 	// Cause is the underlying error, if any.
@@ -92,6 +99,11 @@ func (e *TranspileError) Error() string {
 		s += fmt.Sprintf(" at %s", e.Path)
 	}
 
+	// Add SQL position if available
+	if e.Line > 0 {
+		s += fmt.Sprintf(" at line %d, column %d", e.Line, e.Column)
+	}
+
 	// Add operator if available
 	if e.Operator != "" {
 		s += fmt.Sprintf(" (operator: %s)", e.Operator)
@@ -141,6 +153,8 @@ func (e *TranspileError) WithPath(path string) *TranspileError {
 		Code:     e.Code,
 		Operator: e.Operator,
 		Path:     path,
+		Line:     e.Line,
+		Column:   e.Column,
 		Message:  e.Message,
 		Cause:    e.Cause,
 	}
@@ -152,6 +166,8 @@ func (e *TranspileError) WithOperator(operator string) *TranspileError {
 		Code:     e.Code,
 		Operator: operator,
 		Path:     e.Path,
+		Line:     e.Line,
+		Column:   e.Column,
 		Message:  e.Message,
 		Cause:    e.Cause,
 	}
@@ -159,6 +175,18 @@ func (e *TranspileError) WithOperator(operator string) *TranspileError {
 
 // Helper constructors for common errors.
 
+// NewSQLError creates an error located at a line and column of SQL input.
+// The construct (e.g. "LIKE") is reported in the Operator field.
+func NewSQLError(code ErrorCode, construct string, line, column int, message string) *TranspileError {
+	return &TranspileError{
+		Code:     code,
+		Operator: construct,
+		Line:     line,
+		Column:   column,
+		Message:  message,
+	}
+}
+
 // NewUnsupportedOperator creates an error for an unsupported operator.
 func NewUnsupportedOperator(operator, path string) *TranspileError {
 	return New(ErrUnsupportedOperator, operator, path,
@@ -31,6 +31,17 @@ func TestTranspileError_Error(t *testing.T) {
 			},
 			expected: "[E007]: invalid JSON",
 		},
+		{
+			name: "error with SQL position",
+			err: &TranspileError{
+				Code:     ErrUnsupportedSQL,
+				Operator: "ILIKE",
+				Line:     2,
+				Column:   12,
+				Message:  "pattern matching has no JSON Logic equivalent",
+			},
+			expected: "[E103] at line 2, column 12 (operator: ILIKE): pattern matching has no JSON Logic equivalent",
+		},
 		{
 			name: "error without operator",
 			err: &TranspileError{
@@ -202,6 +213,23 @@ func TestNewUnsupportedOperator(t *testing.T) {
 	}
 }
 
+func TestNewSQLError(t *testing.T) {
+	err := NewSQLError(ErrInvalidSQL, "", 3, 7, "unexpected end of input")
+
+	if err.Code != ErrInvalidSQL {
+		t.Errorf("Code = %v, want %v", err.Code, ErrInvalidSQL)
+	}
+	if err.Line != 3 || err.Column != 7 {
+		t.Errorf("Line:Column = %d:%d, want 3:7", err.Line, err.Column)
+	}
+	if err.Path != "" {
+		t.Errorf("Path = %v, want empty", err.Path)
+	}
+	if got := err.WithOperator("x"); got.Line != 3 || got.Column != 7 {
+		t.Errorf("WithOperator() lost position: %d:%d", got.Line, got.Column)
+	}
+}
+
 func TestNewOperatorRequiresArray(t *testing.T) {
 	err := NewOperatorRequiresArray("and", "$.root")
 
@@ -454,10 +482,12 @@ func TestErrorCodes(t *testing.T) {
 		ErrArrayNotAllowed:     true,
 		ErrValidation:          true,
 		ErrInvalidJSON:         true,
+		ErrInvalidSQL:          true,
 		// Operator errors (E100-E199)
 		ErrUnsupportedOperator:   true,
 		ErrOperatorRequiresArray: true,
 		ErrCustomOperatorFailed:  true,
+		ErrUnsupportedSQL:        true,
 		// Type errors (E200-E299)
 		ErrTypeMismatch:     true,
 		ErrFieldNotInSchema: true,
@@ -472,7 +502,7 @@ func TestErrorCodes(t *testing.T) {
 	}
 
 	// Verify we have all expected codes
-	expectedCount := 19
+	expectedCount := 21
 	if len(codes) != expectedCount {
 		t.Errorf("Expected %d error codes, got %d", expectedCount, len(codes))
 	}
@@ -0,0 +1,205 @@
+package eval
+
+import (
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// evalLambda implements map, filter, all, some and none. The array is unnested
+// like UNNEST(arr), so a NULL array has no elements: all and none are TRUE and
+// some is FALSE for both NULL and empty arrays.
+func evalLambda(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 2, 2); err != nil {
+		return nil, err
+	}
+	elements, err := evalArray(n, s)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]any, 0, len(elements))
+	for _, elem := range elements {
+		v, err := eval(n.Args[1], s.withElement(elem))
+		if err != nil {
+			return nil, err
+		}
+
+		switch n.Operator {
+		case "map":
+			results = append(results, v)
+		case "filter":
+			if toCondition(v) == true {
+				results = append(results, elem)
+			}
+		case "all":
+			if toCondition(v) == false {
+				return false, nil
+			}
+		case "some":
+			if toCondition(v) == true {
+				return true, nil
+			}
+		case "none":
+			if toCondition(v) == true {
+				return false, nil
+			}
+		}
+	}
+
+	switch n.Operator {
+	case "all", "none":
+		return true, nil
+	case "some":
+		return false, nil
+	default:
+		return results, nil
+	}
+}
+
+// evalReduce implements "reduce". The accumulator/current patterns that the
+// transpiler turns into aggregates are evaluated as
+// initial + COALESCE(SUM|MIN|MAX(elements), 0) to match the generated SQL; any
+// other reducer is folded element by element.
+func evalReduce(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 3, 3); err != nil {
+		return nil, err
+	}
+	elements, err := evalArray(n, s)
+	if err != nil {
+		return nil, err
+	}
+	initial, err := eval(n.Args[2], s)
+	if err != nil {
+		return nil, err
+	}
+
+	if function, field, ok := aggregatePattern(n.Args[1]); ok {
+		return evalAggregate(n, function, field, elements, initial)
+	}
+
+	acc := initial
+	for _, elem := range elements {
+		inner := s.withElement(elem)
+		inner.acc = acc
+		inner.hasAcc = true
+		acc, err = eval(n.Args[1], inner)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return acc, nil
+}
+
+// evalAggregate evaluates a reduce that the transpiler emits as an aggregate.
+// The aggregate skips NULL elements and an empty result counts as 0.
+func evalAggregate(n *ast.OpNode, function, field string, elements []any, initial any) (any, error) {
+	var result float64
+	seen := false
+	for _, elem := range elements {
+		v := lookupPath(elem, field)
+		if v == nil {
+			continue
+		}
+		num, err := toNumber(n, v)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case !seen:
+			result = num
+		case function == "+":
+			result += num
+		case function == "min" && num < result, function == "max" && num > result:
+			result = num
+		}
+		seen = true
+	}
+
+	if initial == nil {
+		return nil, nil
+	}
+	start, err := toNumber(n, initial)
+	if err != nil {
+		return nil, err
+	}
+	return start + result, nil
+}
+
+// aggregatePattern recognizes reducers of the form
+// {"+"|"min"|"max": [{"var": "accumulator"}, {"var": "current[.field]"}]}.
+func aggregatePattern(reducer ast.Node) (string, string, bool) {
+	op, ok := reducer.(*ast.OpNode)
+	if !ok || len(op.Args) != 2 {
+		return "", "", false
+	}
+	switch op.Operator {
+	case "+", "min", "max":
+	default:
+		return "", "", false
+	}
+
+	acc, ok := op.Args[0].(*ast.VarNode)
+	if !ok || acc.Name != accumulatorVar || acc.Default != nil {
+		return "", "", false
+	}
+	current, ok := op.Args[1].(*ast.VarNode)
+	if !ok || current.Default != nil {
+		return "", "", false
+	}
+	if current.Name == currentVar {
+		return op.Operator, "", true
+	}
+	if field, ok := strings.CutPrefix(current.Name, currentVar+"."); ok && field != "" {
+		return op.Operator, field, true
+	}
+	return "", "", false
+}
+
+// evalMerge implements "merge" as ARRAY_CONCAT: a NULL argument yields NULL and
+// scalar arguments are treated as single-element arrays.
+func evalMerge(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 1, -1); err != nil {
+		return nil, err
+	}
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := []any{}
+	for _, v := range values {
+		switch t := v.(type) {
+		case nil:
+			return nil, nil
+		case []any:
+			merged = append(merged, t...)
+		default:
+			merged = append(merged, t)
+		}
+	}
+	return merged, nil
+}
+
+// evalArray evaluates the array operand of an array operator.
+// NULL is treated as an empty array.
+func evalArray(n *ast.OpNode, s *scope) ([]any, error) {
+	v, err := eval(n.Args[0], s)
+	if err != nil {
+		return nil, err
+	}
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		return t, nil
+	default:
+		return nil, tperrors.NewTypeMismatch(n.Operator, n.Args[0].Path(), "array", typeName(v))
+	}
+}
+
+// withElement returns a child scope in which item, current and elem refer to elem.
+func (s *scope) withElement(elem any) *scope {
+	return &scope{data: s.data, elem: elem, inArray: true, acc: s.acc, hasAcc: s.hasAcc}
+}
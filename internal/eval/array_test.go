@@ -0,0 +1,61 @@
+package eval
+
+import "testing"
+
+func TestEvaluate_ArrayLambdas(t *testing.T) {
+	data := map[string]any{
+		"scores":    []int{3, 8, 5},
+		"empty":     []any{},
+		"n":         nil,
+		"threshold": 4,
+		"items": []any{
+			map[string]any{"price": 10, "qty": 2},
+			map[string]any{"price": 25, "qty": 1},
+		},
+		"matrix": []any{[]any{1, 2}, []any{3}},
+	}
+	runEvalCases(t, []evalCase{
+		{"map", `{"map": [{"var": "scores"}, {"*": [{"var": "item"}, 2]}]}`, data, []any{6.0, 16.0, 10.0}, false},
+		{"filter", `{"filter": [{"var": "scores"}, {">": [{"var": "current"}, {"var": "threshold"}]}]}`, data, []any{8.0, 5.0}, false},
+		{"some", `{"some": [{"var": "items"}, {">": [{"var": "item.price"}, 20]}]}`, data, true, false},
+		{"some none match", `{"some": [{"var": "items"}, {">": [{"var": "item.price"}, 50]}]}`, data, false, false},
+		{"all", `{"all": [{"var": "scores"}, {">": [{"var": "elem"}, 2]}]}`, data, true, false},
+		{"all fails", `{"all": [{"var": "scores"}, {">": [{"var": "elem"}, 3]}]}`, data, false, false},
+		{"none", `{"none": [{"var": "scores"}, {">": [{"var": "item"}, 10]}]}`, data, true, false},
+		{"all empty is true", `{"all": [{"var": "empty"}, {">": [{"var": "item"}, 0]}]}`, data, true, false},
+		{"all null is true", `{"all": [{"var": "n"}, {">": [{"var": "item"}, 0]}]}`, data, true, false},
+		{"some null is false", `{"some": [{"var": "n"}, {">": [{"var": "item"}, 0]}]}`, data, false, false},
+		{"map null is empty", `{"map": [{"var": "n"}, {"var": "item"}]}`, data, []any{}, false},
+		{"nested lambdas", `{"some": [{"var": "matrix"}, {"in": [3, {"var": "item"}]}]}`, data, true, false},
+		{"non-array", `{"map": [{"var": "threshold"}, {"var": "item"}]}`, data, nil, true},
+	})
+}
+
+func TestEvaluate_Reduce(t *testing.T) {
+	data := map[string]any{
+		"scores": []any{3, nil, 8, 5},
+		"empty":  []any{},
+		"items": []any{
+			map[string]any{"price": 10, "qty": 2},
+			map[string]any{"price": 25, "qty": 1},
+		},
+	}
+	runEvalCases(t, []evalCase{
+		{"sum", `{"reduce": [{"var": "scores"}, {"+": [{"var": "accumulator"}, {"var": "current"}]}, 0]}`, data, float64(16), false},
+		{"sum field", `{"reduce": [{"var": "items"}, {"+": [{"var": "accumulator"}, {"var": "current.price"}]}, 5]}`, data, float64(40), false},
+		{"sum empty", `{"reduce": [{"var": "empty"}, {"+": [{"var": "accumulator"}, {"var": "current"}]}, 7]}`, data, float64(7), false},
+		// The generated SQL adds the aggregate to the initial value.
+		{"min aggregate", `{"reduce": [{"var": "scores"}, {"min": [{"var": "accumulator"}, {"var": "current"}]}, 0]}`, data, float64(3), false},
+		{"max aggregate", `{"reduce": [{"var": "scores"}, {"max": [{"var": "accumulator"}, {"var": "current"}]}, 1]}`, data, float64(9), false},
+		{"general fold", `{"reduce": [{"var": "items"}, {"+": [{"var": "accumulator"}, {"*": [{"var": "current.price"}, {"var": "current.qty"}]}]}, 0]}`, data, float64(45), false},
+	})
+}
+
+func TestEvaluate_Merge(t *testing.T) {
+	data := map[string]any{"a": []string{"x"}, "b": []any{"y", "z"}, "n": nil}
+	runEvalCases(t, []evalCase{
+		{"arrays", `{"merge": [{"var": "a"}, {"var": "b"}]}`, data, []any{"x", "y", "z"}, false},
+		{"scalars", `{"merge": [{"var": "a"}, "w"]}`, data, []any{"x", "w"}, false},
+		{"null propagates", `{"merge": [{"var": "a"}, {"var": "n"}]}`, data, nil, false},
+	})
+}
@@ -0,0 +1,223 @@
+package eval
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// evalEquality implements ==, ===, != and !==. A literal null operand turns the
+// comparison into IS NULL / IS NOT NULL; otherwise NULL operands yield NULL.
+func evalEquality(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 2, 2); err != nil {
+		return nil, err
+	}
+	negate := n.Operator == "!=" || n.Operator == "!=="
+
+	left, right := n.Args[0], n.Args[1]
+	if isNullLiteral(right) {
+		left, right = right, left
+	}
+	if isNullLiteral(left) {
+		v, err := eval(right, s)
+		if err != nil {
+			return nil, err
+		}
+		return (v == nil) != negate, nil
+	}
+
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+	if values[0] == nil || values[1] == nil {
+		return nil, nil
+	}
+	cmp, err := compareValues(n, values[0], values[1], true)
+	if err != nil {
+		return nil, err
+	}
+	return (cmp == 0) != negate, nil
+}
+
+// evalOrdering implements >, >=, < and <=. Three or more arguments chain as
+// (a < b AND b < c).
+func evalOrdering(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 2, -1); err != nil {
+		return nil, err
+	}
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []any
+	for i := 0; i < len(values)-1; i++ {
+		if values[i] == nil || values[i+1] == nil {
+			results = append(results, nil)
+			continue
+		}
+		cmp, err := compareValues(n, values[i], values[i+1], false)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		switch n.Operator {
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		default:
+			ok = cmp <= 0
+		}
+		results = append(results, ok)
+	}
+	return and3(results), nil
+}
+
+// evalIn implements "in". An array right operand tests membership like
+// x IN (...); a string right operand tests substring containment like
+// POSITION(x IN s) > 0.
+func evalIn(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 2, 2); err != nil {
+		return nil, err
+	}
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+	needle, haystack := values[0], values[1]
+	if needle == nil || haystack == nil {
+		return nil, nil
+	}
+
+	switch h := haystack.(type) {
+	case []any:
+		sawNull := false
+		for _, elem := range h {
+			if elem == nil {
+				sawNull = true
+				continue
+			}
+			cmp, err := compareValues(n, needle, elem, true)
+			if err != nil {
+				return nil, err
+			}
+			if cmp == 0 {
+				return true, nil
+			}
+		}
+		if sawNull {
+			return nil, nil
+		}
+		return false, nil
+	case string:
+		return strings.Contains(h, toText(needle)), nil
+	case float64:
+		return strings.Contains(toText(h), toText(needle)), nil
+	default:
+		return nil, tperrors.NewTypeMismatch(n.Operator, n.JSONPath, "array or string", typeName(haystack))
+	}
+}
+
+// isNullLiteral returns true if node is the literal null.
+func isNullLiteral(node ast.Node) bool {
+	lit, ok := node.(*ast.LiteralNode)
+	return ok && lit.Value == nil
+}
+
+// compareValues compares two non-NULL values and returns -1, 0 or 1.
+// A string compared with a number is cast to a number, as the database does
+// for a numeric literal in a string; other mixed types are a type error.
+// Arrays and objects can only be compared for equality.
+func compareValues(n *ast.OpNode, a, b any, equality bool) (int, error) {
+	switch av := a.(type) {
+	case float64:
+		switch bv := b.(type) {
+		case float64:
+			return compareFloats(av, bv), nil
+		case string:
+			if f, ok := parseNumber(bv); ok {
+				return compareFloats(av, f), nil
+			}
+		}
+	case string:
+		switch bv := b.(type) {
+		case string:
+			return strings.Compare(av, bv), nil
+		case float64:
+			if f, ok := parseNumber(av); ok {
+				return compareFloats(f, bv), nil
+			}
+		}
+	case bool:
+		if bv, ok := b.(bool); ok {
+			return compareBools(av, bv), nil
+		}
+	case []any, map[string]any:
+		if equality && reflect.TypeOf(a) == reflect.TypeOf(b) {
+			if reflect.DeepEqual(a, b) {
+				return 0, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, tperrors.New(tperrors.ErrTypeMismatch, n.Operator, n.JSONPath,
+		fmt.Sprintf("cannot compare %s with %s", typeName(a), typeName(b)))
+}
+
+// compareFloats compares two numbers.
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareBools orders FALSE before TRUE.
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// parseNumber parses a numeric string.
+func parseNumber(s string) (float64, bool) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return f, err == nil
+}
+
+// typeName describes a value's type for error messages.
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
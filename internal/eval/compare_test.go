@@ -0,0 +1,70 @@
+package eval
+
+import "testing"
+
+func TestEvaluate_Equality(t *testing.T) {
+	data := map[string]any{"a": 5, "s": "x", "n": nil, "b": true, "arr": []any{1, 2}}
+	runEvalCases(t, []evalCase{
+		{"numbers equal", `{"==": [{"var": "a"}, 5]}`, data, true, false},
+		{"numbers differ", `{"!=": [{"var": "a"}, 6]}`, data, true, false},
+		{"strict equal", `{"===": [{"var": "s"}, "x"]}`, data, true, false},
+		{"strict not equal", `{"!==": [{"var": "s"}, "x"]}`, data, false, false},
+		{"numeric string cast", `{"==": [{"var": "a"}, "5"]}`, data, true, false},
+		{"booleans", `{"==": [{"var": "b"}, true]}`, data, true, false},
+		{"arrays", `{"==": [{"var": "arr"}, {"var": "arr"}]}`, data, true, false},
+		{"null var yields null", `{"==": [{"var": "n"}, 5]}`, data, nil, false},
+		{"null var not equal yields null", `{"!=": [{"var": "n"}, 5]}`, data, nil, false},
+		{"two null vars yield null", `{"==": [{"var": "n"}, {"var": "missing"}]}`, data, nil, false},
+		{"literal null is IS NULL", `{"==": [{"var": "n"}, null]}`, data, true, false},
+		{"literal null left", `{"==": [null, {"var": "a"}]}`, data, false, false},
+		{"literal null IS NOT NULL", `{"!=": [{"var": "a"}, null]}`, data, true, false},
+		{"null is null", `{"==": [null, null]}`, data, true, false},
+		{"string vs number", `{"==": [{"var": "s"}, 5]}`, data, nil, true},
+		{"boolean vs number", `{"==": [{"var": "b"}, 1]}`, data, nil, true},
+	})
+}
+
+func TestEvaluate_Ordering(t *testing.T) {
+	data := map[string]any{"a": 5, "s": "m", "n": nil}
+	runEvalCases(t, []evalCase{
+		{"greater", `{">": [{"var": "a"}, 3]}`, data, true, false},
+		{"greater or equal", `{">=": [{"var": "a"}, 5]}`, data, true, false},
+		{"less", `{"<": [{"var": "a"}, 3]}`, data, false, false},
+		{"less or equal", `{"<=": [{"var": "a"}, 5]}`, data, true, false},
+		{"strings", `{"<": ["a", {"var": "s"}]}`, data, true, false},
+		{"numeric string", `{">": ["10", {"var": "a"}]}`, data, true, false},
+		{"chained between", `{"<": [1, {"var": "a"}, 10]}`, data, true, false},
+		{"chained outside", `{"<=": [1, {"var": "a"}, 4]}`, data, false, false},
+		{"null yields null", `{">": [{"var": "n"}, 3]}`, data, nil, false},
+		{"literal null yields null", `{">": [{"var": "a"}, null]}`, data, nil, false},
+		{"chained false beats null", `{"<": [{"var": "n"}, 10, 1]}`, data, false, false},
+		{"chained true and null", `{"<": [{"var": "n"}, 1, 10]}`, data, nil, false},
+		{"non-numeric string", `{">": [{"var": "s"}, 3]}`, data, nil, true},
+	})
+}
+
+func TestEvaluate_In(t *testing.T) {
+	data := map[string]any{
+		"status": "active",
+		"tags":   []string{"vip", "new"},
+		"mixed":  []any{"x", nil},
+		"text":   "hello world",
+		"code":   123,
+		"n":      nil,
+	}
+	runEvalCases(t, []evalCase{
+		{"literal array member", `{"in": [{"var": "status"}, ["active", "pending"]]}`, data, true, false},
+		{"literal array non-member", `{"in": [{"var": "status"}, ["closed"]]}`, data, false, false},
+		{"array var member", `{"in": ["vip", {"var": "tags"}]}`, data, true, false},
+		{"array var non-member", `{"in": ["old", {"var": "tags"}]}`, data, false, false},
+		{"null element makes non-member null", `{"in": ["y", {"var": "mixed"}]}`, data, nil, false},
+		{"null element member", `{"in": ["x", {"var": "mixed"}]}`, data, true, false},
+		{"string var contains", `{"in": ["world", {"var": "text"}]}`, data, true, false},
+		{"string var not contains", `{"in": ["mars", {"var": "text"}]}`, data, false, false},
+		{"string literal contains", `{"in": [{"var": "status"}, "inactive"]}`, data, true, false},
+		{"number literal contains", `{"in": [2, {"var": "code"}]}`, data, true, false},
+		{"null needle", `{"in": [{"var": "n"}, ["a"]]}`, data, nil, false},
+		{"null haystack", `{"in": ["a", {"var": "n"}]}`, data, nil, false},
+		{"object haystack", `{"in": ["a", {"var": ""}]}`, data, nil, true},
+	})
+}
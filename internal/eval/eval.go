@@ -0,0 +1,356 @@
+// Package eval evaluates JSON Logic ASTs in memory against a single record.
+//
+// Results follow the semantics of the SQL the transpiler generates rather than
+// those of the JSON Logic reference implementation, so that a rule evaluated
+// here agrees with the same rule evaluated by the database:
+//
+//   - nil is SQL NULL. Comparisons and arithmetic with NULL yield NULL, and/or/!
+//     use three-valued logic, and a CASE/if whose condition is NULL takes the
+//     else branch.
+//   - Comparing with a literal null ({"==": [x, null]}) is IS NULL / IS NOT NULL
+//     and never yields NULL.
+//   - "in" tests array membership for array operands and substring containment
+//     for string operands.
+//   - "substr" converts the 0-based start to a 1-based SQL position.
+//   - All numbers are float64.
+package eval
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// Lambda variable names bound by the array operators.
+const (
+	itemVar        = "item"
+	currentVar     = "current"
+	elemVar        = "elem"
+	accumulatorVar = "accumulator"
+)
+
+// scope holds the variables visible while evaluating a node.
+type scope struct {
+	data    map[string]any
+	elem    any
+	inArray bool
+	acc     any
+	hasAcc  bool
+}
+
+// Evaluate evaluates node against data and returns the result.
+// The result is nil (NULL), a bool, a float64, a string or a []any.
+func Evaluate(node ast.Node, data map[string]any) (any, error) {
+	if node == nil {
+		return nil, fmt.Errorf("node cannot be nil")
+	}
+	return eval(node, &scope{data: data})
+}
+
+// eval dispatches on the node type.
+func eval(node ast.Node, s *scope) (any, error) {
+	switch n := node.(type) {
+	case *ast.LiteralNode:
+		return normalize(n.Value), nil
+	case *ast.ArrayNode:
+		values := make([]any, len(n.Elements))
+		for i, elem := range n.Elements {
+			v, err := eval(elem, s)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	case *ast.VarNode:
+		return evalVar(n, s)
+	case *ast.OpNode:
+		return evalOp(n, s)
+	default:
+		return nil, tperrors.New(tperrors.ErrInvalidExpression, "", node.Path(),
+			fmt.Sprintf("invalid node type: %T", node))
+	}
+}
+
+// evalOp evaluates an operator node.
+func evalOp(n *ast.OpNode, s *scope) (any, error) {
+	switch n.Operator {
+	case "var":
+		return nil, tperrors.New(tperrors.ErrInvalidArgument, "var", n.JSONPath, "var requires a string name")
+	case "missing":
+		return evalMissing(n, s)
+	case "missing_some":
+		return evalMissingSome(n, s)
+	case "==", "===", "!=", "!==":
+		return evalEquality(n, s)
+	case ">", ">=", "<", "<=":
+		return evalOrdering(n, s)
+	case "in":
+		return evalIn(n, s)
+	case "and", "or":
+		return evalAndOr(n, s)
+	case "!":
+		return evalNot(n, s)
+	case "!!":
+		return evalTruthy(n, s)
+	case "if":
+		return evalIf(n, s)
+	case "+", "-", "*", "/", "%":
+		return evalArithmetic(n, s)
+	case "max", "min":
+		return evalMaxMin(n, s)
+	case "cat":
+		return evalCat(n, s)
+	case "substr":
+		return evalSubstr(n, s)
+	case "map", "filter", "all", "some", "none":
+		return evalLambda(n, s)
+	case "reduce":
+		return evalReduce(n, s)
+	case "merge":
+		return evalMerge(n, s)
+	default:
+		return nil, tperrors.NewUnsupportedOperator(n.Operator, n.JSONPath)
+	}
+}
+
+// evalArgs evaluates every argument of n in order.
+func evalArgs(n *ast.OpNode, s *scope) ([]any, error) {
+	values := make([]any, len(n.Args))
+	for i, arg := range n.Args {
+		v, err := eval(arg, s)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// requireArgs checks the argument count of n.
+func requireArgs(n *ast.OpNode, minArgs, maxArgs int) error {
+	if len(n.Args) < minArgs {
+		return tperrors.NewInsufficientArgs(n.Operator, n.JSONPath, minArgs, len(n.Args))
+	}
+	if maxArgs >= 0 && len(n.Args) > maxArgs {
+		return tperrors.NewTooManyArgs(n.Operator, n.JSONPath, maxArgs, len(n.Args))
+	}
+	return nil
+}
+
+// evalVar resolves a variable, falling back to its default like COALESCE.
+func evalVar(n *ast.VarNode, s *scope) (any, error) {
+	value := lookup(n.Name, s)
+	if value != nil || n.Default == nil {
+		return value, nil
+	}
+	return eval(n.Default, s)
+}
+
+// lookup resolves a dotted variable name. Inside array operators item, current
+// and elem refer to the current element and accumulator to the reduce
+// accumulator; every other name refers to the record, as a column would.
+func lookup(name string, s *scope) any {
+	head, rest, _ := strings.Cut(name, ".")
+	switch {
+	case s.inArray && (head == itemVar || head == currentVar || head == elemVar):
+		return lookupPath(s.elem, rest)
+	case s.inArray && name == "":
+		return s.elem
+	case s.hasAcc && head == accumulatorVar:
+		return lookupPath(s.acc, rest)
+	case name == "":
+		return normalize(s.data)
+	}
+
+	if value, ok := s.data[name]; ok {
+		return normalize(value)
+	}
+	return lookupPath(s.data, name)
+}
+
+// lookupPath walks a dotted path through nested maps and arrays.
+// Missing keys and out-of-range indexes resolve to nil.
+func lookupPath(value any, path string) any {
+	if path == "" {
+		return normalize(value)
+	}
+	current := normalize(value)
+	for _, segment := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			current = normalize(v[segment])
+		case []any:
+			index, ok := parseIndex(segment)
+			if !ok || index >= len(v) {
+				return nil
+			}
+			current = v[index]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+// parseIndex parses a non-negative array index.
+func parseIndex(segment string) (int, bool) {
+	if segment == "" {
+		return 0, false
+	}
+	index := 0
+	for i := 0; i < len(segment); i++ {
+		if segment[i] < '0' || segment[i] > '9' {
+			return 0, false
+		}
+		index = index*10 + int(segment[i]-'0')
+	}
+	return index, true
+}
+
+// evalMissing implements "missing": x IS NULL, or an OR over several fields.
+func evalMissing(n *ast.OpNode, s *scope) (any, error) {
+	names, err := missingNames(n, n.Args, s)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if lookup(name, s) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// evalMissingSome implements "missing_some": true when at least the given
+// number of fields are NULL.
+func evalMissingSome(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 2, 2); err != nil {
+		return nil, err
+	}
+	minValue, err := eval(n.Args[0], s)
+	if err != nil {
+		return nil, err
+	}
+	minCount, ok := minValue.(float64)
+	if !ok {
+		return nil, tperrors.New(tperrors.ErrInvalidArgument, n.Operator, n.JSONPath,
+			"missing_some requires a numeric minimum")
+	}
+
+	fields, ok := n.Args[1].(*ast.ArrayNode)
+	if !ok {
+		return nil, tperrors.New(tperrors.ErrInvalidArgument, n.Operator, n.JSONPath,
+			"missing_some requires an array of field names")
+	}
+	names, err := missingNames(n, fields.Elements, s)
+	if err != nil {
+		return nil, err
+	}
+
+	nullCount := 0
+	for _, name := range names {
+		if lookup(name, s) == nil {
+			nullCount++
+		}
+	}
+	return float64(nullCount) >= minCount, nil
+}
+
+// missingNames evaluates the field-name arguments of missing/missing_some.
+func missingNames(n *ast.OpNode, args []ast.Node, s *scope) ([]string, error) {
+	if len(args) == 1 {
+		if arr, ok := args[0].(*ast.ArrayNode); ok {
+			args = arr.Elements
+		}
+	}
+	names := make([]string, 0, len(args))
+	for _, arg := range args {
+		v, err := eval(arg, s)
+		if err != nil {
+			return nil, err
+		}
+		name, ok := v.(string)
+		if !ok {
+			return nil, tperrors.New(tperrors.ErrInvalidArgument, n.Operator, arg.Path(),
+				fmt.Sprintf("field name must be a string, got %T", v))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// normalize converts Go values from the data map to the evaluator's value
+// model: float64 numbers, []any arrays and map[string]any objects.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case nil, bool, string, float64, map[string]any:
+		return v
+	case []any:
+		out := make([]any, len(v))
+		for i, elem := range v {
+			out[i] = normalize(elem)
+		}
+		return out
+	case float32:
+		return float64(v)
+	case int:
+		return float64(v)
+	case int8:
+		return float64(v)
+	case int16:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint:
+		return float64(v)
+	case uint8:
+		return float64(v)
+	case uint16:
+		return float64(v)
+	case uint32:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case interface{ Float64() (float64, error) }:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return value
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() { //nolint:exhaustive // default returns the value unchanged
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalize(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		out := make([]any, rv.Len())
+		for i := range out {
+			out[i] = normalize(rv.Index(i).Interface())
+		}
+		return out
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return value
+		}
+		out := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			out[iter.Key().String()] = iter.Value().Interface()
+		}
+		return out
+	default:
+		return value
+	}
+}
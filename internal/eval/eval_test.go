@@ -0,0 +1,132 @@
+package eval
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// evalCase is a single table-driven evaluation case.
+type evalCase struct {
+	name    string
+	logic   string
+	data    map[string]any
+	want    any
+	wantErr bool
+}
+
+// evaluate parses logic and evaluates it against data.
+func evaluate(t *testing.T, logic string, data map[string]any) (any, error) {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(logic), &v); err != nil {
+		t.Fatalf("invalid test JSON %s: %v", logic, err)
+	}
+	node, err := ast.Parse(v)
+	if err != nil {
+		t.Fatalf("ast.Parse(%s) error = %v", logic, err)
+	}
+	return Evaluate(node, data)
+}
+
+// runEvalCases runs a table of evaluation cases.
+func runEvalCases(t *testing.T, tests []evalCase) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evaluate(t, tt.logic, tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Evaluate(%s) = %v, want error", tt.logic, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Evaluate(%s) error = %v", tt.logic, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate(%s) = %#v, want %#v", tt.logic, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_Var(t *testing.T) {
+	data := map[string]any{
+		"amount":     int64(42),
+		"name":       "alice",
+		"user":       map[string]any{"profile": map[string]any{"age": 30}},
+		"tags":       []string{"a", "b"},
+		"flat.key":   "flat",
+		"nothing":    nil,
+		"ptr_amount": func() *int { v := 7; return &v }(),
+	}
+	runEvalCases(t, []evalCase{
+		{"integer normalized", `{"var": "amount"}`, data, float64(42), false},
+		{"string", `{"var": "name"}`, data, "alice", false},
+		{"nested path", `{"var": "user.profile.age"}`, data, float64(30), false},
+		{"flat dotted key", `{"var": "flat.key"}`, data, "flat", false},
+		{"array index", `{"var": "tags.1"}`, data, "b", false},
+		{"index out of range", `{"var": "tags.5"}`, data, nil, false},
+		{"typed slice", `{"var": "tags"}`, data, []any{"a", "b"}, false},
+		{"pointer", `{"var": "ptr_amount"}`, data, float64(7), false},
+		{"missing is null", `{"var": "unknown"}`, data, nil, false},
+		{"missing nested is null", `{"var": "user.address.city"}`, data, nil, false},
+		{"default when missing", `{"var": ["unknown", 5]}`, data, float64(5), false},
+		{"default when null", `{"var": ["nothing", "x"]}`, data, "x", false},
+		{"default unused", `{"var": ["name", "x"]}`, data, "alice", false},
+		{"non-string var", `{"var": 1}`, data, nil, true},
+	})
+}
+
+func TestEvaluate_Missing(t *testing.T) {
+	data := map[string]any{"a": 1, "b": nil, "c": "x"}
+	runEvalCases(t, []evalCase{
+		{"present", `{"missing": "a"}`, data, false, false},
+		{"null", `{"missing": "b"}`, data, true, false},
+		{"absent", `{"missing": "z"}`, data, true, false},
+		{"list any missing", `{"missing": ["a", "z"]}`, data, true, false},
+		{"list none missing", `{"missing": ["a", "c"]}`, data, false, false},
+		{"nested list", `{"missing": [["a", "c"]]}`, data, false, false},
+		{"some min 1", `{"missing_some": [1, ["a", "b"]]}`, data, true, false},
+		{"some min 2 one null", `{"missing_some": [2, ["a", "b", "c"]]}`, data, false, false},
+		{"some min 2 two null", `{"missing_some": [2, ["a", "b", "z"]]}`, data, true, false},
+		{"some non-numeric min", `{"missing_some": ["x", ["a"]]}`, data, nil, true},
+	})
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		logic string
+		code  tperrors.ErrorCode
+		path  string
+	}{
+		{"unsupported operator", `{"and": [{"custom": [1]}]}`, tperrors.ErrUnsupportedOperator, "$.and[0]"},
+		{"too few args", `{"==": [1]}`, tperrors.ErrInsufficientArgs, "$"},
+		{"type mismatch", `{">": [{"var": "flag"}, 1]}`, tperrors.ErrTypeMismatch, "$"},
+		{"division by zero", `{"/": [1, 0]}`, tperrors.ErrInvalidArgument, "$"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := evaluate(t, tt.logic, map[string]any{"flag": true})
+			var tpErr *tperrors.TranspileError
+			if !errors.As(err, &tpErr) {
+				t.Fatalf("error = %v, want TranspileError", err)
+			}
+			if tpErr.Code != tt.code || tpErr.Path != tt.path {
+				t.Errorf("error code/path = %s/%s, want %s/%s", tpErr.Code, tpErr.Path, tt.code, tt.path)
+			}
+		})
+	}
+}
+
+func TestEvaluate_NilNode(t *testing.T) {
+	if _, err := Evaluate(nil, nil); err == nil {
+		t.Error("Evaluate(nil) expected error")
+	}
+}
@@ -0,0 +1,148 @@
+package eval
+
+import (
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+)
+
+// evalAndOr implements "and" and "or" with SQL three-valued logic.
+// A single argument is returned unchanged, as the generated SQL unwraps it.
+func evalAndOr(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 1, -1); err != nil {
+		return nil, err
+	}
+	if len(n.Args) == 1 {
+		return eval(n.Args[0], s)
+	}
+
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+	conditions := make([]any, len(values))
+	for i, v := range values {
+		conditions[i] = toCondition(v)
+	}
+	if n.Operator == "and" {
+		return and3(conditions), nil
+	}
+	return or3(conditions), nil
+}
+
+// evalNot implements "!" as NOT (x): NOT NULL is NULL.
+func evalNot(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 1, 1); err != nil {
+		return nil, err
+	}
+	v, err := eval(n.Args[0], s)
+	if err != nil {
+		return nil, err
+	}
+	switch c := toCondition(v).(type) {
+	case bool:
+		return !c, nil
+	default:
+		return nil, nil
+	}
+}
+
+// evalTruthy implements "!!". Like the generated IS NOT NULL AND x != FALSE ...
+// check, the result is never NULL.
+func evalTruthy(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 1, 1); err != nil {
+		return nil, err
+	}
+	v, err := eval(n.Args[0], s)
+	if err != nil {
+		return nil, err
+	}
+	return truthy(v), nil
+}
+
+// evalIf implements "if" as CASE WHEN ... THEN ... ELSE ... END.
+// A NULL condition is not taken and a missing else branch yields NULL.
+func evalIf(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 1, -1); err != nil {
+		return nil, err
+	}
+	if len(n.Args) == 1 {
+		return eval(n.Args[0], s)
+	}
+
+	i := 0
+	for ; i+1 < len(n.Args); i += 2 {
+		cond, err := eval(n.Args[i], s)
+		if err != nil {
+			return nil, err
+		}
+		if toCondition(cond) == true {
+			return eval(n.Args[i+1], s)
+		}
+	}
+	if i < len(n.Args) {
+		return eval(n.Args[i], s)
+	}
+	return nil, nil
+}
+
+// and3 combines conditions with SQL AND: FALSE wins, then NULL.
+func and3(conditions []any) any {
+	sawNull := false
+	for _, c := range conditions {
+		switch c {
+		case false:
+			return false
+		case nil:
+			sawNull = true
+		}
+	}
+	if sawNull {
+		return nil
+	}
+	return true
+}
+
+// or3 combines conditions with SQL OR: TRUE wins, then NULL.
+func or3(conditions []any) any {
+	sawNull := false
+	for _, c := range conditions {
+		switch c {
+		case true:
+			return true
+		case nil:
+			sawNull = true
+		}
+	}
+	if sawNull {
+		return nil
+	}
+	return false
+}
+
+// toCondition converts a value used as a condition to TRUE, FALSE or NULL.
+// Non-boolean values use JSON Logic truthiness.
+func toCondition(v any) any {
+	switch c := v.(type) {
+	case nil, bool:
+		return c
+	default:
+		return truthy(v)
+	}
+}
+
+// truthy reports whether a value is truthy: not NULL, FALSE, 0, "" or an empty array.
+func truthy(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return t
+	case float64:
+		return t != 0
+	case string:
+		return t != ""
+	case []any:
+		return len(t) > 0
+	default:
+		return true
+	}
+}
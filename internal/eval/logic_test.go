@@ -0,0 +1,44 @@
+package eval
+
+import "testing"
+
+func TestEvaluate_AndOr(t *testing.T) {
+	data := map[string]any{"t": true, "f": false, "n": nil, "zero": 0, "s": "x"}
+	runEvalCases(t, []evalCase{
+		{"and true", `{"and": [{"var": "t"}, true]}`, data, true, false},
+		{"and false", `{"and": [{"var": "t"}, {"var": "f"}]}`, data, false, false},
+		{"and null", `{"and": [{"var": "t"}, {"var": "n"}]}`, data, nil, false},
+		{"and false beats null", `{"and": [{"var": "n"}, {"var": "f"}]}`, data, false, false},
+		{"or true beats null", `{"or": [{"var": "n"}, {"var": "t"}]}`, data, true, false},
+		{"or null", `{"or": [{"var": "n"}, {"var": "f"}]}`, data, nil, false},
+		{"or false", `{"or": [{"var": "f"}, false]}`, data, false, false},
+		{"single arg unwrapped", `{"and": [{"var": "s"}]}`, data, "x", false},
+		{"non-boolean truthiness", `{"or": [{"var": "zero"}, {"var": "s"}]}`, data, true, false},
+	})
+}
+
+func TestEvaluate_Not(t *testing.T) {
+	data := map[string]any{"t": true, "n": nil, "s": ""}
+	runEvalCases(t, []evalCase{
+		{"not true", `{"!": {"var": "t"}}`, data, false, false},
+		{"not array form", `{"!": [{"var": "t"}]}`, data, false, false},
+		{"not null is null", `{"!": {"var": "n"}}`, data, nil, false},
+		{"not empty string", `{"!": {"var": "s"}}`, data, true, false},
+		{"double bang null", `{"!!": {"var": "n"}}`, data, false, false},
+		{"double bang empty string", `{"!!": {"var": "s"}}`, data, false, false},
+		{"double bang zero", `{"!!": 0}`, data, false, false},
+		{"double bang empty array", `{"!!": [[]]}`, data, false, false},
+		{"double bang array", `{"!!": [[0]]}`, data, true, false},
+	})
+}
+
+func TestEvaluate_If(t *testing.T) {
+	data := map[string]any{"score": 75, "n": nil}
+	runEvalCases(t, []evalCase{
+		{"then branch", `{"if": [{">": [{"var": "score"}, 50]}, "pass", "fail"]}`, data, "pass", false},
+		{"else branch", `{"if": [{">": [{"var": "score"}, 90]}, "pass", "fail"]}`, data, "fail", false},
+		{"else if", `{"if": [{">": [{"var": "score"}, 90]}, "A", {">": [{"var": "score"}, 70]}, "B", "C"]}`, data, "B", false},
+		{"no else is null", `{"if": [{">": [{"var": "score"}, 90]}, "A"]}`, data, nil, false},
+		{"null condition takes else", `{"if": [{">": [{"var": "n"}, 1]}, "yes", "no"]}`, data, "no", false},
+	})
+}
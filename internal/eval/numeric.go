@@ -0,0 +1,118 @@
+package eval
+
+import (
+	"math"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// evalArithmetic implements +, -, *, / and %. Any NULL operand yields NULL and
+// division or modulo by zero is an error, as it is in the database.
+func evalArithmetic(n *ast.OpNode, s *scope) (any, error) {
+	minArgs, maxArgs := 1, -1
+	switch n.Operator {
+	case "*", "/":
+		minArgs = 2
+	case "%":
+		minArgs, maxArgs = 2, 2
+	}
+	if err := requireArgs(n, minArgs, maxArgs); err != nil {
+		return nil, err
+	}
+
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+	nums := make([]float64, len(values))
+	for i, v := range values {
+		if v == nil {
+			return nil, nil
+		}
+		num, err := toNumber(n, v)
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = num
+	}
+
+	switch n.Operator {
+	case "+":
+		result := 0.0
+		for _, num := range nums {
+			result += num
+		}
+		return result, nil
+	case "*":
+		result := 1.0
+		for _, num := range nums {
+			result *= num
+		}
+		return result, nil
+	case "-":
+		if len(nums) == 1 {
+			return -nums[0], nil
+		}
+		result := nums[0]
+		for _, num := range nums[1:] {
+			result -= num
+		}
+		return result, nil
+	case "/":
+		result := nums[0]
+		for _, num := range nums[1:] {
+			if num == 0 {
+				return nil, tperrors.New(tperrors.ErrInvalidArgument, n.Operator, n.JSONPath, "division by zero")
+			}
+			result /= num
+		}
+		return result, nil
+	default:
+		if nums[1] == 0 {
+			return nil, tperrors.New(tperrors.ErrInvalidArgument, n.Operator, n.JSONPath, "division by zero")
+		}
+		return math.Mod(nums[0], nums[1]), nil
+	}
+}
+
+// evalMaxMin implements max and min as GREATEST and LEAST: any NULL argument
+// yields NULL.
+func evalMaxMin(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 1, -1); err != nil {
+		return nil, err
+	}
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+
+	var result float64
+	for i, v := range values {
+		if v == nil {
+			return nil, nil
+		}
+		num, err := toNumber(n, v)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 || (n.Operator == "max" && num > result) || (n.Operator == "min" && num < result) {
+			result = num
+		}
+	}
+	return result, nil
+}
+
+// toNumber converts a non-NULL operand to a number. Numeric strings are cast;
+// anything else is a type error.
+func toNumber(n *ast.OpNode, v any) (float64, error) {
+	switch t := v.(type) {
+	case float64:
+		return t, nil
+	case string:
+		if f, ok := parseNumber(t); ok {
+			return f, nil
+		}
+	}
+	return 0, tperrors.NewTypeMismatch(n.Operator, n.JSONPath, "number", typeName(v))
+}
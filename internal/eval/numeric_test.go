@@ -0,0 +1,31 @@
+package eval
+
+import "testing"
+
+func TestEvaluate_Arithmetic(t *testing.T) {
+	data := map[string]any{"a": 10, "b": 4, "s": "2.5", "n": nil, "word": "x"}
+	runEvalCases(t, []evalCase{
+		{"add", `{"+": [{"var": "a"}, {"var": "b"}, 1]}`, data, float64(15), false},
+		{"unary plus casts", `{"+": [{"var": "s"}]}`, data, 2.5, false},
+		{"subtract", `{"-": [{"var": "a"}, {"var": "b"}]}`, data, float64(6), false},
+		{"subtract chained", `{"-": [{"var": "a"}, {"var": "b"}, 1]}`, data, float64(5), false},
+		{"negate", `{"-": [{"var": "a"}]}`, data, float64(-10), false},
+		{"multiply", `{"*": [{"var": "a"}, {"var": "b"}]}`, data, float64(40), false},
+		{"divide", `{"/": [{"var": "a"}, {"var": "b"}]}`, data, 2.5, false},
+		{"modulo", `{"%": [{"var": "a"}, {"var": "b"}]}`, data, float64(2), false},
+		{"null operand", `{"+": [{"var": "a"}, {"var": "n"}]}`, data, nil, false},
+		{"divide by zero", `{"/": [{"var": "a"}, 0]}`, data, nil, true},
+		{"modulo by zero", `{"%": [{"var": "a"}, 0]}`, data, nil, true},
+		{"non-numeric string", `{"+": [{"var": "word"}, 1]}`, data, nil, true},
+	})
+}
+
+func TestEvaluate_MaxMin(t *testing.T) {
+	data := map[string]any{"a": 10, "b": 4, "n": nil}
+	runEvalCases(t, []evalCase{
+		{"max", `{"max": [{"var": "a"}, {"var": "b"}, 7]}`, data, float64(10), false},
+		{"min", `{"min": [{"var": "a"}, {"var": "b"}, 7]}`, data, float64(4), false},
+		{"null propagates", `{"max": [{"var": "a"}, {"var": "n"}]}`, data, nil, false},
+		{"boolean", `{"min": [true, 1]}`, data, nil, true},
+	})
+}
@@ -0,0 +1,111 @@
+package eval
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// evalCat implements "cat" as CONCAT: any NULL argument yields NULL.
+func evalCat(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 1, -1); err != nil {
+		return nil, err
+	}
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		if v == nil {
+			return nil, nil
+		}
+		if err := requireScalar(n, v); err != nil {
+			return nil, err
+		}
+		b.WriteString(toText(v))
+	}
+	return b.String(), nil
+}
+
+// evalSubstr implements "substr" as SUBSTR(s, start + 1[, length]).
+// The 0-based start becomes a 1-based SQL position; a position below 1 counts
+// back from the end of the string, so a start of -3 keeps the last two
+// characters exactly as the generated SQL does. A negative length is an error.
+func evalSubstr(n *ast.OpNode, s *scope) (any, error) {
+	if err := requireArgs(n, 2, 3); err != nil {
+		return nil, err
+	}
+	values, err := evalArgs(n, s)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		if v == nil {
+			return nil, nil
+		}
+	}
+	if err := requireScalar(n, values[0]); err != nil {
+		return nil, err
+	}
+
+	runes := []rune(toText(values[0]))
+	start, err := toNumber(n, values[1])
+	if err != nil {
+		return nil, err
+	}
+
+	position := int(math.Trunc(start)) + 1
+	var begin int
+	switch {
+	case position > 0:
+		begin = position - 1
+	case position < 0:
+		begin = max(len(runes)+position, 0)
+	}
+	if begin >= len(runes) {
+		return "", nil
+	}
+
+	end := len(runes)
+	if len(values) == 3 {
+		length, err := toNumber(n, values[2])
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 {
+			return nil, tperrors.New(tperrors.ErrInvalidArgument, n.Operator, n.JSONPath,
+				"substr length cannot be negative")
+		}
+		end = min(begin+int(math.Trunc(length)), len(runes))
+	}
+	return string(runes[begin:end]), nil
+}
+
+// requireScalar rejects arrays and objects in string functions.
+func requireScalar(n *ast.OpNode, v any) error {
+	switch v.(type) {
+	case []any, map[string]any:
+		return tperrors.NewTypeMismatch(n.Operator, n.JSONPath, "string", typeName(v))
+	default:
+		return nil
+	}
+}
+
+// toText converts a scalar to its SQL text form.
+func toText(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		return ""
+	}
+}
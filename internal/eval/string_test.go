@@ -0,0 +1,34 @@
+package eval
+
+import "testing"
+
+func TestEvaluate_Cat(t *testing.T) {
+	data := map[string]any{"first": "Ada", "last": "Lovelace", "age": 36, "n": nil}
+	runEvalCases(t, []evalCase{
+		{"strings", `{"cat": [{"var": "first"}, " ", {"var": "last"}]}`, data, "Ada Lovelace", false},
+		{"numbers and booleans", `{"cat": [{"var": "age"}, "/", 1.5, "/", true]}`, data, "36/1.5/true", false},
+		{"null propagates", `{"cat": [{"var": "first"}, {"var": "n"}]}`, data, nil, false},
+		{"array argument", `{"cat": [[1, 2]]}`, data, nil, true},
+	})
+}
+
+func TestEvaluate_Substr(t *testing.T) {
+	data := map[string]any{"s": "hello", "u": "héllo", "start": 1, "n": nil}
+	runEvalCases(t, []evalCase{
+		{"from start", `{"substr": [{"var": "s"}, 0]}`, data, "hello", false},
+		{"offset", `{"substr": [{"var": "s"}, 1]}`, data, "ello", false},
+		{"offset and length", `{"substr": [{"var": "s"}, 1, 3]}`, data, "ell", false},
+		{"length past end", `{"substr": [{"var": "s"}, 3, 10]}`, data, "lo", false},
+		{"start past end", `{"substr": [{"var": "s"}, 10]}`, data, "", false},
+		{"var start", `{"substr": [{"var": "s"}, {"var": "start"}, 2]}`, data, "el", false},
+		{"runes", `{"substr": [{"var": "u"}, 1, 2]}`, data, "él", false},
+		// SQL position -1 + 1 = 0 is treated as the first character.
+		{"start -1", `{"substr": [{"var": "s"}, -1]}`, data, "hello", false},
+		// SQL position -3 + 1 = -2 counts back two characters from the end.
+		{"negative start", `{"substr": [{"var": "s"}, -3]}`, data, "lo", false},
+		{"negative start beyond length", `{"substr": [{"var": "s"}, -20, 2]}`, data, "he", false},
+		{"null string", `{"substr": [{"var": "n"}, 1]}`, data, nil, false},
+		{"null length", `{"substr": [{"var": "s"}, 1, {"var": "n"}]}`, data, nil, false},
+		{"negative length", `{"substr": [{"var": "s"}, 0, -1]}`, data, nil, true},
+	})
+}
@@ -0,0 +1,280 @@
+// Package format lays out generated SQL over several lines.
+//
+// The formatter works on the SQL text rather than on the AST, so it applies
+// the same way to the output of every operator, including custom ones. Text
+// that fits in the line width is kept on one line exactly as generated.
+// Longer text is broken, from the outside in:
+//
+//   - A subquery puts SELECT, FROM, WHERE and the other clauses on their own
+//     lines.
+//   - A chain of AND/OR puts each operator at the start of a line.
+//   - Parentheses put their contents on indented lines, one argument per line
+//     for function calls and lists.
+//   - CASE puts each WHEN and the ELSE on an indented line, followed by END.
+//
+// Only whitespace between tokens and the case of keywords change: string
+// literals, quoted identifiers and placeholders are kept verbatim.
+package format
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// Default layout settings, used when an Options field is zero.
+const (
+	DefaultIndent    = 2
+	DefaultLineWidth = 80
+)
+
+// KeywordCase selects how SQL keywords are written.
+type KeywordCase int
+
+const (
+	// KeywordCasePreserve keeps keywords as they were generated.
+	KeywordCasePreserve KeywordCase = iota
+	// KeywordCaseUpper writes keywords in upper case: SELECT, AND, CASE.
+	KeywordCaseUpper
+	// KeywordCaseLower writes keywords in lower case: select, and, case.
+	KeywordCaseLower
+)
+
+// Options controls the layout of formatted SQL.
+type Options struct {
+	// Indent is the number of spaces per nesting level. Zero means DefaultIndent.
+	Indent int
+	// KeywordCase selects the case of keywords. Function names are kept as generated.
+	KeywordCase KeywordCase
+	// LineWidth is the width that lines are kept within where the SQL can be
+	// broken. Zero means DefaultLineWidth.
+	LineWidth int
+}
+
+// clauseKeywords start the clauses of a subquery.
+var clauseKeywords = map[string]bool{
+	"FROM": true, "WHERE": true, "CROSS": true, "JOIN": true, "LEFT": true,
+	"INNER": true, "GROUP": true, "HAVING": true, "ORDER": true, "LIMIT": true,
+	"OFFSET": true, "FETCH": true, "UNION": true,
+}
+
+// SQL formats sql, generated for dialect d, according to opts. SQL that
+// cannot be scanned, such as text with unbalanced parentheses or quotes, is
+// returned unchanged.
+func SQL(sql string, d dialect.Dialect, opts Options) string {
+	if opts.Indent <= 0 {
+		opts.Indent = DefaultIndent
+	}
+	if opts.LineWidth <= 0 {
+		opts.LineWidth = DefaultLineWidth
+	}
+
+	tokens, ok := scan(sql, d)
+	if !ok {
+		return sql
+	}
+	items, ok := build(tokens, opts.KeywordCase)
+	if !ok {
+		return sql
+	}
+
+	p := &printer{opts: opts}
+	p.writeSeq(items, 0, 0)
+	return p.buf.String()
+}
+
+// printer writes nodes, tracking the current column.
+type printer struct {
+	opts Options
+	buf  strings.Builder
+	col  int
+}
+
+// write appends s to the current line.
+func (p *printer) write(s string) {
+	p.buf.WriteString(s)
+	p.col += utf8.RuneCountInString(s)
+}
+
+// newline starts a line indented by level steps.
+func (p *printer) newline(level int) {
+	p.buf.WriteByte('\n')
+	p.col = 0
+	p.write(strings.Repeat(" ", level*p.opts.Indent))
+}
+
+// fits reports whether s fits on the current line.
+func (p *printer) fits(s string) bool {
+	return p.col+utf8.RuneCountInString(s) <= p.opts.LineWidth
+}
+
+// writeSeq writes a sequence of nodes that starts a line at level, breaking
+// it at its clauses, AND/OR chain or THEN if it does not fit. Continuation
+// lines of an AND/OR chain are indented at cont.
+func (p *printer) writeSeq(items []*node, level, cont int) {
+	if len(items) == 0 {
+		return
+	}
+	if flat := flatten(items); p.fits(flat) {
+		p.write(flat)
+		return
+	}
+
+	switch {
+	case items[0].isKeyword("SELECT"):
+		if segments := splitBefore(items, isClause); len(segments) > 1 {
+			for i, segment := range segments {
+				if i > 0 {
+					p.newline(level)
+				}
+				p.writeSeq(segment, level, level+1)
+			}
+			return
+		}
+	case items[0].isKeyword("WHEN"):
+		if segments := splitBefore(items, isThen); len(segments) > 1 {
+			p.writeSeq(segments[0], level, level)
+			for _, segment := range segments[1:] {
+				p.newline(level + 1)
+				p.writeSeq(segment, level+1, level+1)
+			}
+			return
+		}
+	}
+	if segments := splitBefore(items, isConnective); len(segments) > 1 {
+		p.writeSeq(segments[0], level, cont)
+		for _, segment := range segments[1:] {
+			p.newline(cont)
+			p.writeSeq(segment, cont, cont)
+		}
+		return
+	}
+
+	for i, item := range items {
+		if i > 0 && item.space {
+			p.write(" ")
+		}
+		p.writeNode(item, level)
+	}
+}
+
+// writeNode writes a single node, breaking groups and CASE expressions that
+// do not fit.
+func (p *printer) writeNode(n *node, level int) {
+	if flat := n.flat(); p.fits(flat) || n.kind == atomNode {
+		p.write(flat)
+		return
+	}
+
+	if n.kind == caseNode {
+		p.writeCase(n, level)
+		return
+	}
+
+	p.write(n.text)
+	if len(n.items) > 0 {
+		args := splitCommas(n.items)
+		if len(args) > 1 && !n.items[0].isKeyword("SELECT") {
+			for i, arg := range args {
+				p.newline(level + 1)
+				p.writeSeq(arg, level+1, level+1)
+				if i < len(args)-1 {
+					p.write(",")
+				}
+			}
+		} else {
+			p.newline(level + 1)
+			p.writeSeq(n.items, level+1, level+1)
+		}
+		p.newline(level)
+	}
+	p.write(n.close)
+}
+
+// writeCase writes CASE with each WHEN and the ELSE on their own line.
+func (p *printer) writeCase(n *node, level int) {
+	inner := n.items[1 : len(n.items)-1]
+	clauses := splitBefore(inner, func(_, item *node) bool {
+		return item.isKeyword("WHEN") || item.isKeyword("ELSE")
+	})
+
+	p.write(n.items[0].text)
+	start := 0
+	if len(clauses[0]) > 0 && !clauses[0][0].isKeyword("WHEN") && !clauses[0][0].isKeyword("ELSE") {
+		// CASE operand WHEN ...: the operand stays on the CASE line.
+		p.write(" ")
+		p.writeSeq(clauses[0], level, level+1)
+		start = 1
+	}
+	for _, clause := range clauses[start:] {
+		p.newline(level + 1)
+		p.writeSeq(clause, level+1, level+1)
+	}
+	p.newline(level)
+	p.write(n.items[len(n.items)-1].text)
+}
+
+// splitBefore splits items before every item after the first for which at
+// reports true. at also receives the last BETWEEN, AND or OR seen, so that the
+// AND of BETWEEN can be told apart.
+func splitBefore(items []*node, at func(prev, item *node) bool) [][]*node {
+	var segments [][]*node
+	start := 0
+	var prev *node
+	for i, item := range items {
+		if i > 0 && at(prev, item) {
+			segments = append(segments, items[start:i])
+			start = i
+		}
+		if item.isKeyword("BETWEEN") || item.isKeyword("AND") || item.isKeyword("OR") {
+			prev = item
+		}
+	}
+	return append(segments, items[start:])
+}
+
+// isClause reports whether item starts a subquery clause.
+func isClause(_ *node, item *node) bool {
+	return item.kind == atomNode && clauseKeywords[item.upper()]
+}
+
+// isThen reports whether item is the THEN of a WHEN clause.
+func isThen(_ *node, item *node) bool {
+	return item.isKeyword("THEN")
+}
+
+// isConnective reports whether item is an AND or OR joining conditions. The
+// AND that follows BETWEEN belongs to it.
+func isConnective(prev, item *node) bool {
+	if item.isKeyword("OR") {
+		return true
+	}
+	return item.isKeyword("AND") && (prev == nil || !prev.isKeyword("BETWEEN"))
+}
+
+// splitCommas splits the contents of a group at its commas. The commas are
+// dropped: the printer writes them at the end of each line.
+func splitCommas(items []*node) [][]*node {
+	var args [][]*node
+	start := 0
+	for i, item := range items {
+		if item.kind == commaNode {
+			args = append(args, items[start:i])
+			start = i + 1
+		}
+	}
+	return append(args, items[start:])
+}
+
+// flatten writes items on one line with their original spacing.
+func flatten(items []*node) string {
+	var b strings.Builder
+	for i, item := range items {
+		if i > 0 && item.space {
+			b.WriteByte(' ')
+		}
+		b.WriteString(item.flat())
+	}
+	return b.String()
+}
@@ -0,0 +1,217 @@
+package format
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+func TestSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		opts     Options
+		input    string
+		expected string
+	}{
+		{
+			name:     "short SQL stays on one line",
+			dialect:  dialect.DialectPostgreSQL,
+			input:    "WHERE (a = 1 AND b = 2)",
+			expected: "WHERE (a = 1 AND b = 2)",
+		},
+		{
+			name:    "and chain",
+			dialect: dialect.DialectPostgreSQL,
+			opts:    Options{LineWidth: 45},
+			input:   "WHERE (status = 'active' AND amount > 1000 AND (country = 'US' OR country = 'CA'))",
+			expected: `WHERE (
+  status = 'active'
+  AND amount > 1000
+  AND (country = 'US' OR country = 'CA')
+)`,
+		},
+		{
+			name:    "nested chains",
+			dialect: dialect.DialectPostgreSQL,
+			opts:    Options{LineWidth: 30, Indent: 4},
+			input:   "WHERE (status = 'active' AND (country = 'US' OR country = 'CA' OR country = 'MX'))",
+			expected: `WHERE (
+    status = 'active'
+    AND (
+        country = 'US'
+        OR country = 'CA'
+        OR country = 'MX'
+    )
+)`,
+		},
+		{
+			name:     "between keeps its and",
+			dialect:  dialect.DialectPostgreSQL,
+			opts:     Options{LineWidth: 20},
+			input:    "WHERE (x BETWEEN 1 AND 9 AND y = 2)",
+			expected: "WHERE (\n  x BETWEEN 1 AND 9\n  AND y = 2\n)",
+		},
+		{
+			name:    "case",
+			dialect: dialect.DialectPostgreSQL,
+			opts:    Options{LineWidth: 40},
+			input:   "WHERE CASE WHEN age >= 18 AND country = 'US' THEN 'adult in the US' WHEN age >= 13 THEN 'teen' ELSE 'child' END = 'adult'",
+			expected: `WHERE CASE
+  WHEN age >= 18 AND country = 'US'
+    THEN 'adult in the US'
+  WHEN age >= 13 THEN 'teen'
+  ELSE 'child'
+END = 'adult'`,
+		},
+		{
+			name:    "case with operand",
+			dialect: dialect.DialectPostgreSQL,
+			opts:    Options{LineWidth: 30},
+			input:   "WHERE CASE tier WHEN 'gold' THEN 100 WHEN 'silver' THEN 50 ELSE 0 END > 10",
+			expected: `WHERE CASE tier
+  WHEN 'gold' THEN 100
+  WHEN 'silver' THEN 50
+  ELSE 0
+END > 10`,
+		},
+		{
+			name:    "unnest subquery",
+			dialect: dialect.DialectBigQuery,
+			opts:    Options{LineWidth: 45},
+			input:   "WHERE EXISTS (SELECT 1 FROM UNNEST(tags) AS elem WHERE elem = 'vip' AND elem != 'banned')",
+			expected: `WHERE EXISTS (
+  SELECT 1
+  FROM UNNEST(tags) AS elem
+  WHERE elem = 'vip' AND elem != 'banned'
+)`,
+		},
+		{
+			name:    "subquery clause continues indented",
+			dialect: dialect.DialectBigQuery,
+			opts:    Options{LineWidth: 30},
+			input:   "WHERE EXISTS (SELECT 1 FROM UNNEST(tags) AS elem WHERE elem = 'vip' AND elem != 'banned')",
+			expected: `WHERE EXISTS (
+  SELECT 1
+  FROM UNNEST(tags) AS elem
+  WHERE elem = 'vip'
+    AND elem != 'banned'
+)`,
+		},
+		{
+			name:    "function arguments",
+			dialect: dialect.DialectPostgreSQL,
+			opts:    Options{LineWidth: 30},
+			input:   "WHERE COALESCE(first_name, last_name, 'unknown') = 'x'",
+			expected: `WHERE COALESCE(
+  first_name,
+  last_name,
+  'unknown'
+) = 'x'`,
+		},
+		{
+			name:    "array literal",
+			dialect: dialect.DialectClickHouse,
+			opts:    Options{LineWidth: 20},
+			input:   "WHERE hasAny(tags, ['alpha', 'beta'])",
+			expected: `WHERE hasAny(
+  tags,
+  ['alpha', 'beta']
+)`,
+		},
+		{
+			name:     "lower case keywords",
+			dialect:  dialect.DialectPostgreSQL,
+			opts:     Options{KeywordCase: KeywordCaseLower},
+			input:    "WHERE (a IS NOT NULL AND CAST(b AS TEXT) IN ('AND', 'x') AND c = TRUE)",
+			expected: "where (a is not null and CAST(b as TEXT) in ('AND', 'x') and c = true)",
+		},
+		{
+			name:     "upper case keywords",
+			dialect:  dialect.DialectPostgreSQL,
+			opts:     Options{KeywordCase: KeywordCaseUpper},
+			input:    `where (a is null or "and" = 1)`,
+			expected: `WHERE (a IS NULL OR "and" = 1)`,
+		},
+		{
+			name:     "escaped quotes",
+			dialect:  dialect.DialectBigQuery,
+			opts:     Options{LineWidth: 20, KeywordCase: KeywordCaseLower},
+			input:    `WHERE (name = 'O\'Brien (AND)' OR ` + "`or`" + ` = 'x')`,
+			expected: "where (\n  name = 'O\\'Brien (AND)'\n  or `or` = 'x'\n)",
+		},
+		{
+			name:     "doubled quotes",
+			dialect:  dialect.DialectPostgreSQL,
+			opts:     Options{LineWidth: 20},
+			input:    `WHERE (name = 'O''Brien (' OR "a ""b"" (" = 'x')`,
+			expected: "WHERE (\n  name = 'O''Brien ('\n  OR \"a \"\"b\"\" (\" = 'x'\n)",
+		},
+		{
+			name:     "sql server brackets",
+			dialect:  dialect.DialectSQLServer,
+			opts:     Options{LineWidth: 20},
+			input:    "WHERE ([order] = 1 OR [a]]b (] = N'x')",
+			expected: "WHERE (\n  [order] = 1\n  OR [a]]b (] = N'x'\n)",
+		},
+		{
+			name:     "clickhouse placeholders",
+			dialect:  dialect.DialectClickHouse,
+			opts:     Options{LineWidth: 20},
+			input:    "WHERE (status = {p1:String} AND amount > {p2:Int64})",
+			expected: "WHERE (\n  status = {p1:String}\n  AND amount > {p2:Int64}\n)",
+		},
+		{
+			name:     "unbalanced parentheses are returned unchanged",
+			dialect:  dialect.DialectPostgreSQL,
+			opts:     Options{LineWidth: 10},
+			input:    "WHERE (a = 1 AND b = 2",
+			expected: "WHERE (a = 1 AND b = 2",
+		},
+		{
+			name:     "unterminated string is returned unchanged",
+			dialect:  dialect.DialectPostgreSQL,
+			opts:     Options{LineWidth: 10},
+			input:    "WHERE (a = 'x AND b = 2)",
+			expected: "WHERE (a = 'x AND b = 2)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SQL(tt.input, tt.dialect, tt.opts); got != tt.expected {
+				t.Errorf("SQL() =\n%s\nwant\n%s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSQL_KeepsTokens(t *testing.T) {
+	input := "WHERE (a = 1 AND (b = 'x y' OR c IN (1, 2, 3)) AND CASE WHEN d > 0 THEN 1 ELSE 0 END = 1)"
+	for _, width := range []int{1, 10, 20, 40, 200} {
+		got := SQL(input, dialect.DialectPostgreSQL, Options{LineWidth: width})
+		if want, have := tokenTexts(t, input), tokenTexts(t, got); want != have {
+			t.Errorf("SQL() with width %d changed tokens: %s", width, have)
+		}
+		for _, line := range strings.Split(got, "\n") {
+			if strings.TrimRight(line, " ") != line {
+				t.Errorf("SQL() with width %d has trailing spaces: %q", width, line)
+			}
+		}
+	}
+}
+
+// tokenTexts returns the tokens of sql separated by spaces.
+func tokenTexts(t *testing.T, sql string) string {
+	t.Helper()
+	tokens, ok := scan(sql, dialect.DialectPostgreSQL)
+	if !ok {
+		t.Fatalf("scan(%q) failed", sql)
+	}
+	texts := make([]string, len(tokens))
+	for i, tok := range tokens {
+		texts[i] = tok.text
+	}
+	return strings.Join(texts, " ")
+}
@@ -0,0 +1,282 @@
+package format
+
+import (
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// tokenKind is the kind of a scanned token.
+type tokenKind int
+
+const (
+	atomToken  tokenKind = iota // a word, operator, literal or quoted identifier
+	openToken                   // ( or [
+	closeToken                  // ) or ]
+	commaToken                  // ,
+)
+
+// token is a piece of SQL text. space reports whether whitespace preceded it.
+type token struct {
+	kind  tokenKind
+	text  string
+	space bool
+}
+
+// scan splits sql into tokens. Quoted strings and identifiers, and the {...}
+// placeholders of ClickHouse, are kept inside the atom they appear in. It
+// reports false for an unterminated quote.
+func scan(sql string, d dialect.Dialect) ([]token, bool) {
+	var tokens []token
+	space := false
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = true
+			i++
+			continue
+		case c == '(' || (c == '[' && d != dialect.DialectSQLServer):
+			tokens = append(tokens, token{kind: openToken, text: string(c), space: space})
+			i++
+		case c == ')' || (c == ']' && d != dialect.DialectSQLServer):
+			tokens = append(tokens, token{kind: closeToken, text: string(c), space: space})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: commaToken, text: ",", space: space})
+			i++
+		default:
+			end, ok := scanAtom(sql, i, d)
+			if !ok {
+				return nil, false
+			}
+			tokens = append(tokens, token{kind: atomToken, text: sql[i:end], space: space})
+			i = end
+		}
+		space = false
+	}
+	return tokens, true
+}
+
+// scanAtom returns the end of the atom that starts at start.
+func scanAtom(sql string, start int, d dialect.Dialect) (int, bool) {
+	i := start
+	for i < len(sql) {
+		c := sql[i]
+		switch c {
+		case ' ', '\t', '\n', '\r', '(', ')', ',':
+			return i, true
+		case '[', ']':
+			if d != dialect.DialectSQLServer {
+				return i, true
+			}
+			if c == ']' {
+				return 0, false
+			}
+			end, ok := scanQuoted(sql, i, ']', false)
+			if !ok {
+				return 0, false
+			}
+			i = end
+		case '\'':
+			end, ok := scanQuoted(sql, i, '\'', backslashStrings(d))
+			if !ok {
+				return 0, false
+			}
+			i = end
+		case '"':
+			end, ok := scanQuoted(sql, i, '"', false)
+			if !ok {
+				return 0, false
+			}
+			i = end
+		case '`':
+			end, ok := scanQuoted(sql, i, '`', backslashIdentifiers(d))
+			if !ok {
+				return 0, false
+			}
+			i = end
+		case '{':
+			end := strings.IndexByte(sql[i:], '}')
+			if end < 0 {
+				return 0, false
+			}
+			i += end + 1
+		default:
+			i++
+		}
+	}
+	return i, true
+}
+
+// scanQuoted returns the end of the quoted text that starts at start. The
+// closing quote is escaped by doubling it or, if backslash is set, by a
+// preceding backslash.
+func scanQuoted(sql string, start int, quote byte, backslash bool) (int, bool) {
+	for i := start + 1; i < len(sql); i++ {
+		switch {
+		case backslash && sql[i] == '\\':
+			i++
+		case sql[i] == quote:
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// backslashStrings reports whether string literals of d use backslash escapes.
+func backslashStrings(d dialect.Dialect) bool {
+	//nolint:exhaustive // default handles the dialects with standard string literals
+	switch d {
+	case dialect.DialectBigQuery, dialect.DialectSpanner, dialect.DialectClickHouse, dialect.DialectMySQL,
+		dialect.DialectSnowflake, dialect.DialectSparkSQL:
+		return true
+	default:
+		return false
+	}
+}
+
+// backslashIdentifiers reports whether backtick identifiers of d use
+// backslash escapes.
+func backslashIdentifiers(d dialect.Dialect) bool {
+	return d == dialect.DialectBigQuery || d == dialect.DialectSpanner || d == dialect.DialectClickHouse
+}
+
+// nodeKind is the kind of a node in the layout tree.
+type nodeKind int
+
+const (
+	atomNode  nodeKind = iota // a single token
+	commaNode                 // a comma between arguments
+	groupNode                 // parenthesized or bracketed items
+	caseNode                  // CASE ... END, with both keywords among its items
+)
+
+// node is an element of the layout tree. For a group, text is the opening
+// bracket, together with the function name or keyword it is attached to.
+type node struct {
+	kind  nodeKind
+	text  string
+	close string
+	items []*node
+	space bool
+}
+
+// isKeyword reports whether n is the keyword word.
+func (n *node) isKeyword(word string) bool {
+	return n.kind == atomNode && strings.EqualFold(n.text, word)
+}
+
+// upper returns the text of n in upper case.
+func (n *node) upper() string {
+	return strings.ToUpper(n.text)
+}
+
+// flat returns n written on a single line.
+func (n *node) flat() string {
+	switch n.kind {
+	case groupNode:
+		return n.text + flatten(n.items) + n.close
+	case caseNode:
+		return flatten(n.items)
+	default:
+		return n.text
+	}
+}
+
+// build turns tokens into a layout tree, applying the keyword case. It reports
+// false for unbalanced brackets or CASE without END.
+func build(tokens []token, kc KeywordCase) ([]*node, bool) {
+	b := &builder{tokens: tokens, kc: kc}
+	items, ok := b.items("")
+	if !ok || b.pos < len(b.tokens) {
+		return nil, false
+	}
+	return items, true
+}
+
+// builder reads tokens into nodes.
+type builder struct {
+	tokens []token
+	pos    int
+	kc     KeywordCase
+}
+
+// items reads nodes until the closing bracket or END that matches until is
+// reached, leaving it unread. An empty until reads to the end.
+func (b *builder) items(until string) ([]*node, bool) {
+	var items []*node
+	for b.pos < len(b.tokens) {
+		tok := b.tokens[b.pos]
+		switch {
+		case tok.kind == closeToken:
+			return items, tok.text == until
+		case tok.kind == atomToken && until == "END" && strings.EqualFold(tok.text, "END"):
+			return items, true
+		}
+		b.pos++
+
+		switch tok.kind {
+		case commaToken:
+			items = append(items, &node{kind: commaNode, text: ",", space: tok.space})
+		case openToken:
+			close := ")"
+			if tok.text == "[" {
+				close = "]"
+			}
+			inner, ok := b.items(close)
+			if !ok {
+				return nil, false
+			}
+			b.pos++
+			group := &node{kind: groupNode, text: tok.text, close: close, items: inner, space: tok.space}
+			// A bracket written right after a name belongs to it: COUNT(, ARRAY[.
+			if n := len(items); n > 0 && !tok.space && items[n-1].kind == atomNode {
+				group.text = items[n-1].text + group.text
+				group.space = items[n-1].space
+				items = items[:n-1]
+			}
+			items = append(items, group)
+		default:
+			atom := &node{kind: atomNode, text: tok.text, space: tok.space}
+			if !b.attached() {
+				atom.text = b.keywordCase(tok.text)
+			}
+			if !strings.EqualFold(tok.text, "CASE") {
+				items = append(items, atom)
+				continue
+			}
+			inner, ok := b.items("END")
+			if !ok || b.pos >= len(b.tokens) {
+				return nil, false
+			}
+			end := b.tokens[b.pos]
+			b.pos++
+			caseItems := append([]*node{atom}, inner...)
+			caseItems = append(caseItems, &node{kind: atomNode, text: b.keywordCase(end.text), space: end.space})
+			items = append(items, &node{kind: caseNode, items: caseItems, space: tok.space})
+		}
+	}
+	return items, until == ""
+}
+
+// attached reports whether the next token is a bracket written right after
+// the current one, which makes the current token a function name.
+func (b *builder) attached() bool {
+	return b.pos < len(b.tokens) && b.tokens[b.pos].kind == openToken && !b.tokens[b.pos].space
+}
+
+// keywordCase applies the keyword case to word if it is a keyword.
+func (b *builder) keywordCase(word string) string {
+	if b.kc == KeywordCasePreserve || !dialect.IsReservedKeyword(word) {
+		return word
+	}
+	if b.kc == KeywordCaseLower {
+		return strings.ToLower(word)
+	}
+	return strings.ToUpper(word)
+}
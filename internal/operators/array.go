@@ -62,7 +62,7 @@ func (a *ArrayOperator) validateArrayOperand(value interface{}) error {
 		return nil
 	}
 
-	fieldName := a.extractFieldNameFromValue(value)
+	fieldName := varFieldName(value)
 	if fieldName == "" {
 		return nil // Can't determine field name, skip validation
 	}
@@ -73,33 +73,36 @@ func (a *ArrayOperator) validateArrayOperand(value interface{}) error {
 	}
 
 	if !a.schema().IsArrayType(fieldName) {
-		return fmt.Errorf("array operation on non-array field '%s' (type: %s)", fieldName, fieldType)
+		return fmt.Errorf("array operation on non-array field '%s' (type: %s)", fieldLabel(a.schema(), fieldName), fieldType)
 	}
 
 	return nil
 }
 
-// extractFieldNameFromValue extracts field name from a value that might be a var expression.
-func (a *ArrayOperator) extractFieldNameFromValue(value interface{}) string {
-	if varExpr, ok := value.(map[string]interface{}); ok {
-		if varName, hasVar := varExpr[OpVar]; hasVar {
-			return a.extractFieldName(varName)
-		}
+// forElements returns the operator that renders expressions over the elements
+// of array, in which item and current refer to the current element and its
+// fields resolve against the element schema declared for the array field.
+func (a *ArrayOperator) forElements(array interface{}) *ArrayOperator {
+	if a.config == nil {
+		return a
 	}
-	return ""
+	return NewArrayOperator(a.config.scoped(func(config *OperatorConfig) {
+		config.inArray = true
+		if name := varFieldName(array); config.Schema != nil && name != "" {
+			config.Schema = elementsOf(config.Schema, name)
+		}
+	}))
 }
 
-// extractFieldName extracts the field name from a var argument.
-func (a *ArrayOperator) extractFieldName(varName interface{}) string {
-	if nameStr, ok := varName.(string); ok {
-		return nameStr
+// forReduce returns the operator that renders the reducer over the elements of
+// array, in which accumulator refers to the running value that starts from
+// initial.
+func (a *ArrayOperator) forReduce(array interface{}, initial string) *ArrayOperator {
+	elements := a.forElements(array)
+	if elements.config != nil {
+		elements.config.reduceInitial = initial
 	}
-	if nameArr, ok := varName.([]interface{}); ok && len(nameArr) > 0 {
-		if nameStr, ok := nameArr[0].(string); ok {
-			return nameStr
-		}
-	}
-	return ""
+	return elements
 }
 
 // ToSQL converts an array operation to SQL.
@@ -130,7 +133,7 @@ func (a *ArrayOperator) ToSQL(operator string, args []interface{}) (string, erro
 
 // handleMap converts map operator to SQL.
 // Generates: ARRAY(SELECT transformation FROM UNNEST(array) AS elem).
-// For ClickHouse: Uses arrayMap or subquery with arrayJoin.
+// Other dialects render their own form through the configured Spec.
 func (a *ArrayOperator) handleMap(args []interface{}) (string, error) {
 	if len(args) != 2 {
 		return "", fmt.Errorf("map requires exactly 2 arguments")
@@ -155,27 +158,18 @@ func (a *ArrayOperator) handleMap(args []interface{}) (string, error) {
 	}
 
 	// Second argument: transformation expression
-	transformation, err := a.expressionToSQL(args[1])
+	transformation, err := a.forElements(args[0]).expressionToSQL(args[1])
 	if err != nil {
 		return "", fmt.Errorf("invalid map transformation argument: %w", err)
 	}
+	transformation = a.config.PredicateValue(args[1], transformation)
 
-	// Replace element reference in transformation
-	transformationWithElem := a.replaceElementReference(transformation)
-
-	// Generate SQL based on dialect
-	//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-	switch a.getDialect() {
-	case dialect.DialectClickHouse:
-		return fmt.Sprintf("arrayMap(elem -> %s, %s)", transformationWithElem, array), nil
-	default:
-		return fmt.Sprintf("ARRAY(SELECT %s FROM UNNEST(%s) AS elem)", transformationWithElem, array), nil
-	}
+	return a.config.Spec().ArrayMap(array, transformation)
 }
 
 // handleFilter converts filter operator to SQL.
 // Generates: ARRAY(SELECT elem FROM UNNEST(array) AS elem WHERE condition).
-// For ClickHouse: Uses arrayFilter function.
+// Other dialects render their own form through the configured Spec.
 func (a *ArrayOperator) handleFilter(args []interface{}) (string, error) {
 	if len(args) != 2 {
 		return "", fmt.Errorf("filter requires exactly 2 arguments")
@@ -200,22 +194,13 @@ func (a *ArrayOperator) handleFilter(args []interface{}) (string, error) {
 	}
 
 	// Second argument: condition expression
-	condition, err := a.expressionToSQL(args[1])
+	condition, err := a.forElements(args[0]).expressionToSQL(args[1])
 	if err != nil {
 		return "", fmt.Errorf("invalid filter condition argument: %w", err)
 	}
+	condition = a.config.Condition(args[1], condition)
 
-	// Replace element reference in condition
-	conditionWithElem := a.replaceElementReference(condition)
-
-	// Generate SQL based on dialect
-	//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-	switch a.getDialect() {
-	case dialect.DialectClickHouse:
-		return fmt.Sprintf("arrayFilter(elem -> %s, %s)", conditionWithElem, array), nil
-	default:
-		return fmt.Sprintf("ARRAY(SELECT elem FROM UNNEST(%s) AS elem WHERE %s)", array, conditionWithElem), nil
-	}
+	return a.config.Spec().ArrayFilter(array, condition)
 }
 
 // handleReduce converts reduce operator to SQL.
@@ -225,7 +210,7 @@ func (a *ArrayOperator) handleFilter(args []interface{}) (string, error) {
 // For common patterns, this generates optimized SQL:
 // - Addition: initial + COALESCE((SELECT SUM(elem) FROM UNNEST(array) AS elem), 0).
 // - General: (SELECT reducer FROM UNNEST(array) AS elem).
-// For ClickHouse: Uses arrayReduce function for aggregates.
+// The dialect Spec renders the final SQL with ArrayAggregate and ArrayReduce.
 func (a *ArrayOperator) handleReduce(args []interface{}) (string, error) {
 	if len(args) != 3 {
 		return "", fmt.Errorf("reduce requires exactly 3 arguments")
@@ -260,53 +245,27 @@ func (a *ArrayOperator) handleReduce(args []interface{}) (string, error) {
 
 	// Check for common reduction patterns and optimize
 	if pattern := a.detectAggregatePattern(reducerExpr); pattern != nil {
-		// Build the element reference: "elem" or "elem.field" if field suffix exists
-		elemRef := ElemVar
+		// Quote the field read from each element, if any
+		var path, quotedPath []string
 		if pattern.fieldSuffix != "" {
-			elemRef = ElemVar + "." + pattern.fieldSuffix
-		}
-
-		// Generate optimized aggregate SQL based on dialect
-		//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-		switch a.getDialect() {
-		case dialect.DialectClickHouse:
-			// ClickHouse: For field access, we need arrayMap first to extract the field
-			if pattern.fieldSuffix != "" {
-				// initial + coalesce(arrayReduce('sum', arrayMap(x -> x.field, array)), 0)
-				return fmt.Sprintf("%s + coalesce(arrayReduce('%s', arrayMap(x -> x.%s, %s)), 0)",
-					initial, strings.ToLower(pattern.function), pattern.fieldSuffix, array), nil
+			path, err = dialect.SplitPath(pattern.fieldSuffix)
+			if err == nil {
+				quotedPath, err = a.config.quoteSegments(pattern.fieldSuffix, path)
+			}
+			if err != nil {
+				return "", fmt.Errorf("invalid reduce field: %w", err)
 			}
-			// initial + coalesce(arrayReduce('sum', array), 0)
-			return fmt.Sprintf("%s + coalesce(arrayReduce('%s', %s), 0)",
-				initial, strings.ToLower(pattern.function), array), nil
-		default:
-			// Standard SQL: initial + COALESCE((SELECT AGG(elem.field) FROM UNNEST(array) AS elem), 0)
-			return fmt.Sprintf("%s + COALESCE((SELECT %s(%s) FROM UNNEST(%s) AS elem), 0)",
-				initial, pattern.function, elemRef, array), nil
 		}
+		return a.config.Spec().ArrayAggregate(array, initial, pattern.function, path, quotedPath)
 	}
 
 	// General case: evaluate reducer expression with element reference
-	reducer, err := a.expressionToSQL(reducerExpr)
+	reducer, err := a.forReduce(args[0], initial).expressionToSQL(reducerExpr)
 	if err != nil {
 		return "", fmt.Errorf("invalid reduce expression: %w", err)
 	}
 
-	// Replace variable references
-	reducerWithElem := a.replaceElementReference(reducer)
-	// Replace accumulator with initial for the subquery context
-	reducerWithElem = strings.ReplaceAll(reducerWithElem, AccumulatorVar, initial)
-
-	// Generate SQL based on dialect
-	//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-	switch a.getDialect() {
-	case dialect.DialectClickHouse:
-		// ClickHouse uses arrayFold for general reduction (ClickHouse 22.8+)
-		return fmt.Sprintf("arrayFold((acc, elem) -> %s, %s, %s)", reducerWithElem, array, initial), nil
-	default:
-		// Standard SQL using a subquery
-		return fmt.Sprintf("(SELECT %s FROM UNNEST(%s) AS elem)", reducerWithElem, array), nil
-	}
+	return a.config.Spec().ArrayReduce(array, initial, reducer)
 }
 
 // aggregatePattern represents a detected aggregate pattern with optional field suffix.
@@ -351,7 +310,7 @@ func (a *ArrayOperator) detectAggregatePattern(expr interface{}) *aggregatePatte
 }
 
 // isAccumulatorCurrentPattern checks if args match [{"var": "accumulator"}, {"var": "current"}]
-// or [{"var": "accumulator"}, {"var": "current.field"}].
+// or [{"var": "accumulator"}, {"var": "current.field"}], in either order.
 // Returns (fieldSuffix, true) if pattern matches, ("", false) otherwise.
 // fieldSuffix is empty for plain "current", or contains the field path (e.g., "price" for "current.price").
 func (a *ArrayOperator) isAccumulatorCurrentPattern(args interface{}) (string, bool) {
@@ -360,38 +319,19 @@ func (a *ArrayOperator) isAccumulatorCurrentPattern(args interface{}) (string, b
 		return "", false
 	}
 
-	// Check first arg is {"var": "accumulator"}
-	arg0Map, ok := argsArr[0].(map[string]interface{})
-	if !ok {
-		return "", false
-	}
-	if varName, hasVar := arg0Map[OpVar]; !hasVar || varName != AccumulatorVar {
-		return "", false
-	}
-
-	// Check second arg is {"var": "current"} or {"var": "current.field"}
-	arg1Map, ok := argsArr[1].(map[string]interface{})
-	if !ok {
-		return "", false
-	}
-	varName, hasVar := arg1Map[OpVar]
-	if !hasVar {
-		return "", false
-	}
-
-	varNameStr, ok := varName.(string)
-	if !ok {
-		return "", false
-	}
-
-	// Check if it's exactly "current" or starts with "current."
-	if varNameStr == CurrentVar {
-		return "", true // Plain current, no field suffix
-	}
-	if strings.HasPrefix(varNameStr, CurrentVar+".") {
-		// Extract field suffix (e.g., "price" from "current.price")
-		fieldSuffix := strings.TrimPrefix(varNameStr, CurrentVar+".")
-		return fieldSuffix, true
+	for i, other := range []int{1, 0} {
+		if varFieldName(argsArr[i]) != AccumulatorVar {
+			continue
+		}
+		// Check the other arg is {"var": "current"} or {"var": "current.field"}
+		varName := varFieldName(argsArr[other])
+		if varName == CurrentVar {
+			return "", true // Plain current, no field suffix
+		}
+		if strings.HasPrefix(varName, CurrentVar+".") {
+			// Extract field suffix (e.g., "price" from "current.price")
+			return strings.TrimPrefix(varName, CurrentVar+"."), true
+		}
 	}
 
 	return "", false
@@ -400,7 +340,7 @@ func (a *ArrayOperator) isAccumulatorCurrentPattern(args interface{}) (string, b
 // handleAll converts all operator to SQL.
 // This checks if all elements in an array satisfy a condition.
 // Generates: NOT EXISTS (SELECT 1 FROM UNNEST(array) AS elem WHERE NOT (condition)).
-// For ClickHouse: Uses arrayAll function.
+// Other dialects render their own form through the configured Spec.
 func (a *ArrayOperator) handleAll(args []interface{}) (string, error) {
 	if len(args) != 2 {
 		return "", fmt.Errorf("all requires exactly 2 arguments")
@@ -425,29 +365,19 @@ func (a *ArrayOperator) handleAll(args []interface{}) (string, error) {
 	}
 
 	// Second argument: condition expression
-	condition, err := a.expressionToSQL(args[1])
+	condition, err := a.forElements(args[0]).expressionToSQL(args[1])
 	if err != nil {
 		return "", fmt.Errorf("invalid all condition argument: %w", err)
 	}
+	condition = a.config.Condition(args[1], condition)
 
-	// Replace 'elem' in condition with the actual element reference
-	conditionWithElem := a.replaceElementReference(condition)
-
-	// Generate SQL based on dialect
-	//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-	switch a.getDialect() {
-	case dialect.DialectClickHouse:
-		return fmt.Sprintf("arrayAll(elem -> %s, %s)", conditionWithElem, array), nil
-	default:
-		// Standard SQL: NOT EXISTS (SELECT 1 FROM UNNEST(array) AS elem WHERE NOT (condition))
-		return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM UNNEST(%s) AS elem WHERE NOT (%s))", array, conditionWithElem), nil
-	}
+	return a.config.Spec().ArrayAll(array, condition)
 }
 
 // handleSome converts some operator to SQL.
 // This checks if some elements in an array satisfy a condition.
 // Generates: EXISTS (SELECT 1 FROM UNNEST(array) AS elem WHERE condition).
-// For ClickHouse: Uses arrayExists function.
+// Other dialects render their own form through the configured Spec.
 func (a *ArrayOperator) handleSome(args []interface{}) (string, error) {
 	if len(args) != 2 {
 		return "", fmt.Errorf("some requires exactly 2 arguments")
@@ -472,29 +402,19 @@ func (a *ArrayOperator) handleSome(args []interface{}) (string, error) {
 	}
 
 	// Second argument: condition expression
-	condition, err := a.expressionToSQL(args[1])
+	condition, err := a.forElements(args[0]).expressionToSQL(args[1])
 	if err != nil {
 		return "", fmt.Errorf("invalid some condition argument: %w", err)
 	}
+	condition = a.config.Condition(args[1], condition)
 
-	// Replace element reference in condition
-	conditionWithElem := a.replaceElementReference(condition)
-
-	// Generate SQL based on dialect
-	//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-	switch a.getDialect() {
-	case dialect.DialectClickHouse:
-		return fmt.Sprintf("arrayExists(elem -> %s, %s)", conditionWithElem, array), nil
-	default:
-		// Standard SQL: EXISTS (SELECT 1 FROM UNNEST(array) AS elem WHERE condition)
-		return fmt.Sprintf("EXISTS (SELECT 1 FROM UNNEST(%s) AS elem WHERE %s)", array, conditionWithElem), nil
-	}
+	return a.config.Spec().ArraySome(array, condition)
 }
 
 // handleNone converts none operator to SQL.
 // This checks if no elements in an array satisfy a condition.
 // Generates: NOT EXISTS (SELECT 1 FROM UNNEST(array) AS elem WHERE condition).
-// For ClickHouse: Uses NOT arrayExists function.
+// Other dialects render their own form through the configured Spec.
 func (a *ArrayOperator) handleNone(args []interface{}) (string, error) {
 	if len(args) != 2 {
 		return "", fmt.Errorf("none requires exactly 2 arguments")
@@ -519,29 +439,19 @@ func (a *ArrayOperator) handleNone(args []interface{}) (string, error) {
 	}
 
 	// Second argument: condition expression
-	condition, err := a.expressionToSQL(args[1])
+	condition, err := a.forElements(args[0]).expressionToSQL(args[1])
 	if err != nil {
 		return "", fmt.Errorf("invalid none condition argument: %w", err)
 	}
+	condition = a.config.Condition(args[1], condition)
 
-	// Replace element reference in condition
-	conditionWithElem := a.replaceElementReference(condition)
-
-	// Generate SQL based on dialect
-	//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-	switch a.getDialect() {
-	case dialect.DialectClickHouse:
-		return fmt.Sprintf("NOT arrayExists(elem -> %s, %s)", conditionWithElem, array), nil
-	default:
-		// Standard SQL: NOT EXISTS (SELECT 1 FROM UNNEST(array) AS elem WHERE condition)
-		return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM UNNEST(%s) AS elem WHERE %s)", array, conditionWithElem), nil
-	}
+	return a.config.Spec().ArrayNone(array, condition)
 }
 
 // handleMerge converts merge operator to SQL.
 // This merges multiple arrays into one.
-// BigQuery/Spanner: ARRAY_CONCAT(array1, array2, ...)
-// PostgreSQL: array1 || array2 || ...
+// Generates: ARRAY_CONCAT(array1, array2, ...).
+// Other dialects render their own form through the configured Spec.
 func (a *ArrayOperator) handleMerge(args []interface{}) (string, error) {
 	if len(args) < 1 {
 		return "", fmt.Errorf("merge requires at least 1 argument")
@@ -571,30 +481,10 @@ func (a *ArrayOperator) handleMerge(args []interface{}) (string, error) {
 		arrays[i] = array
 	}
 
-	// Generate SQL based on dialect
-	d := dialect.DialectUnspecified
-	if a.config != nil {
-		d = a.config.GetDialect()
-	}
-
-	switch d {
-	case dialect.DialectPostgreSQL:
-		// PostgreSQL: Use || operator for array concatenation
-		if len(arrays) == 1 {
-			return arrays[0], nil
-		}
-		return fmt.Sprintf("(%s)", strings.Join(arrays, " || ")), nil
-	case dialect.DialectBigQuery, dialect.DialectSpanner, dialect.DialectDuckDB:
-		// BigQuery/Spanner/DuckDB: Use ARRAY_CONCAT function
-		return fmt.Sprintf("ARRAY_CONCAT(%s)", strings.Join(arrays, ", ")), nil
-	case dialect.DialectClickHouse:
-		// ClickHouse: Use arrayConcat function
-		return fmt.Sprintf("arrayConcat(%s)", strings.Join(arrays, ", ")), nil
-	case dialect.DialectUnspecified:
+	if a.getDialect() == dialect.DialectUnspecified {
 		return "", fmt.Errorf("merge: dialect not specified")
-	default:
-		return "", fmt.Errorf("merge: unsupported dialect %s", d)
 	}
+	return a.config.Spec().ArrayMerge(arrays)
 }
 
 // valueToSQL converts a value to SQL, handling var expressions, arrays, and literals.
@@ -629,86 +519,98 @@ func (a *ArrayOperator) valueToSQL(value interface{}) (string, error) {
 			}
 			elements[i] = elementSQL
 		}
-		return fmt.Sprintf("[%s]", strings.Join(elements, " ")), nil
+		return a.config.Spec().ArrayLiteral(elements)
 	}
 
 	// Handle primitive values
 	return a.dataOp.valueToSQL(value)
 }
 
-// expressionToSQL converts a JSON Logic expression to SQL.
+// expressionToSQL converts a JSON Logic expression to SQL, sharing the
+// logical operator's dispatch so nested expressions render the same way
+// inside and outside array operations.
 func (a *ArrayOperator) expressionToSQL(expr interface{}) (string, error) {
-	// Handle ProcessedValue (pre-processed SQL from parser)
-	if pv, ok := expr.(ProcessedValue); ok {
-		if pv.IsSQL {
-			return pv.Value, nil
-		}
-		// It's a literal, recursively convert it
-		return a.expressionToSQL(pv.Value)
-	}
+	return a.getLogicalOperator().expressionToSQL(expr)
+}
 
-	// Handle primitive values
-	if a.isPrimitive(expr) {
-		return a.dataOp.valueToSQL(expr)
-	}
+// mysqlElementTable returns a JSON_TABLE source that unnests a MySQL JSON array
+// into rows with a single column named elem, read from path of each element.
+func mysqlElementTable(array, columnType, path string) string {
+	return fmt.Sprintf("JSON_TABLE(%s, '$[*]' COLUMNS (%s %s PATH %s)) AS jt", array, ElemVar, columnType, path)
+}
 
-	// Handle var expressions
-	if varExpr, ok := expr.(map[string]interface{}); ok {
-		if varName, hasVar := varExpr[OpVar]; hasVar {
-			// Special case: empty var name represents the current element in array operations
-			if varName == "" {
-				return ElemVar, nil
-			}
-			return a.dataOp.ToSQL(OpVar, []interface{}{varName})
-		}
-	}
+// oracleElementColumns are the JSON_TABLE columns used to unnest an Oracle
+// JSON array: the element position, the element as text and the element as
+// JSON wrapped in a one-element array, which keeps scalars as valid JSON.
+const oracleElementColumns = "elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$'"
 
-	// Handle complex expressions by delegating to other operators
-	if exprMap, ok := expr.(map[string]interface{}); ok {
-		for operator, args := range exprMap {
-			switch operator {
-			case "==", "===", "!=", "!==", ">", ">=", "<", "<=", "in":
-				if arr, ok := args.([]interface{}); ok {
-					return a.comparisonOp.ToSQL(operator, arr)
-				}
-			case "and", "or", "!", "!!", "if":
-				if arr, ok := args.([]interface{}); ok {
-					return a.getLogicalOperator().ToSQL(operator, arr)
-				}
-			case "+", "-", "*", "/", "%", "max", "min":
-				if arr, ok := args.([]interface{}); ok {
-					return a.numericOp.ToSQL(operator, arr)
-				}
-			case "map", "filter", "reduce", "all", "some", "none", "merge":
-				// Handle nested array operators
-				if arr, ok := args.([]interface{}); ok {
-					return a.ToSQL(operator, arr)
-				}
-			default:
-				// Try to use the expression parser callback for unknown operators
-				// This enables support for custom operators in nested contexts
-				if a.config != nil && a.config.HasExpressionParser() {
-					return a.config.ParseExpression(exprMap, "$")
-				}
-				return "", fmt.Errorf("unsupported operator in array expression: %s", operator)
-			}
-		}
-	}
+// oracleElementJSON strips the wrapping brackets from elem_json, leaving the
+// JSON encoding of the current element.
+const oracleElementJSON = "SUBSTR(elem_json, 2, LENGTH(elem_json) - 2)"
+
+// oracleElementTable returns a JSON_TABLE source that unnests an Oracle JSON
+// array into rows with the given columns. Oracle does not accept AS before a
+// table alias.
+func oracleElementTable(array, columns string) string {
+	return fmt.Sprintf("JSON_TABLE(%s, '$[*]' COLUMNS (%s)) jt", array, columns)
+}
+
+// sqliteElementValue is the json_each column holding the current element.
+const sqliteElementValue = "je.value"
+
+// sqliteElementTable returns a json_each source that unnests a SQLite JSON
+// array into rows aliased je, with each element in je.value.
+func sqliteElementTable(array string) string {
+	return fmt.Sprintf("json_each(%s) AS je", array)
+}
+
+// sqlServerElementValue is the OPENJSON column holding the current element as text.
+const sqlServerElementValue = "je.value"
+
+// sqlServerElementOrder orders OPENJSON rows by their position in the array.
+const sqlServerElementOrder = "CAST(je.[key] AS INT)"
+
+// sqlServerElementJSON re-encodes the current OPENJSON row as JSON: OPENJSON
+// returns strings unquoted and null as SQL NULL, while numbers, booleans,
+// arrays and objects are already JSON text.
+const sqlServerElementJSON = `CASE je.type WHEN 0 THEN 'null' WHEN 1 THEN CONCAT('"', STRING_ESCAPE(je.value, 'json'), '"') ELSE je.value END`
 
-	return "", fmt.Errorf("invalid expression type: %T", expr)
+// sqlServerElementTable returns an OPENJSON source that unnests a SQL Server
+// JSON array into rows aliased je, with each element in je.value.
+func sqlServerElementTable(array string) string {
+	return fmt.Sprintf("OPENJSON(%s) AS je", array)
 }
 
-// replaceElementReference replaces element references in conditions.
-// For now, this is a simple implementation that assumes the condition uses a variable.
-// It replaces "item" and "current" references with "elem" for UNNEST subqueries.
-func (a *ArrayOperator) replaceElementReference(condition string) string {
-	// Replace variable references in the condition with the element name
-	// This handles cases where {"var": "item"} or {"var": "current"} should become "elem"
-	// Simple string replacement for now - in a more complex implementation,
-	// you'd want to parse the SQL and replace variable references properly
-	result := strings.ReplaceAll(condition, ItemVar, ElemVar)
-	result = strings.ReplaceAll(result, CurrentVar, ElemVar)
-	return result
+// sqlServerJSONArrayAgg returns the aggregate that joins JSON encoded values
+// into a JSON array, yielding [] rather than NULL when there are no rows.
+func sqlServerJSONArrayAgg(value, order string) string {
+	return fmt.Sprintf("CONCAT('[', STRING_AGG(%s, ',') WITHIN GROUP (ORDER BY %s), ']')", value, order)
+}
+
+// jsonElement returns the je.value column of a JSON table function, which
+// holds the current element, extracting the field at path with extract.
+func jsonElement(d dialect.Dialect, extract string, path []string) (string, error) {
+	if len(path) == 0 {
+		return sqliteElementValue, nil
+	}
+	literal, err := d.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s(%s, %s)", extract, sqliteElementValue, literal), nil
+}
+
+// lambdaFold returns the body of a reduce lambda that folds value into acc
+// for an aggregate function.
+func lambdaFold(function, value string) string {
+	switch function {
+	case AggregateMIN:
+		return fmt.Sprintf("LEAST(acc, %s)", value)
+	case AggregateMAX:
+		return fmt.Sprintf("GREATEST(acc, %s)", value)
+	default:
+		return fmt.Sprintf("acc + %s", value)
+	}
 }
 
 // isPrimitive checks if a value is a primitive type.
@@ -1,6 +1,7 @@
 package operators
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/h22rana/jsonlogic2sql/internal/dialect"
@@ -312,14 +313,14 @@ func TestArrayOperator_DialectSupport(t *testing.T) {
 					name:     "reduce with MIN pattern",
 					operator: "reduce",
 					args:     []any{map[string]any{"var": "values"}, map[string]any{"min": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 999999},
-					expected: "999999 + COALESCE((SELECT MIN(elem) FROM UNNEST(values) AS elem), 0)",
+					expected: "LEAST(999999, COALESCE((SELECT MIN(elem) FROM UNNEST(values) AS elem), 999999))",
 					hasError: false,
 				},
 				{
 					name:     "reduce with MAX pattern",
 					operator: "reduce",
 					args:     []any{map[string]any{"var": "values"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
-					expected: "0 + COALESCE((SELECT MAX(elem) FROM UNNEST(values) AS elem), 0)",
+					expected: "GREATEST(0, COALESCE((SELECT MAX(elem) FROM UNNEST(values) AS elem), 0))",
 					hasError: false,
 				},
 				{
@@ -348,14 +349,14 @@ func TestArrayOperator_DialectSupport(t *testing.T) {
 					name:     "reduce with MAX pattern on current.value",
 					operator: "reduce",
 					args:     []any{map[string]any{"var": "readings"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.value"}}}, 0},
-					expected: "0 + COALESCE((SELECT MAX(elem.value) FROM UNNEST(readings) AS elem), 0)",
+					expected: "GREATEST(0, COALESCE((SELECT MAX(elem.value) FROM UNNEST(readings) AS elem), 0))",
 					hasError: false,
 				},
 				{
 					name:     "reduce with MIN pattern on current.amount",
 					operator: "reduce",
 					args:     []any{map[string]any{"var": "transactions"}, map[string]any{"min": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.amount"}}}, 9999999},
-					expected: "9999999 + COALESCE((SELECT MIN(elem.amount) FROM UNNEST(transactions) AS elem), 0)",
+					expected: "LEAST(9999999, COALESCE((SELECT MIN(elem.amount) FROM UNNEST(transactions) AS elem), 9999999))",
 					hasError: false,
 				},
 				{
@@ -777,7 +778,7 @@ func TestArrayOperator_EdgeCases(t *testing.T) {
 				map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}},
 				0,
 			},
-			expected: "0 + COALESCE((SELECT MAX(elem) FROM UNNEST(values) AS elem), 0)",
+			expected: "GREATEST(0, COALESCE((SELECT MAX(elem) FROM UNNEST(values) AS elem), 0))",
 			hasError: false,
 		},
 		{
@@ -788,7 +789,19 @@ func TestArrayOperator_EdgeCases(t *testing.T) {
 				map[string]any{"min": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}},
 				999999,
 			},
-			expected: "999999 + COALESCE((SELECT MIN(elem) FROM UNNEST(values) AS elem), 0)",
+			expected: "LEAST(999999, COALESCE((SELECT MIN(elem) FROM UNNEST(values) AS elem), 999999))",
+			hasError: false,
+		},
+		{
+			// The initial value takes part in the minimum, so 10 over [20, 30] is 10
+			name:     "reduce with MIN pattern, current first",
+			operator: "reduce",
+			args: []any{
+				map[string]any{"var": "values"},
+				map[string]any{"min": []any{map[string]any{"var": "current"}, map[string]any{"var": "accumulator"}}},
+				10,
+			},
+			expected: "LEAST(10, COALESCE((SELECT MIN(elem) FROM UNNEST(values) AS elem), 10))",
 			hasError: false,
 		},
 		{
@@ -970,14 +983,14 @@ func TestArrayOperator_ClickHouse(t *testing.T) {
 			name:     "reduce with MAX pattern on current.value (ClickHouse)",
 			operator: "reduce",
 			args:     []any{map[string]any{"var": "readings"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.value"}}}, 0},
-			expected: "0 + coalesce(arrayReduce('max', arrayMap(x -> x.value, readings)), 0)",
+			expected: "greatest(0, coalesce(arrayReduce('max', arrayMap(x -> x.value, readings)), 0))",
 			hasError: false,
 		},
 		{
 			name:     "reduce with MIN pattern on current.amount (ClickHouse)",
 			operator: "reduce",
 			args:     []any{map[string]any{"var": "transactions"}, map[string]any{"min": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.amount"}}}, 9999999},
-			expected: "9999999 + coalesce(arrayReduce('min', arrayMap(x -> x.amount, transactions)), 0)",
+			expected: "least(9999999, coalesce(arrayReduce('min', arrayMap(x -> x.amount, transactions)), 9999999))",
 			hasError: false,
 		},
 		// All - uses arrayAll
@@ -1025,3 +1038,973 @@ func TestArrayOperator_ClickHouse(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayOperator_SQLite(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectSQLite, nil)
+	op := NewArrayOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "map with transformation",
+			operator: "map",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "item"}, 2}}},
+			expected: "(SELECT json_group_array((je.value * 2)) FROM json_each(numbers) AS je)",
+		},
+		{
+			name:     "filter with condition",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": "item"}, 70}}},
+			expected: "(SELECT json_group_array(je.value) FROM json_each(scores) AS je WHERE je.value >= 70)",
+		},
+		{
+			name:     "reduce with SUM pattern",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
+			expected: "0 + COALESCE((SELECT SUM(je.value) FROM json_each(numbers) AS je), 0)",
+		},
+		{
+			name:     "reduce with MAX pattern on current.price",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.price"}}}, 0},
+			expected: "max(0, COALESCE((SELECT MAX(json_extract(je.value, '$.price')) FROM json_each(items) AS je), 0))",
+		},
+		{
+			name:     "reduce with MIN pattern, current first",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"min": []any{map[string]any{"var": "current"}, map[string]any{"var": "accumulator"}}}, 10},
+			expected: "min(10, COALESCE((SELECT MIN(je.value) FROM json_each(numbers) AS je), 10))",
+		},
+		{
+			name:     "reduce sum with current first",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "current"}, map[string]any{"var": "accumulator"}}}, 0},
+			expected: "0 + COALESCE((SELECT SUM(je.value) FROM json_each(numbers) AS je), 0)",
+		},
+		{
+			name:     "reduce general expression",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 1},
+			errMsg:   "unsupported reduce body on SQLite",
+		},
+		{
+			name:     "all with condition",
+			operator: "all",
+			args:     []any{map[string]any{"var": "values"}, map[string]any{">": []any{map[string]any{"var": "item"}, 0}}},
+			expected: `NOT EXISTS (SELECT 1 FROM json_each("values") AS je WHERE NOT (je.value > 0))`,
+		},
+		{
+			name:     "some with field access",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.status"}, "active"}}},
+			expected: "EXISTS (SELECT 1 FROM json_each(items) AS je WHERE json_extract(je.value, '$.status') = 'active')",
+		},
+		{
+			name:     "none with reserved field",
+			operator: "none",
+			args:     []any{map[string]any{"var": "entries"}, map[string]any{"==": []any{map[string]any{"var": "item.order.name"}, "x"}}},
+			expected: "NOT EXISTS (SELECT 1 FROM json_each(entries) AS je WHERE json_extract(je.value, '$.order.name') = 'x')",
+		},
+		{
+			name:     "literals naming the element are left alone",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.x"}, "elem.x or elem"}}},
+			expected: "EXISTS (SELECT 1 FROM json_each(items) AS je WHERE json_extract(je.value, '$.x') = 'elem.x or elem')",
+		},
+		{
+			name:     "merge with literal array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}, []any{1, 2}},
+			expected: "(SELECT json_group_array(je.value) FROM json_each(json_array(json(a), json(json_array(1, 2)))) AS src, json_each(src.value) AS je)",
+		},
+		{
+			name:     "merge single array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}},
+			expected: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSQLiteArrayElement(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected string
+	}{
+		{nil, "je.value"},
+		{[]string{"price"}, "json_extract(je.value, '$.price')"},
+		{[]string{"order", "name"}, "json_extract(je.value, '$.order.name')"},
+		{[]string{"first name"}, `json_extract(je.value, '$."first name"')`},
+	}
+
+	spec := SpecFor(dialect.DialectSQLite)
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got, err := spec.ArrayElement(tt.path, nil)
+			if err != nil {
+				t.Fatalf("ArrayElement(%q) error: %v", tt.path, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ArrayElement(%q) = %q, want %q", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_Snowflake(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectSnowflake, nil)
+	op := NewArrayOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+	}{
+		{
+			name:     "map with transformation",
+			operator: "map",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "item"}, 2}}},
+			expected: "TRANSFORM(numbers, elem -> (elem * 2))",
+		},
+		{
+			name:     "filter with condition",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": "item"}, 70}}},
+			expected: "FILTER(scores, elem -> elem >= 70)",
+		},
+		{
+			name:     "reduce with SUM pattern",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
+			expected: "REDUCE(numbers, 0, (acc, elem) -> acc + elem)",
+		},
+		{
+			name:     "reduce with MAX pattern on current.price",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.price"}}}, 0},
+			expected: "REDUCE(items, 0, (acc, elem) -> GREATEST(acc, elem:price))",
+		},
+		{
+			name:     "reduce general expression",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 1},
+			expected: "REDUCE(numbers, 1, (acc, elem) -> (acc * elem))",
+		},
+		{
+			name:     "reduce leaves literals and columns naming the accumulator alone",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "words"}, map[string]any{"if": []any{map[string]any{"==": []any{map[string]any{"var": "current"}, "accumulator"}}, map[string]any{"var": "accumulator_total"}, map[string]any{"var": "accumulator"}}}, 0},
+			expected: "REDUCE(words, 0, (acc, elem) -> IFF(elem = 'accumulator', accumulator_total, acc))",
+		},
+		{
+			name:     "all with condition",
+			operator: "all",
+			args:     []any{map[string]any{"var": "values"}, map[string]any{">": []any{map[string]any{"var": "item"}, 0}}},
+			expected: `ARRAY_SIZE(FILTER("values", elem -> NOT (elem > 0))) = 0`,
+		},
+		{
+			name:     "some with field access",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.status"}, "active"}}},
+			expected: "ARRAY_SIZE(FILTER(items, elem -> elem:status = 'active')) > 0",
+		},
+		{
+			name:     "literals naming the element are left alone",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.x"}, "elem.x or elem"}}},
+			expected: "ARRAY_SIZE(FILTER(items, elem -> elem:x = 'elem.x or elem')) > 0",
+		},
+		{
+			name:     "none with reserved field",
+			operator: "none",
+			args:     []any{map[string]any{"var": "entries"}, map[string]any{"==": []any{map[string]any{"var": "item.order.name"}, "x"}}},
+			expected: `ARRAY_SIZE(FILTER(entries, elem -> elem:"order".name = 'x')) = 0`,
+		},
+		{
+			name:     "merge three arrays",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}, []any{1, 2}, map[string]any{"var": "b"}},
+			expected: "ARRAY_CAT(ARRAY_CAT(a, ARRAY_CONSTRUCT(1, 2)), b)",
+		},
+		{
+			name:     "merge single array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}},
+			expected: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSnowflakeArrayElement(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected string
+	}{
+		{nil, "elem"},
+		{[]string{"price"}, "elem:price"},
+		{[]string{"order", "name"}, `elem:"order".name`},
+		{[]string{"first name"}, `elem:"first name"`},
+	}
+
+	spec := SpecFor(dialect.DialectSnowflake)
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got, err := spec.ArrayElement(tt.path, nil)
+			if err != nil {
+				t.Fatalf("ArrayElement(%q) error: %v", tt.path, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ArrayElement(%q) = %q, want %q", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_SQLServer(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectSQLServer, nil)
+	op := NewArrayOperator(config)
+	elemJSON := `CASE je.type WHEN 0 THEN 'null' WHEN 1 THEN CONCAT('"', STRING_ESCAPE(je.value, 'json'), '"') ELSE je.value END`
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "map with transformation",
+			operator: "map",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "item"}, 2}}},
+			expected: "(SELECT CONCAT('[', STRING_AGG(SUBSTRING(m.j, 2, LEN(m.j) - 2), ',') WITHIN GROUP (ORDER BY CAST(je.[key] AS INT)), ']') " +
+				"FROM OPENJSON(numbers) AS je CROSS APPLY (SELECT JSON_ARRAY((je.value * 2) NULL ON NULL) AS j) AS m)",
+		},
+		{
+			name:     "filter with condition",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": "item"}, 70}}},
+			expected: "(SELECT CONCAT('[', STRING_AGG(" + elemJSON + ", ',') WITHIN GROUP (ORDER BY CAST(je.[key] AS INT)), ']') " +
+				"FROM OPENJSON(scores) AS je WHERE je.value >= 70)",
+		},
+		{
+			name:     "reduce with SUM pattern",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
+			expected: "0 + COALESCE((SELECT SUM(CAST(je.value AS FLOAT)) FROM OPENJSON(numbers) AS je), 0)",
+		},
+		{
+			name:     "reduce with MAX pattern on current.price",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.price"}}}, 0},
+			expected: "(SELECT MAX(v) FROM (VALUES (0), ((SELECT MAX(CAST(JSON_VALUE(je.value, '$.price') AS FLOAT)) FROM OPENJSON(items) AS je))) AS t(v))",
+		},
+		{
+			// The initial value takes part in the minimum, so 10 over [20, 30] is 10
+			name:     "reduce with MIN pattern, current first",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"min": []any{map[string]any{"var": "current"}, map[string]any{"var": "accumulator"}}}, 10},
+			expected: "(SELECT MIN(v) FROM (VALUES (10), ((SELECT MIN(CAST(je.value AS FLOAT)) FROM OPENJSON(numbers) AS je))) AS t(v))",
+		},
+		{
+			name:     "reduce general expression",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 1},
+			errMsg:   "unsupported reduce body on SQL Server",
+		},
+		{
+			name:     "all with condition",
+			operator: "all",
+			args:     []any{map[string]any{"var": "values"}, map[string]any{">": []any{map[string]any{"var": "item"}, 0}}},
+			expected: "NOT EXISTS (SELECT 1 FROM OPENJSON([values]) AS je WHERE NOT (je.value > 0))",
+		},
+		{
+			name:     "some with field access",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.status"}, "active"}}},
+			expected: "EXISTS (SELECT 1 FROM OPENJSON(items) AS je WHERE JSON_VALUE(je.value, '$.status') = 'active')",
+		},
+		{
+			name:     "literals naming the element are left alone",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.x"}, "elem.x or elem"}}},
+			expected: "EXISTS (SELECT 1 FROM OPENJSON(items) AS je WHERE JSON_VALUE(je.value, '$.x') = 'elem.x or elem')",
+		},
+		{
+			name:     "none with reserved field",
+			operator: "none",
+			args:     []any{map[string]any{"var": "entries"}, map[string]any{"==": []any{map[string]any{"var": "item.order.name"}, "x"}}},
+			expected: "NOT EXISTS (SELECT 1 FROM OPENJSON(entries) AS je WHERE JSON_VALUE(je.value, '$.order.name') = 'x')",
+		},
+		{
+			name:     "merge three arrays",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}, []any{1, 2}, map[string]any{"var": "b"}},
+			expected: "(SELECT CONCAT('[', STRING_AGG(" + elemJSON + ", ',') WITHIN GROUP (ORDER BY src.n, CAST(je.[key] AS INT)), ']') " +
+				"FROM (VALUES (1, a), (2, JSON_ARRAY(1, 2)), (3, b)) AS src(n, arr) CROSS APPLY OPENJSON(src.arr) AS je)",
+		},
+		{
+			name:     "merge single array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}},
+			expected: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_Trino(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectTrino, nil)
+	op := NewArrayOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+	}{
+		{
+			name:     "map with transformation",
+			operator: "map",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "item"}, 2}}},
+			expected: "transform(numbers, elem -> (elem * 2))",
+		},
+		{
+			name:     "filter with condition",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": "item"}, 70}}},
+			expected: "filter(scores, elem -> elem >= 70)",
+		},
+		{
+			name:     "reduce with SUM pattern",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
+			expected: "reduce(numbers, 0, (acc, elem) -> acc + elem, acc -> acc)",
+		},
+		{
+			name:     "reduce with MAX pattern on current.price",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.price"}}}, 0},
+			expected: "reduce(items, 0, (acc, elem) -> GREATEST(acc, elem.price), acc -> acc)",
+		},
+		{
+			name:     "reduce general expression",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 1},
+			expected: "reduce(numbers, 1, (acc, elem) -> (acc * elem), acc -> acc)",
+		},
+		{
+			name:     "reduce leaves literals and columns naming the accumulator alone",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "words"}, map[string]any{"if": []any{map[string]any{"==": []any{map[string]any{"var": "current"}, "accumulator"}}, map[string]any{"var": "accumulator_total"}, map[string]any{"var": "accumulator"}}}, 0},
+			expected: "reduce(words, 0, (acc, elem) -> CASE WHEN elem = 'accumulator' THEN accumulator_total ELSE acc END, acc -> acc)",
+		},
+		{
+			name:     "all with condition",
+			operator: "all",
+			args:     []any{map[string]any{"var": "values"}, map[string]any{">": []any{map[string]any{"var": "item"}, 0}}},
+			expected: `all_match("values", elem -> elem > 0)`,
+		},
+		{
+			name:     "some with field access",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.status"}, "active"}}},
+			expected: "any_match(items, elem -> elem.status = 'active')",
+		},
+		{
+			name:     "none with reserved field",
+			operator: "none",
+			args:     []any{map[string]any{"var": "entries"}, map[string]any{"==": []any{map[string]any{"var": "item.values.name"}, "x"}}},
+			expected: `none_match(entries, elem -> elem."values".name = 'x')`,
+		},
+		{
+			name:     "merge three arrays",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}, []any{1, 2}, map[string]any{"var": "b"}},
+			expected: "concat(a, ARRAY[1, 2], b)",
+		},
+		{
+			name:     "merge single array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}},
+			expected: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_Oracle(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectOracle, nil)
+	op := NewArrayOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "map with transformation",
+			operator: "map",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "item"}, 2}}},
+			expected: "COALESCE((SELECT JSON_ARRAYAGG((elem * 2) ORDER BY elem_pos NULL ON NULL) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt), '[]')",
+		},
+		{
+			name:     "filter with condition",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": "item"}, 70}}},
+			expected: "COALESCE((SELECT JSON_ARRAYAGG(SUBSTR(elem_json, 2, LENGTH(elem_json) - 2) FORMAT JSON ORDER BY elem_pos NULL ON NULL) FROM JSON_TABLE(scores, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE elem >= 70), '[]')",
+		},
+		{
+			name:     "reduce with SUM pattern",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
+			expected: "0 + NVL((SELECT SUM(elem) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem NUMBER PATH '$')) jt), 0)",
+		},
+		{
+			name:     "reduce with MAX pattern on current.price",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.price"}}}, 0},
+			expected: "GREATEST(0, NVL((SELECT MAX(elem) FROM JSON_TABLE(items, '$[*]' COLUMNS (elem NUMBER PATH '$.price')) jt), 0))",
+		},
+		{
+			// The initial value takes part in the minimum, so 10 over [20, 30] is 10
+			name:     "reduce with MIN pattern, current first",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"min": []any{map[string]any{"var": "current"}, map[string]any{"var": "accumulator"}}}, 10},
+			expected: "LEAST(10, NVL((SELECT MIN(elem) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem NUMBER PATH '$')) jt), 10))",
+		},
+		{
+			name:     "reduce general expression",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 1},
+			errMsg:   "unsupported reduce body on Oracle",
+		},
+		{
+			name:     "all with condition",
+			operator: "all",
+			args:     []any{map[string]any{"var": "values"}, map[string]any{">": []any{map[string]any{"var": "item"}, 0}}},
+			expected: `NOT EXISTS (SELECT 1 FROM JSON_TABLE("values", '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE NOT (elem > 0))`,
+		},
+		{
+			name:     "some with field access",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.status"}, "active"}}},
+			expected: "EXISTS (SELECT 1 FROM JSON_TABLE(items, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE JSON_VALUE(elem_json, '$[0].status') = 'active')",
+		},
+		{
+			name:     "literals naming the element are left alone",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.x"}, "elem.x or elem"}}},
+			expected: "EXISTS (SELECT 1 FROM JSON_TABLE(items, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE JSON_VALUE(elem_json, '$[0].x') = 'elem.x or elem')",
+		},
+		{
+			name:     "none with reserved field",
+			operator: "none",
+			args:     []any{map[string]any{"var": "entries"}, map[string]any{"==": []any{map[string]any{"var": "item.level.name"}, "x"}}},
+			expected: "NOT EXISTS (SELECT 1 FROM JSON_TABLE(entries, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt WHERE JSON_VALUE(elem_json, '$[0].level.name') = 'x')",
+		},
+		{
+			name:     "merge three arrays",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}, []any{1, 2}, map[string]any{"var": "b"}},
+			expected: "COALESCE((SELECT JSON_ARRAYAGG(SUBSTR(elem_json, 2, LENGTH(elem_json) - 2) FORMAT JSON ORDER BY src.n, elem_pos NULL ON NULL) " +
+				"FROM (SELECT 1 AS n, a AS arr FROM DUAL UNION ALL SELECT 2 AS n, JSON_ARRAY(1, 2) AS arr FROM DUAL UNION ALL SELECT 3 AS n, b AS arr FROM DUAL) src, " +
+				"JSON_TABLE(src.arr, '$[*]' COLUMNS (elem_pos FOR ORDINALITY, elem VARCHAR2(4000) PATH '$', elem_json VARCHAR2(4000) FORMAT JSON WITH WRAPPER PATH '$')) jt), '[]')",
+		},
+		{
+			name:     "merge single array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}},
+			expected: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_SparkSQL(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectSparkSQL, nil)
+	op := NewArrayOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+	}{
+		{
+			name:     "map with transformation",
+			operator: "map",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "item"}, 2}}},
+			expected: "transform(numbers, elem -> (elem * 2))",
+		},
+		{
+			name:     "filter with condition",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": "item"}, 70}}},
+			expected: "filter(scores, elem -> elem >= 70)",
+		},
+		{
+			name:     "reduce with SUM pattern",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
+			expected: "aggregate(numbers, CAST(0 AS DOUBLE), (acc, elem) -> acc + elem)",
+		},
+		{
+			name:     "reduce with MIN pattern on current.price",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"min": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.price"}}}, 100},
+			expected: "aggregate(items, CAST(100 AS DOUBLE), (acc, elem) -> LEAST(acc, elem.price))",
+		},
+		{
+			name:     "reduce general expression",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 1},
+			expected: "aggregate(numbers, 1, (acc, elem) -> (acc * elem))",
+		},
+		{
+			name:     "reduce leaves literals and columns naming the accumulator alone",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "words"}, map[string]any{"if": []any{map[string]any{"==": []any{map[string]any{"var": "current"}, "accumulator"}}, map[string]any{"var": "accumulator_total"}, map[string]any{"var": "accumulator"}}}, 0},
+			expected: "aggregate(words, 0, (acc, elem) -> CASE WHEN elem = 'accumulator' THEN accumulator_total ELSE acc END)",
+		},
+		{
+			name:     "all with condition",
+			operator: "all",
+			args:     []any{map[string]any{"var": "values"}, map[string]any{">": []any{map[string]any{"var": "item"}, 0}}},
+			expected: "forall(values, elem -> elem > 0)",
+		},
+		{
+			name:     "some with field access",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.status"}, "active"}}},
+			expected: "exists(items, elem -> elem.status = 'active')",
+		},
+		{
+			name:     "none with reserved field",
+			operator: "none",
+			args:     []any{map[string]any{"var": "entries"}, map[string]any{"==": []any{map[string]any{"var": "item.user.name"}, "x"}}},
+			expected: "NOT exists(entries, elem -> elem.`user`.name = 'x')",
+		},
+		{
+			name:     "merge three arrays",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}, []any{1, 2}, map[string]any{"var": "b"}},
+			expected: "concat(a, array(1, 2), b)",
+		},
+		{
+			name:     "merge single array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}},
+			expected: "concat(a)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestOracleArrayElement(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected string
+	}{
+		{nil, "elem"},
+		{[]string{"price"}, "JSON_VALUE(elem_json, '$[0].price')"},
+		{[]string{"first name"}, `JSON_VALUE(elem_json, '$[0]."first name"')`},
+		{[]string{`a"b`, "c"}, `JSON_VALUE(elem_json, '$[0]."a\"b".c')`},
+	}
+
+	spec := SpecFor(dialect.DialectOracle)
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got, err := spec.ArrayElement(tt.path, nil)
+			if err != nil {
+				t.Fatalf("ArrayElement(%q) error: %v", tt.path, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ArrayElement(%q) = %q, want %q", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSQLServerArrayElement(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected string
+	}{
+		{nil, "je.value"},
+		{[]string{"price"}, "JSON_VALUE(je.value, '$.price')"},
+		{[]string{"first name"}, `JSON_VALUE(je.value, '$."first name"')`},
+		{[]string{"a]b.c", "d"}, `JSON_VALUE(je.value, '$."a]b.c".d')`},
+	}
+
+	spec := SpecFor(dialect.DialectSQLServer)
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got, err := spec.ArrayElement(tt.path, nil)
+			if err != nil {
+				t.Fatalf("ArrayElement(%q) error: %v", tt.path, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ArrayElement(%q) = %q, want %q", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_MySQL(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectMySQL, nil)
+	op := NewArrayOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "map with transformation",
+			operator: "map",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "item"}, 2}}},
+			expected: "COALESCE((SELECT JSON_ARRAYAGG((elem * 2)) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt), JSON_ARRAY())",
+		},
+		{
+			name:     "filter with condition",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": "item"}, 70}}},
+			expected: "COALESCE((SELECT JSON_ARRAYAGG(elem) FROM JSON_TABLE(scores, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE elem >= 70), JSON_ARRAY())",
+		},
+		{
+			name:     "reduce with SUM pattern",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"+": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 0},
+			expected: "0 + COALESCE((SELECT SUM(elem) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem DOUBLE PATH '$')) AS jt), 0)",
+		},
+		{
+			name:     "reduce with MAX pattern on current.price",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"max": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current.price"}}}, 0},
+			expected: "GREATEST(0, COALESCE((SELECT MAX(elem) FROM JSON_TABLE(items, '$[*]' COLUMNS (elem DOUBLE PATH '$.price')) AS jt), 0))",
+		},
+		{
+			// The initial value takes part in the minimum, so 10 over [20, 30] is 10
+			name:     "reduce with MIN pattern, current first",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"min": []any{map[string]any{"var": "current"}, map[string]any{"var": "accumulator"}}}, 10},
+			expected: "LEAST(10, COALESCE((SELECT MIN(elem) FROM JSON_TABLE(numbers, '$[*]' COLUMNS (elem DOUBLE PATH '$')) AS jt), 10))",
+		},
+		{
+			name:     "all with condition",
+			operator: "all",
+			args:     []any{map[string]any{"var": "values"}, map[string]any{">": []any{map[string]any{"var": "item"}, 0}}},
+			expected: "NOT EXISTS (SELECT 1 FROM JSON_TABLE(`values`, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE NOT (elem > 0))",
+		},
+		{
+			name:     "some with field access",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.status"}, "active"}}},
+			expected: "EXISTS (SELECT 1 FROM JSON_TABLE(items, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE JSON_EXTRACT(elem, '$.status') = 'active')",
+		},
+		{
+			name:     "literals naming the element are left alone",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.x"}, "elem.x or elem"}}},
+			expected: "EXISTS (SELECT 1 FROM JSON_TABLE(items, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE JSON_EXTRACT(elem, '$.x') = 'elem.x or elem')",
+		},
+		{
+			name:     "quoted element field",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "item.first name"}, "x"}}},
+			expected: `EXISTS (SELECT 1 FROM JSON_TABLE(items, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE JSON_EXTRACT(elem, '$."first name"') = 'x')`,
+		},
+		{
+			name:     "none with reserved field",
+			operator: "none",
+			args:     []any{map[string]any{"var": "rows"}, map[string]any{"==": []any{map[string]any{"var": "item.key.name"}, "x"}}},
+			expected: "NOT EXISTS (SELECT 1 FROM JSON_TABLE(`rows`, '$[*]' COLUMNS (elem JSON PATH '$')) AS jt WHERE JSON_EXTRACT(elem, '$.key.name') = 'x')",
+		},
+		{
+			name:     "merge with literal array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}, []any{1, 2}},
+			expected: "JSON_MERGE_PRESERVE(a, JSON_ARRAY(1, 2))",
+		},
+		{
+			name:     "reduce general expression",
+			operator: "reduce",
+			args:     []any{map[string]any{"var": "numbers"}, map[string]any{"*": []any{map[string]any{"var": "accumulator"}, map[string]any{"var": "current"}}}, 1},
+			errMsg:   "unsupported reduce body on MySQL",
+		},
+		{
+			name:     "merge single array",
+			operator: "merge",
+			args:     []any{map[string]any{"var": "a"}},
+			expected: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_ElementSchema(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"items":              "array",
+			"items[].price":      "number",
+			"items[].status":     "enum",
+			"items[].name":       "string",
+			"items[].lines":      "array",
+			"items[].lines[].id": "string",
+			"name":               "string",
+		},
+	}
+	op := NewArrayOperator(NewOperatorConfig(dialect.DialectBigQuery, schema))
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "element field",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{">": []any{map[string]any{"var": "item.price"}, 5}}},
+			expected: "EXISTS (SELECT 1 FROM UNNEST(items) AS elem WHERE elem.price > 5)",
+		},
+		{
+			name:     "nested element array",
+			operator: "filter",
+			args: []any{map[string]any{"var": "items"}, map[string]any{"some": []any{
+				map[string]any{"var": "item.lines"}, map[string]any{"==": []any{map[string]any{"var": "item.id"}, "a"}},
+			}}},
+			expected: "ARRAY(SELECT elem FROM UNNEST(items) AS elem WHERE EXISTS (SELECT 1 FROM UNNEST(elem.lines) AS elem WHERE elem.id = 'a'))",
+		},
+		{
+			name:     "ordering on enum element field",
+			operator: "some",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{">": []any{map[string]any{"var": "item.status"}, 5}}},
+			errMsg:   "on incompatible field 'item.status' (type: enum)",
+		},
+		{
+			name:     "array operation on string element field",
+			operator: "some",
+			args: []any{map[string]any{"var": "items"}, map[string]any{"all": []any{
+				map[string]any{"var": "item.name"}, map[string]any{"==": []any{map[string]any{"var": ""}, "a"}},
+			}}},
+			errMsg: "array operation on non-array field 'item.name' (type: string)",
+		},
+		{
+			name:     "fields outside the element",
+			operator: "none",
+			args:     []any{map[string]any{"var": "items"}, map[string]any{"==": []any{map[string]any{"var": "name"}, "a"}}},
+			expected: "NOT EXISTS (SELECT 1 FROM UNNEST(items) AS elem WHERE name = 'a')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("ToSQL() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArrayOperator_ElementTypes(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags":     "array",
+			"tags[]":   "string",
+			"flags":    "array",
+			"flags[]":  "boolean",
+			"scores":   "array",
+			"scores[]": "integer",
+			"untyped":  "array",
+		},
+	}
+	op := NewArrayOperator(NewOperatorConfig(dialect.DialectBigQuery, schema))
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []any
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "number against string elements",
+			operator: "some",
+			args:     []any{map[string]any{"var": "tags"}, map[string]any{">": []any{map[string]any{"var": ""}, 5}}},
+			errMsg:   "ordering comparison '>' between string field 'tags[]' and number 5",
+		},
+		{
+			name:     "ordering on boolean elements",
+			operator: "all",
+			args:     []any{map[string]any{"var": "flags"}, map[string]any{"<": []any{map[string]any{"var": "item"}, 1}}},
+			errMsg:   "ordering comparison '<' on incompatible field 'item' (type: boolean)",
+		},
+		{
+			name:     "numeric string coerced for integer elements",
+			operator: "some",
+			args:     []any{map[string]any{"var": "scores"}, map[string]any{">=": []any{map[string]any{"var": ""}, "10"}}},
+			expected: "EXISTS (SELECT 1 FROM UNNEST(scores) AS elem WHERE elem >= 10)",
+		},
+		{
+			name:     "truthiness of boolean elements",
+			operator: "some",
+			args:     []any{map[string]any{"var": "flags"}, map[string]any{"!!": map[string]any{"var": ""}}},
+			expected: "EXISTS (SELECT 1 FROM UNNEST(flags) AS elem WHERE elem IS TRUE)",
+		},
+		{
+			name:     "truthiness of string elements",
+			operator: "filter",
+			args:     []any{map[string]any{"var": "tags"}, map[string]any{"!!": []any{map[string]any{"var": "current"}}}},
+			expected: "ARRAY(SELECT elem FROM UNNEST(tags) AS elem WHERE (elem IS NOT NULL AND elem != ''))",
+		},
+		{
+			name:     "untyped elements",
+			operator: "some",
+			args:     []any{map[string]any{"var": "untyped"}, map[string]any{">": []any{map[string]any{"var": ""}, 5}}},
+			expected: "EXISTS (SELECT 1 FROM UNNEST(untyped) AS elem WHERE elem > 5)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("ToSQL() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -4,8 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-
-	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+	"time"
 )
 
 // ComparisonOperator handles comparison operators (==, ===, !=, !==, >, >=, <, <=).
@@ -30,36 +29,48 @@ func (c *ComparisonOperator) schema() SchemaProvider {
 	return c.config.Schema
 }
 
-// strposFunc returns the appropriate string position function call based on dialect.
-// BigQuery/Spanner/DuckDB: STRPOS(haystack, needle)
-// PostgreSQL: POSITION(needle IN haystack)
-// ClickHouse: position(haystack, needle).
+// strposFunc returns the 1-based position of needle in haystack using the
+// dialect's string position function.
 func (c *ComparisonOperator) strposFunc(haystack, needle string) string {
-	d := dialect.DialectUnspecified
-	if c.config != nil {
-		d = c.config.GetDialect()
+	return c.config.Spec().StringPosition(haystack, needle)
+}
+
+// operandToSQL converts an equality or ordering operand to SQL.
+// Oracle stores the empty string as NULL, so an equality with it never
+// matches; the empty string literal becomes NULL there and is never bound as a
+// parameter.
+func (c *ComparisonOperator) operandToSQL(value interface{}) (string, error) {
+	if c.isOracleEmptyString(value) {
+		return "NULL", nil
 	}
+	return c.valueToSQL(value)
+}
 
-	//nolint:exhaustive // default handles BigQuery/Spanner/DuckDB
-	switch d {
-	case dialect.DialectPostgreSQL:
-		return fmt.Sprintf("POSITION(%s IN %s)", needle, haystack)
-	case dialect.DialectClickHouse:
-		return fmt.Sprintf("position(%s, %s)", haystack, needle)
-	default:
-		return fmt.Sprintf("STRPOS(%s, %s)", haystack, needle)
+// isOracleEmptyString reports whether value is the empty string literal and the
+// dialect is Oracle.
+func (c *ComparisonOperator) isOracleEmptyString(value interface{}) bool {
+	if !c.config.IsOracle() {
+		return false
 	}
+	if pv, ok := value.(ProcessedValue); ok {
+		if pv.IsSQL {
+			return false
+		}
+		value = pv.Value
+	}
+	s, ok := value.(string)
+	return ok && s == ""
 }
 
 // validateOrderingOperand checks if a field used in an ordering comparison is of a valid type
-// Only numeric and string types support ordering comparisons (>, >=, <, <=)
+// Only numeric, string and temporal types support ordering comparisons (>, >=, <, <=)
 // Rejects array, object, and boolean types.
 func (c *ComparisonOperator) validateOrderingOperand(value interface{}, operator string) error {
 	if c.schema() == nil {
 		return nil // No schema, no validation
 	}
 
-	fieldName := c.extractFieldNameFromValue(value)
+	fieldName := varFieldName(value)
 	if fieldName == "" {
 		return nil // Can't determine field name, skip validation
 	}
@@ -69,36 +80,13 @@ func (c *ComparisonOperator) validateOrderingOperand(value interface{}, operator
 		return nil // Field not in schema, skip validation (existence checked by DataOperator)
 	}
 
-	// Allow numeric and string types for ordering comparisons
-	if c.schema().IsNumericType(fieldName) || c.schema().IsStringType(fieldName) {
+	// Allow numeric, string and temporal types for ordering comparisons
+	if c.schema().IsNumericType(fieldName) || c.schema().IsStringType(fieldName) || IsTemporalType(fieldType) {
 		return nil
 	}
 
 	// Disallow array, object, boolean for ordering comparisons
-	return fmt.Errorf("ordering comparison '%s' on incompatible field '%s' (type: %s)", operator, fieldName, fieldType)
-}
-
-// extractFieldNameFromValue extracts field name from a value that might be a var expression.
-func (c *ComparisonOperator) extractFieldNameFromValue(value interface{}) string {
-	if varExpr, ok := value.(map[string]interface{}); ok {
-		if varName, hasVar := varExpr[OpVar]; hasVar {
-			return c.extractFieldName(varName)
-		}
-	}
-	return ""
-}
-
-// extractFieldName extracts the field name from a var argument.
-func (c *ComparisonOperator) extractFieldName(varName interface{}) string {
-	if nameStr, ok := varName.(string); ok {
-		return nameStr
-	}
-	if nameArr, ok := varName.([]interface{}); ok && len(nameArr) > 0 {
-		if nameStr, ok := nameArr[0].(string); ok {
-			return nameStr
-		}
-	}
-	return ""
+	return fmt.Errorf("ordering comparison '%s' on incompatible field '%s' (type: %s)", operator, fieldLabel(c.schema(), fieldName), fieldType)
 }
 
 // coerceValueForComparison coerces a literal value based on the type of the field being compared.
@@ -129,6 +117,136 @@ func (c *ComparisonOperator) coerceValueForComparison(value interface{}, fieldNa
 	return value
 }
 
+// temporalValue renders a literal compared with a date, datetime, timestamp or
+// time field as a typed literal, validating that it is an ISO-8601 string.
+// Other values, including NULL and expressions, are returned unchanged.
+func (c *ComparisonOperator) temporalValue(value interface{}, fieldName string) (interface{}, error) {
+	if c.schema() == nil || fieldName == "" || value == nil {
+		return value, nil
+	}
+	fieldType := c.schema().GetFieldType(fieldName)
+	if !IsTemporalType(fieldType) {
+		return value, nil
+	}
+
+	switch v := value.(type) {
+	case ProcessedValue:
+		if v.IsSQL {
+			return value, nil
+		}
+		value = v.Value
+	case map[string]interface{}:
+		return value, nil
+	}
+
+	sql, err := c.config.TemporalLiteral(fieldType, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for field '%s': %w", fieldLabel(c.schema(), fieldName), err)
+	}
+	return SQLResult(sql), nil
+}
+
+// dateExpressionKind returns the temporal type of a date operator that
+// returns a date, such as date_sub or now, or an empty string for any other
+// value.
+func (c *ComparisonOperator) dateExpressionKind(value interface{}) string {
+	operator, _ := expressionOperator(value)
+	switch operator {
+	case OpNow, OpDaysAgo:
+		return TypeTimestamp
+	case OpDateAdd, OpDateSub, OpDateTrunc:
+		return NewDateOperator(c.config).expressionKind(value.(map[string]interface{}))
+	default:
+		return ""
+	}
+}
+
+// dateLiteral renders a string literal compared with a date of the given
+// kind as a typed literal, as temporalValue does for temporal fields. The
+// literal is typed in the common type of the date and the literal itself, so
+// a date compared with a timestamp literal is compared as a timestamp. Other
+// values are returned unchanged.
+func (c *ComparisonOperator) dateLiteral(value interface{}, kind string) (interface{}, error) {
+	if kind == "" {
+		return value, nil
+	}
+	literal := value
+	if pv, ok := value.(ProcessedValue); ok && !pv.IsSQL {
+		literal = pv.Value
+	}
+	text, ok := literal.(string)
+	if !ok {
+		return value, nil
+	}
+	literalKind := TypeTimestamp
+	if _, err := time.Parse(dateLayout, text); err == nil {
+		literalKind = TypeDate
+	}
+
+	sql, err := c.config.TemporalLiteral(commonKind(kind, literalKind), text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value compared with a date: %w", err)
+	}
+	return SQLResult(sql), nil
+}
+
+// validateTemporalOrdering rejects ordering comparisons between fields whose
+// values cannot be ordered against each other: a time of day with a date or
+// datetime, or a temporal field with a field of another type.
+func (c *ComparisonOperator) validateTemporalOrdering(operator string, args []interface{}) error {
+	if c.schema() == nil {
+		return nil
+	}
+
+	var firstName, firstType string
+	for _, arg := range args {
+		fieldName := varFieldName(arg)
+		if fieldName == "" {
+			continue
+		}
+		fieldType := c.schema().GetFieldType(fieldName)
+		if fieldType == "" {
+			continue
+		}
+		if firstName == "" {
+			firstName, firstType = fieldName, fieldType
+			continue
+		}
+
+		firstTemporal, temporal := IsTemporalType(firstType), IsTemporalType(fieldType)
+		if !firstTemporal && !temporal {
+			continue
+		}
+		if firstTemporal != temporal || temporalClass(firstType) != temporalClass(fieldType) {
+			return fmt.Errorf("ordering comparison '%s' between incompatible fields '%s' (type: %s) and '%s' (type: %s)",
+				operator, firstName, firstType, fieldName, fieldType)
+		}
+	}
+	return nil
+}
+
+// validateStringOrdering rejects ordering a string field against a number
+// literal, which most dialects refuse to compare.
+func (c *ComparisonOperator) validateStringOrdering(operator string, args []interface{}) error {
+	if c.schema() == nil {
+		return nil
+	}
+
+	for _, arg := range args {
+		fieldName := varFieldName(arg)
+		if fieldName == "" || !c.schema().IsStringType(fieldName) {
+			continue
+		}
+		for _, other := range args {
+			switch other.(type) {
+			case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+				return fmt.Errorf("ordering comparison '%s' between string field '%s' and number %v", operator, fieldLabel(c.schema(), fieldName), other)
+			}
+		}
+	}
+	return nil
+}
+
 // validateEnumValue validates that a value is valid for an enum field.
 // Returns nil if valid or if not an enum field.
 func (c *ComparisonOperator) validateEnumValue(value interface{}, fieldName string) error {
@@ -185,8 +303,8 @@ func (c *ComparisonOperator) ToSQL(operator string, args []interface{}) (string,
 	leftArg := args[0]
 	rightArg := args[1]
 
-	leftFieldName := c.extractFieldNameFromValue(leftArg)
-	rightFieldName := c.extractFieldNameFromValue(rightArg)
+	leftFieldName := varFieldName(leftArg)
+	rightFieldName := varFieldName(rightArg)
 
 	// If left is a field and right is a literal, coerce right based on left's type
 	if leftFieldName != "" && rightFieldName == "" {
@@ -195,6 +313,10 @@ func (c *ComparisonOperator) ToSQL(operator string, args []interface{}) (string,
 		if err := c.validateEnumValue(rightArg, leftFieldName); err != nil {
 			return "", err
 		}
+		var err error
+		if rightArg, err = c.temporalValue(rightArg, leftFieldName); err != nil {
+			return "", err
+		}
 	}
 	// If right is a field and left is a literal, coerce left based on right's type
 	if rightFieldName != "" && leftFieldName == "" {
@@ -203,14 +325,33 @@ func (c *ComparisonOperator) ToSQL(operator string, args []interface{}) (string,
 		if err := c.validateEnumValue(leftArg, rightFieldName); err != nil {
 			return "", err
 		}
+		var err error
+		if leftArg, err = c.temporalValue(leftArg, rightFieldName); err != nil {
+			return "", err
+		}
 	}
 
-	leftSQL, err := c.valueToSQL(leftArg)
+	// If neither side is a field, type a literal compared with a date
+	// operator. Each side is typed just before it is rendered, so that
+	// placeholders are numbered in order.
+	dateLiterals := leftFieldName == "" && rightFieldName == ""
+	var err error
+	if dateLiterals {
+		if leftArg, err = c.dateLiteral(leftArg, c.dateExpressionKind(rightArg)); err != nil {
+			return "", err
+		}
+	}
+	leftSQL, err := c.operandToSQL(leftArg)
 	if err != nil {
 		return "", fmt.Errorf("invalid left operand: %w", err)
 	}
 
-	rightSQL, err := c.valueToSQL(rightArg)
+	if dateLiterals {
+		if rightArg, err = c.dateLiteral(rightArg, c.dateExpressionKind(args[0])); err != nil {
+			return "", err
+		}
+	}
+	rightSQL, err := c.operandToSQL(rightArg)
 	if err != nil {
 		return "", fmt.Errorf("invalid right operand: %w", err)
 	}
@@ -320,7 +461,11 @@ func (c *ComparisonOperator) valueToSQL(value interface{}) (string, error) {
 					return c.processArithmeticExpression(op, args)
 				case ">", ">=", "<", "<=", "==", "===", "!=", "!==":
 					// Handle comparison operations
-					return c.processComparisonExpression(op, args)
+					sql, err := c.processComparisonExpression(op, args)
+					if err != nil {
+						return "", err
+					}
+					return c.config.PredicateValue(expr, sql), nil
 				case "max", "min":
 					// Handle min/max operations
 					return c.processMinMaxExpression(op, args)
@@ -337,7 +482,11 @@ func (c *ComparisonOperator) valueToSQL(value interface{}) (string, error) {
 					// Try to process them directly as a fallback
 					if arr, ok := args.([]interface{}); ok {
 						arrayOp := NewArrayOperator(c.config)
-						return arrayOp.ToSQL(op, arr)
+						sql, err := arrayOp.ToSQL(op, arr)
+						if err != nil {
+							return "", err
+						}
+						return c.config.PredicateValue(expr, sql), nil
 					}
 					return "", fmt.Errorf("array operator %s requires array arguments", op)
 				case "cat", "substr":
@@ -351,7 +500,11 @@ func (c *ComparisonOperator) valueToSQL(value interface{}) (string, error) {
 					// Try to use the expression parser callback for unknown operators
 					// This enables support for custom operators in nested contexts
 					if c.config != nil && c.config.HasExpressionParser() {
-						return c.config.ParseExpression(expr, "$")
+						sql, err := c.config.ParseExpression(expr, "$")
+						if err != nil {
+							return "", err
+						}
+						return c.config.PredicateValue(expr, sql), nil
 					}
 					return "", fmt.Errorf("unsupported expression type in comparison: %s", op)
 				}
@@ -372,7 +525,7 @@ func (c *ComparisonOperator) valueToSQL(value interface{}) (string, error) {
 // leftOriginal is the original left argument (before SQL conversion) for enum validation.
 func (c *ComparisonOperator) handleIn(leftSQL string, rightValue, leftOriginal interface{}) (string, error) {
 	// Extract field name from left side for enum validation
-	leftFieldName := c.extractFieldNameFromValue(leftOriginal)
+	leftFieldName := varFieldName(leftOriginal)
 
 	// Check if right side is a variable expression
 	if varExpr, ok := rightValue.(map[string]interface{}); ok {
@@ -404,7 +557,7 @@ func (c *ComparisonOperator) handleIn(leftSQL string, rightValue, leftOriginal i
 			if c.schema() != nil && fieldName != "" {
 				if c.schema().IsArrayType(fieldName) {
 					// Array type: use array membership syntax
-					return fmt.Sprintf("%s IN %s", leftSQL, rightSQL), nil
+					return c.config.Spec().ArrayContains(rightSQL, leftSQL)
 				} else if c.schema().IsStringType(fieldName) {
 					// String type: use string containment syntax
 					return fmt.Sprintf("%s > 0", c.strposFunc(rightSQL, leftSQL)), nil
@@ -412,14 +565,15 @@ func (c *ComparisonOperator) handleIn(leftSQL string, rightValue, leftOriginal i
 			}
 
 			// No schema or unknown type: use heuristic based on left side
-			// If left side is a literal (quoted), assume string containment
-			isLeftLiteral := strings.HasPrefix(leftSQL, "'") && strings.HasSuffix(leftSQL, "'")
+			// If left side is a string literal (quoted or bound), assume string containment
+			_, isLeftString := leftOriginal.(string)
+			isLeftLiteral := isLeftString || (strings.HasPrefix(leftSQL, "'") && strings.HasSuffix(leftSQL, "'"))
 			if isLeftLiteral {
 				// Use STRPOS/position for string containment
 				return fmt.Sprintf("%s > 0", c.strposFunc(rightSQL, leftSQL)), nil
 			}
 			// Otherwise, assume array membership
-			return fmt.Sprintf("%s IN %s", leftSQL, rightSQL), nil
+			return c.config.Spec().ArrayContains(rightSQL, leftSQL)
 		}
 	}
 
@@ -438,9 +592,23 @@ func (c *ComparisonOperator) handleIn(leftSQL string, rightValue, leftOriginal i
 			}
 		}
 
-		// Convert array elements to SQL values
+		// Convert array elements to SQL values. Oracle stores the empty string
+		// as NULL, so it is matched with IS NULL rather than listed.
 		var values []string
+		var emptyString bool
 		for _, item := range arr {
+			if c.isOracleEmptyString(item) {
+				emptyString = true
+				continue
+			}
+			item, err := c.temporalValue(item, leftFieldName)
+			if err != nil {
+				return "", err
+			}
+			if pv, ok := item.(ProcessedValue); ok && pv.IsSQL {
+				values = append(values, pv.Value)
+				continue
+			}
 			valueSQL, err := c.dataOp.valueToSQL(item)
 			if err != nil {
 				return "", fmt.Errorf("invalid array element: %w", err)
@@ -448,6 +616,12 @@ func (c *ComparisonOperator) handleIn(leftSQL string, rightValue, leftOriginal i
 			values = append(values, valueSQL)
 		}
 
+		switch {
+		case emptyString && len(values) == 0:
+			return fmt.Sprintf("%s IS NULL", leftSQL), nil
+		case emptyString:
+			return fmt.Sprintf("(%s IN (%s) OR %s IS NULL)", leftSQL, strings.Join(values, ", "), leftSQL), nil
+		}
 		return fmt.Sprintf("%s IN (%s)", leftSQL, strings.Join(values, ", ")), nil
 	}
 
@@ -477,6 +651,7 @@ func (c *ComparisonOperator) handleIn(leftSQL string, rightValue, leftOriginal i
 // handleChainedComparison handles chained comparisons like {"<": [10, {"var": "x"}, 20, 30]}
 // For 2 args: generates "a < b"
 // For 3+ args: generates "(a < b AND b < c AND c < d)".
+// With Between set, {"<=": [a, b, c]} generates "b BETWEEN a AND c".
 func (c *ComparisonOperator) handleChainedComparison(operator string, args []interface{}) (string, error) {
 	if len(args) < 2 {
 		return "", fmt.Errorf("chained comparison requires at least 2 arguments")
@@ -488,6 +663,12 @@ func (c *ComparisonOperator) handleChainedComparison(operator string, args []int
 			return "", err
 		}
 	}
+	if err := c.validateTemporalOrdering(operator, args); err != nil {
+		return "", err
+	}
+	if err := c.validateStringOrdering(operator, args); err != nil {
+		return "", err
+	}
 
 	// Apply type coercion: find field names and coerce adjacent literals
 	coercedArgs := make([]interface{}, len(args))
@@ -496,7 +677,7 @@ func (c *ComparisonOperator) handleChainedComparison(operator string, args []int
 	// Find the field name from any var expression to use for coercion
 	var fieldName string
 	for _, arg := range args {
-		if name := c.extractFieldNameFromValue(arg); name != "" {
+		if name := varFieldName(arg); name != "" {
 			fieldName = name
 			break
 		}
@@ -505,8 +686,22 @@ func (c *ComparisonOperator) handleChainedComparison(operator string, args []int
 	// Coerce all literal arguments based on the field type
 	if fieldName != "" {
 		for i, arg := range coercedArgs {
-			if c.extractFieldNameFromValue(arg) == "" {
-				coercedArgs[i] = c.coerceValueForComparison(arg, fieldName)
+			if varFieldName(arg) == "" {
+				coerced, err := c.temporalValue(c.coerceValueForComparison(arg, fieldName), fieldName)
+				if err != nil {
+					return "", err
+				}
+				coercedArgs[i] = coerced
+			}
+		}
+	}
+
+	// Otherwise, type the literals compared with a date operator
+	var dateKind string
+	if fieldName == "" {
+		for _, arg := range args {
+			if dateKind = c.dateExpressionKind(arg); dateKind != "" {
+				break
 			}
 		}
 	}
@@ -514,6 +709,10 @@ func (c *ComparisonOperator) handleChainedComparison(operator string, args []int
 	// Convert all arguments to SQL
 	var sqlArgs []string
 	for i, arg := range coercedArgs {
+		arg, err := c.dateLiteral(arg, dateKind)
+		if err != nil {
+			return "", err
+		}
 		argSQL, err := c.valueToSQL(arg)
 		if err != nil {
 			return "", fmt.Errorf("invalid argument %d: %w", i, err)
@@ -526,6 +725,10 @@ func (c *ComparisonOperator) handleChainedComparison(operator string, args []int
 		return fmt.Sprintf("%s %s %s", sqlArgs[0], operator, sqlArgs[1]), nil
 	}
 
+	if len(args) == 3 && operator == "<=" && c.config != nil && c.config.Between {
+		return fmt.Sprintf("%s BETWEEN %s AND %s", sqlArgs[1], sqlArgs[0], sqlArgs[2]), nil
+	}
+
 	// For 3+ arguments, generate chained comparisons with parentheses
 	var conditions []string
 	for i := 0; i < len(sqlArgs)-1; i++ {
@@ -558,7 +761,7 @@ func (c *ComparisonOperator) processArithmeticExpression(op string, args interfa
 		if err != nil {
 			return "", fmt.Errorf("invalid unary plus argument: %w", err)
 		}
-		return fmt.Sprintf("CAST(%s AS NUMERIC)", operand), nil
+		return c.config.CastToNumber(operand), nil
 	}
 
 	if len(argsSlice) < 2 {
@@ -586,7 +789,7 @@ func (c *ComparisonOperator) processArithmeticExpression(op string, args interfa
 	case "/":
 		return fmt.Sprintf("(%s)", strings.Join(operands, " / ")), nil
 	case "%":
-		return fmt.Sprintf("(%s)", strings.Join(operands, " % ")), nil
+		return c.config.Modulo(operands), nil
 	default:
 		return "", fmt.Errorf("unsupported arithmetic operation: %s", op)
 	}
@@ -661,9 +864,9 @@ func (c *ComparisonOperator) processMinMaxExpression(op string, args interface{}
 	// Generate SQL based on operation
 	switch op {
 	case "max":
-		return fmt.Sprintf("GREATEST(%s)", strings.Join(operands, ", ")), nil
+		return c.config.Greatest(operands), nil
 	case "min":
-		return fmt.Sprintf("LEAST(%s)", strings.Join(operands, ", ")), nil
+		return c.config.Least(operands), nil
 	default:
 		return "", fmt.Errorf("unsupported min/max operation: %s", op)
 	}
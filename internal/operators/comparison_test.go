@@ -259,6 +259,100 @@ func TestComparisonOperator_ToSQL(t *testing.T) {
 	}
 }
 
+func TestComparisonOperator_Oracle(t *testing.T) {
+	op := NewComparisonOperator(NewOperatorConfig(dialect.DialectOracle, nil))
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+	}{
+		{"equals empty string", "==", []interface{}{map[string]interface{}{"var": "name"}, ""}, "name IS NULL"},
+		{"strict equals empty string", "===", []interface{}{"", map[string]interface{}{"var": "name"}}, "name IS NULL"},
+		{"not equals empty string", "!=", []interface{}{map[string]interface{}{"var": "name"}, ""}, "name IS NOT NULL"},
+		{"strict not equals empty string", "!==", []interface{}{map[string]interface{}{"var": "name"}, ""}, "name IS NOT NULL"},
+		{"equals string", "==", []interface{}{map[string]interface{}{"var": "name"}, "a"}, "name = 'a'"},
+		{"array membership", "in", []interface{}{map[string]interface{}{"var": "tag"}, map[string]interface{}{"var": "tags"}},
+			`JSON_EXISTS(tags, '$[*]?(@ == $v)' PASSING tag AS "v")`},
+		{"string containment", "in", []interface{}{"a", map[string]interface{}{"var": "name"}}, "INSTR(name, 'a') > 0"},
+		{"list with empty string", "in", []interface{}{map[string]interface{}{"var": "name"}, []interface{}{"a", ""}}, "(name IN ('a') OR name IS NULL)"},
+		{"list of empty string", "in", []interface{}{map[string]interface{}{"var": "name"}, []interface{}{""}}, "name IS NULL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComparisonOperator_SparkSQL(t *testing.T) {
+	op := NewComparisonOperator(NewOperatorConfig(dialect.DialectSparkSQL, nil))
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+	}{
+		{"array membership", "in", []interface{}{map[string]interface{}{"var": "tag"}, map[string]interface{}{"var": "tags"}},
+			"array_contains(tags, tag)"},
+		{"literal list", "in", []interface{}{map[string]interface{}{"var": "status"}, []interface{}{"a", "b"}}, "status IN ('a', 'b')"},
+		{"string containment", "in", []interface{}{"a", map[string]interface{}{"var": "name"}}, "instr(name, 'a') > 0"},
+		{"escaped string", "==", []interface{}{map[string]interface{}{"var": "path"}, `C:\temp`}, `path = 'C:\\temp'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComparisonOperator_Between(t *testing.T) {
+	config := NewOperatorConfig(dialect.DialectPostgreSQL, nil)
+	config.Between = true
+	op := NewComparisonOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+	}{
+		{"inclusive range", "<=", []interface{}{1, map[string]interface{}{"var": "x"}, 9}, "x BETWEEN 1 AND 9"},
+		{"exclusive range is chained", "<", []interface{}{1, map[string]interface{}{"var": "x"}, 9}, "(1 < x AND x < 9)"},
+		{"longer chain", "<=", []interface{}{1, map[string]interface{}{"var": "x"}, map[string]interface{}{"var": "y"}, 9},
+			"(1 <= x AND x <= y AND y <= 9)"},
+		{"two operands", "<=", []interface{}{map[string]interface{}{"var": "x"}, 9}, "x <= 9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestComparisonOperator_valueToSQL(t *testing.T) {
 	op := NewComparisonOperator(nil)
 
@@ -375,6 +469,55 @@ func TestComparisonOperator_strposFunc(t *testing.T) {
 			needle:   "'test'",
 			expected: "position(description, 'test')",
 		},
+		{
+			name:     "MySQL dialect",
+			dialect:  dialect.DialectMySQL,
+			haystack: "description",
+			needle:   "'test'",
+			expected: "LOCATE('test', description)",
+		},
+		{
+			name:     "SQLite dialect",
+			dialect:  dialect.DialectSQLite,
+			haystack: "description",
+			needle:   "'test'",
+			expected: "instr(description, 'test')",
+		},
+		{
+			name:     "Snowflake dialect",
+			dialect:  dialect.DialectSnowflake,
+			haystack: "description",
+			needle:   "'test'",
+			expected: "POSITION('test' IN description)",
+		},
+		{
+			name:     "SQLServer dialect",
+			dialect:  dialect.DialectSQLServer,
+			haystack: "description",
+			needle:   "'test'",
+			expected: "CHARINDEX('test', description)",
+		},
+		{
+			name:     "Trino dialect",
+			dialect:  dialect.DialectTrino,
+			haystack: "description",
+			needle:   "'test'",
+			expected: "STRPOS(description, 'test')",
+		},
+		{
+			name:     "Oracle dialect",
+			dialect:  dialect.DialectOracle,
+			haystack: "description",
+			needle:   "'test'",
+			expected: "INSTR(description, 'test')",
+		},
+		{
+			name:     "SparkSQL dialect",
+			dialect:  dialect.DialectSparkSQL,
+			haystack: "description",
+			needle:   "'test'",
+			expected: "instr(description, 'test')",
+		},
 		{
 			name:     "Unspecified dialect defaults to STRPOS",
 			dialect:  dialect.DialectUnspecified,
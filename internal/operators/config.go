@@ -2,6 +2,7 @@ package operators
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/h22rana/jsonlogic2sql/internal/dialect"
 )
@@ -19,6 +20,31 @@ type OperatorConfig struct {
 	Schema           SchemaProvider
 	Dialect          dialect.Dialect
 	ExpressionParser ExpressionParser
+	// Params collects bind arguments when generating parameterized SQL.
+	// When nil, literal values are inlined into the generated SQL.
+	Params *ParamCollector
+	// QuoteIdentifiers quotes every path segment of a var name. When false,
+	// segments are only quoted if they are reserved words or fall outside the
+	// safe identifier grammar. Segments quoted only because of this option
+	// are folded with Dialect.FoldIdentifier.
+	QuoteIdentifiers bool
+	// StrictIdentifiers rejects var names whose segments fall outside the safe
+	// identifier grammar [A-Za-z_][A-Za-z0-9_]* instead of quoting them.
+	StrictIdentifiers bool
+	// Between renders the chained comparison {"<=": [lo, x, hi]} as
+	// x BETWEEN lo AND hi. The optimizer merges range pairs into that form.
+	Between bool
+	// ScopedParser returns the ExpressionParser for a copy of this config that
+	// is scoped to part of a rule, such as the elements of an array, so that
+	// nested expressions handed back to the parser render in that scope.
+	ScopedParser func(config *OperatorConfig) ExpressionParser
+
+	// inArray is set inside the bodies of array operators, where item, current
+	// and elem refer to the current element.
+	inArray bool
+	// reduceInitial is the SQL of the initial value inside reduce bodies, where
+	// accumulator refers to the running value.
+	reduceInitial string
 }
 
 // NewOperatorConfig creates a new operator config with dialect and optional schema.
@@ -34,6 +60,144 @@ func (c *OperatorConfig) HasSchema() bool {
 	return c != nil && c.Schema != nil
 }
 
+// IsParameterized returns true if literal values should be emitted as bind placeholders.
+func (c *OperatorConfig) IsParameterized() bool {
+	return c != nil && c.Params != nil
+}
+
+// LiteralToSQL converts a Go literal value to SQL.
+// This is the single place where literals are rendered: strings are quoted
+// with the dialect's escaping rules, and when parameterized every non-NULL
+// literal is bound as a parameter instead. NULL stays inline so that
+// IS NULL comparisons keep working.
+func (c *OperatorConfig) LiteralToSQL(value any) (string, error) {
+	if c.IsParameterized() {
+		switch value.(type) {
+		case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			return c.Params.Add(value), nil
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return c.GetDialect().QuoteString(v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%v", v), nil
+	case float32, float64:
+		return fmt.Sprintf("%v", v), nil
+	case bool:
+		return c.BoolLiteral(v), nil
+	case nil:
+		return "NULL", nil
+	default:
+		return "", fmt.Errorf("unsupported value type: %T", value)
+	}
+}
+
+// IdentifierToSQL converts a dotted JSON Logic var name to a SQL column reference.
+// Each dot-separated segment is validated and quoted independently with the
+// dialect's identifier quotes, so "user.order" becomes user.`order` in BigQuery.
+// Lambda variables (item, current, accumulator, elem) are never quoted.
+func (c *OperatorConfig) IdentifierToSQL(name string) (string, error) {
+	segments, err := dialect.SplitPath(name)
+	if err != nil {
+		return "", err
+	}
+	head := ""
+	if isLambdaVar(segments[0]) {
+		head, segments = segments[0], segments[1:]
+	}
+	quoted, err := c.quoteSegments(name, segments)
+	if err != nil {
+		return "", err
+	}
+	if head != "" {
+		quoted = append([]string{head}, quoted...)
+	}
+	return strings.Join(quoted, "."), nil
+}
+
+// quoteSegments quotes the path segments of the var name for the dialect.
+func (c *OperatorConfig) quoteSegments(name string, segments []string) ([]string, error) {
+	d := c.GetDialect()
+	quoted := make([]string, len(segments))
+	for i, segment := range segments {
+		safe := dialect.IsSafeIdentifier(segment)
+		if !safe && c != nil && c.StrictIdentifiers {
+			return nil, fmt.Errorf("invalid identifier %q: segment %q must match [A-Za-z_][A-Za-z0-9_]*", name, segment)
+		}
+
+		reserved := d.IsReservedKeyword(segment)
+		quote := !safe || reserved || (c != nil && c.QuoteIdentifiers)
+		if !quote {
+			quoted[i] = segment
+			continue
+		}
+
+		if safe && !reserved {
+			// Quoted only because of QuoteIdentifiers: keep naming the
+			// column the bare identifier would have named.
+			segment = d.FoldIdentifier(segment)
+		}
+		var err error
+		quoted[i], err = d.QuoteIdentifier(segment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identifier %q: %w", name, err)
+		}
+	}
+	return quoted, nil
+}
+
+// lambdaVarToSQL renders name, a reference to the current array element such
+// as item, current.price or elem, or to the reduce accumulator, with the
+// dialect's Spec. It reports false for other names and outside the bodies of
+// array operators.
+func (c *OperatorConfig) lambdaVarToSQL(name string) (string, bool, error) {
+	if c == nil || !c.inArray {
+		return "", false, nil
+	}
+	if name == AccumulatorVar && c.reduceInitial != "" {
+		return c.Spec().ArrayAccumulator(c.reduceInitial), true, nil
+	}
+	segments, err := dialect.SplitPath(name)
+	if err != nil || !isElementVar(segments[0]) {
+		return "", false, nil
+	}
+	path := segments[1:]
+	quoted, err := c.quoteSegments(name, path)
+	if err != nil {
+		return "", true, err
+	}
+	sql, err := c.Spec().ArrayElement(path, quoted)
+	return sql, true, err
+}
+
+// scoped returns a copy of c changed by change. The copy gets its own
+// expression parser, so that nested expressions see the change too.
+func (c *OperatorConfig) scoped(change func(config *OperatorConfig)) *OperatorConfig {
+	config := *c
+	change(&config)
+	if c.ScopedParser != nil {
+		config.ExpressionParser = c.ScopedParser(&config)
+	}
+	return &config
+}
+
+// isLambdaVar returns true for variable names bound by array operators.
+func isLambdaVar(name string) bool {
+	return isElementVar(name) || name == AccumulatorVar
+}
+
+// isElementVar returns true for the names of the current array element.
+func isElementVar(name string) bool {
+	switch name {
+	case ElemVar, ItemVar, CurrentVar:
+		return true
+	default:
+		return false
+	}
+}
+
 // GetDialect returns the configured dialect.
 func (c *OperatorConfig) GetDialect() dialect.Dialect {
 	if c == nil {
@@ -42,17 +206,31 @@ func (c *OperatorConfig) GetDialect() dialect.Dialect {
 	return c.Dialect
 }
 
+// Spec returns the Spec that renders the configured dialect. Unspecified and
+// unknown dialects get the standard SQL forms.
+func (c *OperatorConfig) Spec() dialect.Spec {
+	if spec := SpecFor(c.GetDialect()); spec != nil {
+		return spec
+	}
+	return standardSpec{d: c.GetDialect()}
+}
+
 // ValidateDialect checks if the configured dialect is supported.
 // Returns an error for unsupported or unspecified dialects.
 // This should be called by operators to ensure dialect compatibility.
 func (c *OperatorConfig) ValidateDialect(operator string) error {
 	d := c.GetDialect()
 	switch d {
-	case dialect.DialectBigQuery, dialect.DialectSpanner, dialect.DialectPostgreSQL, dialect.DialectDuckDB, dialect.DialectClickHouse:
+	case dialect.DialectBigQuery, dialect.DialectSpanner, dialect.DialectPostgreSQL, dialect.DialectDuckDB, dialect.DialectClickHouse,
+		dialect.DialectMySQL, dialect.DialectSQLite, dialect.DialectSnowflake, dialect.DialectSQLServer, dialect.DialectTrino,
+		dialect.DialectOracle, dialect.DialectSparkSQL:
 		return nil // Supported dialects
 	case dialect.DialectUnspecified:
 		return fmt.Errorf("operator '%s': dialect not specified", operator)
 	default:
+		if d.IsCustom() {
+			return nil
+		}
 		return fmt.Errorf("operator '%s' not supported for dialect: %s", operator, d)
 	}
 }
@@ -82,6 +260,152 @@ func (c *OperatorConfig) IsClickHouse() bool {
 	return c.GetDialect() == dialect.DialectClickHouse
 }
 
+// IsMySQL returns true if the dialect is MySQL.
+func (c *OperatorConfig) IsMySQL() bool {
+	return c.GetDialect() == dialect.DialectMySQL
+}
+
+// IsSQLite returns true if the dialect is SQLite.
+func (c *OperatorConfig) IsSQLite() bool {
+	return c.GetDialect() == dialect.DialectSQLite
+}
+
+// IsSnowflake returns true if the dialect is Snowflake.
+func (c *OperatorConfig) IsSnowflake() bool {
+	return c.GetDialect() == dialect.DialectSnowflake
+}
+
+// IsSQLServer returns true if the dialect is SQL Server.
+func (c *OperatorConfig) IsSQLServer() bool {
+	return c.GetDialect() == dialect.DialectSQLServer
+}
+
+// IsTrino returns true if the dialect is Trino.
+func (c *OperatorConfig) IsTrino() bool {
+	return c.GetDialect() == dialect.DialectTrino
+}
+
+// IsOracle returns true if the dialect is Oracle.
+func (c *OperatorConfig) IsOracle() bool {
+	return c.GetDialect() == dialect.DialectOracle
+}
+
+// IsSparkSQL returns true if the dialect is Spark SQL.
+func (c *OperatorConfig) IsSparkSQL() bool {
+	return c.GetDialect() == dialect.DialectSparkSQL
+}
+
+// HasNumericBooleans returns true if the dialect has no boolean type in
+// conditions, so booleans are stored and compared as 1 and 0. This is the case
+// for SQL Server (BIT), for Oracle before 23ai and for any dialect whose Spec
+// renders true as 1.
+func (c *OperatorConfig) HasNumericBooleans() bool {
+	return c.BoolLiteral(true) == "1"
+}
+
+// CastToNumber wraps operand in a numeric CAST, as used by unary plus.
+func (c *OperatorConfig) CastToNumber(operand string) string {
+	return c.Spec().CastToNumber(operand)
+}
+
+// BoolLiteral returns the SQL literal for a boolean value.
+func (c *OperatorConfig) BoolLiteral(v bool) string {
+	return c.Spec().BoolLiteral(v)
+}
+
+// BoolPredicate returns a constant condition that is always true or always false.
+// SQL Server and Oracle only accept predicates in conditions, so a comparison
+// is used there.
+func (c *OperatorConfig) BoolPredicate(v bool) string {
+	switch {
+	case c.HasNumericBooleans() && v:
+		return "1 = 1"
+	case c.HasNumericBooleans():
+		return "1 = 0"
+	default:
+		return c.BoolLiteral(v)
+	}
+}
+
+// Condition returns sql, the SQL rendered for expr, as a predicate. SQL Server
+// and Oracle only accept predicates in conditions, so a value there, such as a
+// var reference, a boolean literal or an if expression, is compared with 1.
+func (c *OperatorConfig) Condition(expr any, sql string) string {
+	if !c.HasNumericBooleans() || !isValueExpression(expr) {
+		return sql
+	}
+	return fmt.Sprintf("%s = 1", sql)
+}
+
+// PredicateValue returns sql, the SQL rendered for expr, as a value. SQL Server
+// and Oracle have no boolean values, so a predicate used as an operand there
+// becomes 1 or 0.
+func (c *OperatorConfig) PredicateValue(expr any, sql string) string {
+	if !c.HasNumericBooleans() || !isPredicateExpression(expr) {
+		return sql
+	}
+	return fmt.Sprintf("CASE WHEN %s THEN 1 ELSE 0 END", sql)
+}
+
+// predicateOperators are the built-in operators rendered as SQL predicates.
+var predicateOperators = map[string]bool{
+	OpAnd: true, OpOr: true, OpNot: true, OpDoubleBang: true,
+	OpEqual: true, OpStrictEqual: true, OpNotEqual: true, OpStrictNotEqual: true,
+	OpGreaterThan: true, OpGreaterThanOrEqual: true, OpLessThan: true, OpLessThanOrEqual: true, OpIn: true,
+	OpAll: true, OpSome: true, OpNone: true,
+	OpMissing: true, OpMissingSome: true, OpWithinLast: true,
+}
+
+// expressionOperator returns the operator of a single-key operator expression.
+func expressionOperator(expr any) (string, bool) {
+	obj, ok := expr.(map[string]any)
+	if !ok || len(obj) != 1 {
+		return "", false
+	}
+	for operator := range obj {
+		return operator, true
+	}
+	return "", false
+}
+
+// isPredicateExpression reports whether expr renders as a SQL predicate.
+func isPredicateExpression(expr any) bool {
+	operator, ok := expressionOperator(expr)
+	return ok && predicateOperators[operator]
+}
+
+// isValueExpression reports whether expr renders as a SQL value that must be
+// compared to be used as a condition: a boolean literal, a var reference or an
+// if expression.
+func isValueExpression(expr any) bool {
+	if _, ok := expr.(bool); ok {
+		return true
+	}
+	operator, ok := expressionOperator(expr)
+	return ok && (operator == OpVar || operator == OpIf)
+}
+
+// Truthiness returns the generic JSON Logic truthiness check for operand:
+// not NULL, not false, not zero and not the empty string.
+func (c *OperatorConfig) Truthiness(operand string) string {
+	return c.Spec().Truthiness(operand)
+}
+
+// Greatest returns the SQL for the largest of operands.
+func (c *OperatorConfig) Greatest(operands []string) string {
+	return c.Spec().Greatest(operands)
+}
+
+// Least returns the SQL for the smallest of operands.
+func (c *OperatorConfig) Least(operands []string) string {
+	return c.Spec().Least(operands)
+}
+
+// Modulo returns the SQL for the remainder of operands divided left to right.
+func (c *OperatorConfig) Modulo(operands []string) string {
+	return c.Spec().Modulo(operands)
+}
+
 // SetExpressionParser sets the callback for parsing nested expressions.
 // This should be called by the parser after all operators are created.
 func (c *OperatorConfig) SetExpressionParser(parser ExpressionParser) {
@@ -185,6 +185,48 @@ func TestOperatorConfig_ValidateDialect(t *testing.T) {
 			operator:  "test",
 			wantError: false,
 		},
+		{
+			name:      "MySQL is valid",
+			config:    &OperatorConfig{Dialect: dialect.DialectMySQL},
+			operator:  "test",
+			wantError: false,
+		},
+		{
+			name:      "SQLite is valid",
+			config:    &OperatorConfig{Dialect: dialect.DialectSQLite},
+			operator:  "test",
+			wantError: false,
+		},
+		{
+			name:      "Snowflake is valid",
+			config:    &OperatorConfig{Dialect: dialect.DialectSnowflake},
+			operator:  "test",
+			wantError: false,
+		},
+		{
+			name:      "SQLServer is valid",
+			config:    &OperatorConfig{Dialect: dialect.DialectSQLServer},
+			operator:  "test",
+			wantError: false,
+		},
+		{
+			name:      "Trino is valid",
+			config:    &OperatorConfig{Dialect: dialect.DialectTrino},
+			operator:  "test",
+			wantError: false,
+		},
+		{
+			name:      "Oracle is valid",
+			config:    &OperatorConfig{Dialect: dialect.DialectOracle},
+			operator:  "test",
+			wantError: false,
+		},
+		{
+			name:      "SparkSQL is valid",
+			config:    &OperatorConfig{Dialect: dialect.DialectSparkSQL},
+			operator:  "test",
+			wantError: false,
+		},
 		{
 			name:      "Unspecified dialect returns error",
 			config:    &OperatorConfig{Dialect: dialect.DialectUnspecified},
@@ -374,6 +416,369 @@ func TestOperatorConfig_IsClickHouse(t *testing.T) {
 	}
 }
 
+func TestOperatorConfig_IsMySQL(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   bool
+	}{
+		{
+			name:   "is MySQL",
+			config: &OperatorConfig{Dialect: dialect.DialectMySQL},
+			want:   true,
+		},
+		{
+			name:   "is not MySQL - PostgreSQL",
+			config: &OperatorConfig{Dialect: dialect.DialectPostgreSQL},
+			want:   false,
+		},
+		{
+			name:   "nil config",
+			config: nil,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsMySQL(); got != tt.want {
+				t.Errorf("IsMySQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_IsSQLite(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   bool
+	}{
+		{"is SQLite", &OperatorConfig{Dialect: dialect.DialectSQLite}, true},
+		{"is not SQLite - MySQL", &OperatorConfig{Dialect: dialect.DialectMySQL}, false},
+		{"nil config", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsSQLite(); got != tt.want {
+				t.Errorf("IsSQLite() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_IsSnowflake(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   bool
+	}{
+		{"is Snowflake", &OperatorConfig{Dialect: dialect.DialectSnowflake}, true},
+		{"is not Snowflake - SQLite", &OperatorConfig{Dialect: dialect.DialectSQLite}, false},
+		{"nil config", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsSnowflake(); got != tt.want {
+				t.Errorf("IsSnowflake() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_IsSQLServer(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   bool
+	}{
+		{"is SQLServer", &OperatorConfig{Dialect: dialect.DialectSQLServer}, true},
+		{"is not SQLServer - Snowflake", &OperatorConfig{Dialect: dialect.DialectSnowflake}, false},
+		{"nil config", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsSQLServer(); got != tt.want {
+				t.Errorf("IsSQLServer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_IsTrino(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   bool
+	}{
+		{"is Trino", &OperatorConfig{Dialect: dialect.DialectTrino}, true},
+		{"is not Trino - PostgreSQL", &OperatorConfig{Dialect: dialect.DialectPostgreSQL}, false},
+		{"nil config", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsTrino(); got != tt.want {
+				t.Errorf("IsTrino() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_IsOracle(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   bool
+	}{
+		{"is Oracle", &OperatorConfig{Dialect: dialect.DialectOracle}, true},
+		{"is not Oracle - PostgreSQL", &OperatorConfig{Dialect: dialect.DialectPostgreSQL}, false},
+		{"nil config", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsOracle(); got != tt.want {
+				t.Errorf("IsOracle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_IsSparkSQL(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   bool
+	}{
+		{"is SparkSQL", &OperatorConfig{Dialect: dialect.DialectSparkSQL}, true},
+		{"is not SparkSQL - Trino", &OperatorConfig{Dialect: dialect.DialectTrino}, false},
+		{"nil config", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsSparkSQL(); got != tt.want {
+				t.Errorf("IsSparkSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_Booleans(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         *OperatorConfig
+		wantTrue       string
+		wantFalse      string
+		wantPredicate  string
+		wantTruthiness string
+	}{
+		{"BigQuery", &OperatorConfig{Dialect: dialect.DialectBigQuery}, "TRUE", "FALSE", "FALSE",
+			"(x IS NOT NULL AND x != FALSE AND x != 0 AND x != '')"},
+		{"SQLServer", &OperatorConfig{Dialect: dialect.DialectSQLServer}, "1", "0", "1 = 0",
+			"(x IS NOT NULL AND x != 0 AND x != '')"},
+		{"Oracle", &OperatorConfig{Dialect: dialect.DialectOracle}, "1", "0", "1 = 0",
+			"(x IS NOT NULL AND x != 0)"},
+		{"nil config", nil, "TRUE", "FALSE", "FALSE",
+			"(x IS NOT NULL AND x != FALSE AND x != 0 AND x != '')"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.BoolLiteral(true); got != tt.wantTrue {
+				t.Errorf("BoolLiteral(true) = %v, want %v", got, tt.wantTrue)
+			}
+			if got := tt.config.BoolLiteral(false); got != tt.wantFalse {
+				t.Errorf("BoolLiteral(false) = %v, want %v", got, tt.wantFalse)
+			}
+			if got := tt.config.BoolPredicate(false); got != tt.wantPredicate {
+				t.Errorf("BoolPredicate(false) = %v, want %v", got, tt.wantPredicate)
+			}
+			if got := tt.config.Truthiness("x"); got != tt.wantTruthiness {
+				t.Errorf("Truthiness() = %v, want %v", got, tt.wantTruthiness)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_GreatestLeast(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       *OperatorConfig
+		wantGreatest string
+		wantLeast    string
+	}{
+		{"BigQuery", &OperatorConfig{Dialect: dialect.DialectBigQuery}, "GREATEST(a, 1)", "LEAST(a, 1)"},
+		{"SQLite", &OperatorConfig{Dialect: dialect.DialectSQLite}, "max(a, 1)", "min(a, 1)"},
+		{"nil config", nil, "GREATEST(a, 1)", "LEAST(a, 1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operands := []string{"a", "1"}
+			if got := tt.config.Greatest(operands); got != tt.wantGreatest {
+				t.Errorf("Greatest() = %v, want %v", got, tt.wantGreatest)
+			}
+			if got := tt.config.Least(operands); got != tt.wantLeast {
+				t.Errorf("Least() = %v, want %v", got, tt.wantLeast)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_Modulo(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *OperatorConfig
+		operands []string
+		want     string
+	}{
+		{"BigQuery", &OperatorConfig{Dialect: dialect.DialectBigQuery}, []string{"a", "2"}, "(a % 2)"},
+		{"BigQuery three operands", &OperatorConfig{Dialect: dialect.DialectBigQuery}, []string{"a", "5", "2"}, "(a % 5 % 2)"},
+		{"Oracle", &OperatorConfig{Dialect: dialect.DialectOracle}, []string{"a", "2"}, "MOD(a, 2)"},
+		{"Oracle three operands", &OperatorConfig{Dialect: dialect.DialectOracle}, []string{"a", "5", "2"}, "MOD(MOD(a, 5), 2)"},
+		{"nil config", nil, []string{"a", "2"}, "(a % 2)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.Modulo(tt.operands); got != tt.want {
+				t.Errorf("Modulo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_CastToNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OperatorConfig
+		want   string
+	}{
+		{"BigQuery", &OperatorConfig{Dialect: dialect.DialectBigQuery}, "CAST(x AS NUMERIC)"},
+		{"MySQL", &OperatorConfig{Dialect: dialect.DialectMySQL}, "CAST(x AS DOUBLE)"},
+		{"Snowflake", &OperatorConfig{Dialect: dialect.DialectSnowflake}, "CAST(x AS DOUBLE)"},
+		{"SQLServer", &OperatorConfig{Dialect: dialect.DialectSQLServer}, "CAST(x AS FLOAT)"},
+		{"Trino", &OperatorConfig{Dialect: dialect.DialectTrino}, "CAST(x AS DOUBLE)"},
+		{"Oracle", &OperatorConfig{Dialect: dialect.DialectOracle}, "CAST(x AS NUMBER)"},
+		{"SparkSQL", &OperatorConfig{Dialect: dialect.DialectSparkSQL}, "CAST(x AS DOUBLE)"},
+		{"nil config", nil, "CAST(x AS NUMERIC)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.CastToNumber("x"); got != tt.want {
+				t.Errorf("CastToNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_IdentifierToSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *OperatorConfig
+		input    string
+		want     string
+		hasError bool
+	}{
+		{
+			name:   "safe name unquoted",
+			config: &OperatorConfig{Dialect: dialect.DialectBigQuery},
+			input:  "user.address.city",
+			want:   "user.address.city",
+		},
+		{
+			name:   "reserved segment quoted BigQuery",
+			config: &OperatorConfig{Dialect: dialect.DialectBigQuery},
+			input:  "user.order",
+			want:   "user.`order`",
+		},
+		{
+			name:   "unsafe segment quoted PostgreSQL",
+			config: &OperatorConfig{Dialect: dialect.DialectPostgreSQL},
+			input:  "first name",
+			want:   `"first name"`,
+		},
+		{
+			name:   "quote all segments DuckDB",
+			config: &OperatorConfig{Dialect: dialect.DialectDuckDB, QuoteIdentifiers: true},
+			input:  "user.name",
+			want:   `"user"."name"`,
+		},
+		{
+			name:   "quote all segments upper-cases safe names Oracle",
+			config: &OperatorConfig{Dialect: dialect.DialectOracle, QuoteIdentifiers: true},
+			input:  "user_profile.first name",
+			want:   `"USER_PROFILE"."first name"`,
+		},
+		{
+			name:   "quote all segments keeps reserved words Snowflake",
+			config: &OperatorConfig{Dialect: dialect.DialectSnowflake, QuoteIdentifiers: true},
+			input:  "accounts.order.total",
+			want:   `"ACCOUNTS"."order"."TOTAL"`,
+		},
+		{
+			name:   "lambda variable not quoted",
+			config: &OperatorConfig{Dialect: dialect.DialectClickHouse, QuoteIdentifiers: true},
+			input:  "item.price",
+			want:   "item.`price`",
+		},
+		{
+			name:   "hostile name quoted",
+			config: &OperatorConfig{Dialect: dialect.DialectSpanner},
+			input:  "x` OR 1=1 --",
+			want:   "`x\\` OR 1=1 --`",
+		},
+		{
+			name:     "strict rejects unsafe segment",
+			config:   &OperatorConfig{Dialect: dialect.DialectBigQuery, StrictIdentifiers: true},
+			input:    "first name",
+			hasError: true,
+		},
+		{
+			name:   "strict allows reserved words",
+			config: &OperatorConfig{Dialect: dialect.DialectPostgreSQL, StrictIdentifiers: true},
+			input:  "group",
+			want:   `"group"`,
+		},
+		{
+			name:     "empty segment",
+			config:   &OperatorConfig{Dialect: dialect.DialectBigQuery},
+			input:    "user..name",
+			hasError: true,
+		},
+		{
+			name:   "nil config",
+			config: nil,
+			input:  "order",
+			want:   `"order"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.IdentifierToSQL(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("IdentifierToSQL(%q) expected error, got %s", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("IdentifierToSQL(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("IdentifierToSQL(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
 // mockSchemaProvider implements SchemaProvider for testing.
 type mockSchemaProvider struct{}
 
@@ -416,3 +821,11 @@ func (m *mockSchemaProvider) IsEnumType(fieldName string) bool {
 func (m *mockSchemaProvider) ValidateEnumValue(fieldName, value string) error {
 	return nil
 }
+
+func (m *mockSchemaProvider) GetColumnMapping(fieldName string) (ColumnMapping, bool) {
+	return ColumnMapping{}, false
+}
+
+func (m *mockSchemaProvider) IsJSONType(fieldName string) bool {
+	return false
+}
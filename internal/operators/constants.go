@@ -107,3 +107,21 @@ const (
 	// OpSubstr is the substring operator.
 	OpSubstr = "substr"
 )
+
+// Date operator names.
+const (
+	// OpNow is the current timestamp operator.
+	OpNow = "now"
+	// OpDateAdd is the date interval addition operator.
+	OpDateAdd = "date_add"
+	// OpDateSub is the date interval subtraction operator.
+	OpDateSub = "date_sub"
+	// OpDateDiff is the date difference operator.
+	OpDateDiff = "date_diff"
+	// OpDateTrunc is the date truncation operator.
+	OpDateTrunc = "date_trunc"
+	// OpDaysAgo is the relative date operator.
+	OpDaysAgo = "days_ago"
+	// OpWithinLast is the relative date range condition operator.
+	OpWithinLast = "within_last"
+)
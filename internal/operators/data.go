@@ -37,6 +37,29 @@ func (d *DataOperator) ToSQL(operator string, args []interface{}) (string, error
 	}
 }
 
+// varFieldName returns the field name of a var expression, {"var": "name"} or
+// {"var": ["name", default]}, or an empty string for any other value. The
+// empty name is the current array element.
+func varFieldName(value interface{}) string {
+	obj, ok := value.(map[string]interface{})
+	if !ok || len(obj) != 1 {
+		return ""
+	}
+	name := obj[OpVar]
+	if arr, ok := name.([]interface{}); ok && len(arr) > 0 {
+		name = arr[0]
+	}
+	switch name := name.(type) {
+	case string:
+		if name == "" {
+			return ElemVar
+		}
+		return name
+	default:
+		return ""
+	}
+}
+
 // handleVar converts var operator to SQL.
 func (d *DataOperator) handleVar(args []interface{}) (string, error) {
 	if len(args) == 0 {
@@ -49,6 +72,9 @@ func (d *DataOperator) handleVar(args []interface{}) (string, error) {
 		// In JSON Logic, {"var": ""} means "the current data context"
 		// In array operations (map, filter, reduce), this refers to the current element
 		if varName == "" {
+			if sql, ok, err := d.config.lambdaVarToSQL(ElemVar); ok {
+				return sql, err
+			}
 			return ElemVar, nil
 		}
 
@@ -58,8 +84,7 @@ func (d *DataOperator) handleVar(args []interface{}) (string, error) {
 				return "", err
 			}
 		}
-		columnName := d.convertVarName(varName)
-		return columnName, nil
+		return d.convertVarName(varName)
 	}
 
 	// For SQL context, var operator only accepts string arguments (column names)
@@ -79,7 +104,10 @@ func (d *DataOperator) handleVar(args []interface{}) (string, error) {
 					return "", err
 				}
 			}
-			columnName := d.convertVarName(varName)
+			columnName, err := d.convertVarName(varName)
+			if err != nil {
+				return "", err
+			}
 
 			// If there's a default value, use COALESCE
 			if len(arr) > 1 {
@@ -103,6 +131,7 @@ func (d *DataOperator) handleVar(args []interface{}) (string, error) {
 }
 
 // handleMissing converts missing operator to SQL.
+// Oracle stores the empty string as NULL, so an empty string counts as missing there.
 func (d *DataOperator) handleMissing(args []interface{}) (string, error) {
 	if len(args) != 1 {
 		return "", fmt.Errorf("missing operator requires exactly 1 argument")
@@ -116,7 +145,10 @@ func (d *DataOperator) handleMissing(args []interface{}) (string, error) {
 				return "", err
 			}
 		}
-		columnName := d.convertVarName(varName)
+		columnName, err := d.convertVarName(varName)
+		if err != nil {
+			return "", err
+		}
 		return fmt.Sprintf("%s IS NULL", columnName), nil
 	}
 
@@ -138,7 +170,10 @@ func (d *DataOperator) handleMissing(args []interface{}) (string, error) {
 					return "", err
 				}
 			}
-			columnName := d.convertVarName(name)
+			columnName, err := d.convertVarName(name)
+			if err != nil {
+				return "", err
+			}
 			nullConditions = append(nullConditions, fmt.Sprintf("%s IS NULL", columnName))
 		}
 
@@ -185,7 +220,10 @@ func (d *DataOperator) handleMissingSome(args []interface{}) (string, error) {
 					return "", err
 				}
 			}
-			columnName := d.convertVarName(name)
+			columnName, err := d.convertVarName(name)
+			if err != nil {
+				return "", err
+			}
 			nullConditions = append(nullConditions, fmt.Sprintf("%s IS NULL", columnName))
 		}
 		return fmt.Sprintf("(%s)", strings.Join(nullConditions, " OR ")), nil
@@ -205,7 +243,10 @@ func (d *DataOperator) handleMissingSome(args []interface{}) (string, error) {
 				return "", err
 			}
 		}
-		columnName := d.convertVarName(name)
+		columnName, err := d.convertVarName(name)
+		if err != nil {
+			return "", err
+		}
 		caseStatements = append(caseStatements, fmt.Sprintf("CASE WHEN %s IS NULL THEN 1 ELSE 0 END", columnName))
 	}
 
@@ -214,12 +255,57 @@ func (d *DataOperator) handleMissingSome(args []interface{}) (string, error) {
 	return fmt.Sprintf("(%s) >= %d", nullCount, int(minCount)), nil
 }
 
-// convertVarName converts a JSON Logic variable name to SQL column name
-// Preserves dot notation for nested properties: "user.verified" -> "user.verified".
-func (d *DataOperator) convertVarName(varName string) string {
-	// Keep the original dot notation as-is for nested properties
-	// This allows for proper JSON column access in databases that support it
-	return varName
+// convertVarName converts a JSON Logic variable name to SQL column name.
+// Paths under a schema-declared JSON column become JSON extraction expressions;
+// otherwise the name is rendered as a column reference (see convertColumn).
+func (d *DataOperator) convertVarName(varName string) (string, error) {
+	if varName == "" {
+		return "", nil
+	}
+	if root, path, ok := d.findJSONRoot(varName); ok {
+		return d.jsonPathToSQL(varName, root, path)
+	}
+	return d.convertColumn(varName)
+}
+
+// convertColumn renders a field as a column reference.
+// Inside array bodies, the current element, its fields and the reduce
+// accumulator are rendered by the dialect Spec. Schema column mappings take precedence; otherwise dot notation is preserved
+// for nested properties ("user.verified" -> "user.verified") and segments that
+// are reserved words or unsafe are quoted for the dialect.
+func (d *DataOperator) convertColumn(varName string) (string, error) {
+	if sql, ok, err := d.config.lambdaVarToSQL(varName); ok {
+		return sql, err
+	}
+	if d.schema() != nil {
+		if mapping, ok := d.schema().GetColumnMapping(varName); ok {
+			return d.mappingToSQL(varName, mapping)
+		}
+	}
+	return d.config.IdentifierToSQL(varName)
+}
+
+// mappingToSQL renders a schema column mapping: raw SQL is emitted as-is,
+// otherwise the column path (defaulting to the var name) is quoted and
+// prefixed with the table alias.
+func (d *DataOperator) mappingToSQL(varName string, mapping ColumnMapping) (string, error) {
+	if mapping.SQL != "" {
+		return mapping.SQL, nil
+	}
+
+	column := mapping.Column
+	if column == "" {
+		column = varName
+	}
+	if mapping.Table != "" {
+		column = mapping.Table + "." + column
+	}
+
+	columnSQL, err := d.config.IdentifierToSQL(column)
+	if err != nil {
+		return "", fmt.Errorf("invalid column mapping for field '%s': %w", varName, err)
+	}
+	return columnSQL, nil
 }
 
 // getNumber extracts a number from an interface{} and returns it as float64.
@@ -265,23 +351,5 @@ func (d *DataOperator) valueToSQL(value interface{}) (string, error) {
 		return d.valueToSQL(pv.Value)
 	}
 
-	switch v := value.(type) {
-	case string:
-		// Escape single quotes in strings
-		escaped := strings.ReplaceAll(v, "'", "''")
-		return fmt.Sprintf("'%s'", escaped), nil
-	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
-		return fmt.Sprintf("%v", v), nil
-	case float32, float64:
-		return fmt.Sprintf("%v", v), nil
-	case bool:
-		if v {
-			return "TRUE", nil
-		}
-		return "FALSE", nil
-	case nil:
-		return "NULL", nil
-	default:
-		return "", fmt.Errorf("unsupported value type: %T", value)
-	}
+	return d.config.LiteralToSQL(value)
 }
@@ -242,7 +242,10 @@ func TestDataOperator_convertVarName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
-			result := op.convertVarName(tt.input)
+			result, err := op.convertVarName(tt.input)
+			if err != nil {
+				t.Fatalf("convertVarName(%s) unexpected error: %v", tt.input, err)
+			}
 			if result != tt.expected {
 				t.Errorf("convertVarName(%s) = %s, expected %s", tt.input, result, tt.expected)
 			}
@@ -290,3 +293,66 @@ func TestDataOperator_valueToSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestVarFieldName(t *testing.T) {
+	tests := []struct {
+		name     string
+		arg      interface{}
+		expected string
+	}{
+		{
+			name:     "simple var string",
+			arg:      map[string]interface{}{"var": "fieldName"},
+			expected: "fieldName",
+		},
+		{
+			name:     "var with array - field name and default",
+			arg:      map[string]interface{}{"var": []interface{}{"fieldName", "default"}},
+			expected: "fieldName",
+		},
+		{
+			name:     "nested field name",
+			arg:      map[string]interface{}{"var": "user.profile.name"},
+			expected: "user.profile.name",
+		},
+		{
+			name:     "empty var is the current element",
+			arg:      map[string]interface{}{"var": ""},
+			expected: ElemVar,
+		},
+		{
+			name:     "not a var expression",
+			arg:      map[string]interface{}{">": []interface{}{1, 2}},
+			expected: "",
+		},
+		{
+			name:     "multiple keys - not valid",
+			arg:      map[string]interface{}{"var": "field", "other": "value"},
+			expected: "",
+		},
+		{
+			name:     "primitive value",
+			arg:      42,
+			expected: "",
+		},
+		{
+			name:     "empty array for var",
+			arg:      map[string]interface{}{"var": []interface{}{}},
+			expected: "",
+		},
+		{
+			name:     "array with non-string first element",
+			arg:      map[string]interface{}{"var": []interface{}{123, "default"}},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := varFieldName(tt.arg)
+			if result != tt.expected {
+				t.Errorf("varFieldName() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,605 @@
+package operators
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// Date units accepted by the date operators.
+const (
+	UnitSecond = "second"
+	UnitMinute = "minute"
+	UnitHour   = "hour"
+	UnitDay    = "day"
+	UnitWeek   = "week"
+	UnitMonth  = "month"
+	UnitYear   = "year"
+)
+
+// dateUnits lists the date units in increasing length.
+var dateUnits = []string{UnitSecond, UnitMinute, UnitHour, UnitDay, UnitWeek, UnitMonth, UnitYear}
+
+// unitSeconds is the length in seconds of the units shorter than a day.
+var unitSeconds = map[string]int{UnitSecond: 1, UnitMinute: 60, UnitHour: 3600}
+
+// DateOperator handles the date operators now, date_add, date_sub, date_diff,
+// date_trunc, days_ago and within_last.
+//
+// Dates are rendered according to their temporal type: a date, a datetime or
+// a timestamp. The type comes from the schema for var operands, from the
+// literal for ISO-8601 strings (a date alone is a date) and defaults to
+// timestamp. It selects the function family in dialects that have one per type,
+// such as DATE_ADD, DATETIME_ADD and TIMESTAMP_ADD in BigQuery.
+type DateOperator struct {
+	config *OperatorConfig
+	dataOp *DataOperator
+}
+
+// NewDateOperator creates a new DateOperator instance with optional config.
+func NewDateOperator(config *OperatorConfig) *DateOperator {
+	return &DateOperator{
+		config: config,
+		dataOp: NewDataOperator(config),
+	}
+}
+
+// schema returns the schema from config, or nil if not configured.
+func (d *DateOperator) schema() SchemaProvider {
+	if d.config == nil {
+		return nil
+	}
+	return d.config.Schema
+}
+
+// dateValue is a rendered date expression and its temporal type.
+type dateValue struct {
+	sql  string
+	kind string // TypeDate, TypeDatetime or TypeTimestamp
+}
+
+// operand returns v as handed to the Spec.
+func (v dateValue) operand() dialect.DateOperand {
+	return dialect.DateOperand{SQL: v.sql, Kind: v.kind}
+}
+
+// interval is an amount of date units added to or subtracted from a date.
+// The amount is either an integer literal or the SQL of an expression.
+type interval struct {
+	amount int64
+	expr   string
+	unit   string
+}
+
+// ToSQL converts a date operator to SQL.
+func (d *DateOperator) ToSQL(operator string, args []interface{}) (string, error) {
+	switch operator {
+	case OpDateDiff:
+		return d.handleDateDiff(args)
+	case OpWithinLast:
+		return d.handleWithinLast(args)
+	default:
+		v, err := d.dateToSQL(operator, args)
+		return v.sql, err
+	}
+}
+
+// dateToSQL converts a date operator that returns a date to SQL.
+func (d *DateOperator) dateToSQL(operator string, args []interface{}) (dateValue, error) {
+	switch operator {
+	case OpNow:
+		if len(args) != 0 {
+			return dateValue{}, fmt.Errorf("now takes no arguments")
+		}
+		return d.now(TypeTimestamp), nil
+	case OpDateAdd, OpDateSub:
+		if len(args) != 3 {
+			return dateValue{}, fmt.Errorf("%s requires exactly 3 arguments: date, amount and unit", operator)
+		}
+		value, err := d.operand(args[0])
+		if err != nil {
+			return dateValue{}, err
+		}
+		iv, err := d.interval(args[1], args[2], value.kind)
+		if err != nil {
+			return dateValue{}, err
+		}
+		if operator == OpDateSub {
+			return d.add(value, iv, -1)
+		}
+		return d.add(value, iv, 1)
+	case OpDateTrunc:
+		if len(args) != 2 {
+			return dateValue{}, fmt.Errorf("date_trunc requires exactly 2 arguments: date and unit")
+		}
+		value, err := d.operand(args[0])
+		if err != nil {
+			return dateValue{}, err
+		}
+		unit, err := d.unit(args[1], value.kind)
+		if err != nil {
+			return dateValue{}, err
+		}
+		sql, err := d.trunc(value, unit)
+		if err != nil {
+			return dateValue{}, err
+		}
+		return dateValue{sql: sql, kind: value.kind}, nil
+	case OpDaysAgo:
+		if len(args) != 1 {
+			return dateValue{}, fmt.Errorf("days_ago requires exactly 1 argument")
+		}
+		iv, err := d.interval(args[0], UnitDay, TypeTimestamp)
+		if err != nil {
+			return dateValue{}, err
+		}
+		return d.add(d.now(TypeTimestamp), iv, -1)
+	case OpDateDiff, OpWithinLast:
+		return dateValue{}, fmt.Errorf("%s does not return a date", operator)
+	default:
+		return dateValue{}, fmt.Errorf("unsupported date operator: %s", operator)
+	}
+}
+
+// handleDateDiff converts {"date_diff": [end, start, unit]} to the number of
+// units from start to end.
+func (d *DateOperator) handleDateDiff(args []interface{}) (string, error) {
+	if len(args) != 3 {
+		return "", fmt.Errorf("date_diff requires exactly 3 arguments: end date, start date and unit")
+	}
+	end, err := d.operand(args[0])
+	if err != nil {
+		return "", err
+	}
+	start, err := d.operand(args[1])
+	if err != nil {
+		return "", err
+	}
+	kind := commonKind(end.kind, start.kind)
+	unit, err := d.unit(args[2], kind)
+	if err != nil {
+		return "", err
+	}
+	return d.diff(end, start, unit, kind)
+}
+
+// handleWithinLast converts {"within_last": [date, amount, unit]} to a
+// condition that holds when date lies between amount units ago and now. The
+// unit defaults to day. Dates are compared with the current date rather than
+// the current timestamp, so that today's date is within the last day.
+func (d *DateOperator) handleWithinLast(args []interface{}) (string, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return "", fmt.Errorf("within_last requires 2 or 3 arguments: date, amount and an optional unit")
+	}
+	value, err := d.operand(args[0])
+	if err != nil {
+		return "", err
+	}
+	var unit interface{} = UnitDay
+	if len(args) == 3 {
+		unit = args[2]
+	}
+	iv, err := d.interval(args[1], unit, value.kind)
+	if err != nil {
+		return "", err
+	}
+
+	now := d.now(value.kind)
+	since, err := d.add(now, iv, -1)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s >= %s AND %s <= %s)", value.sql, since.sql, value.sql, now.sql), nil
+}
+
+// operand converts a date argument to SQL: an ISO-8601 string, a var or an
+// expression returning a date.
+func (d *DateOperator) operand(arg interface{}) (dateValue, error) {
+	switch v := arg.(type) {
+	case string:
+		kind := TypeTimestamp
+		if _, err := time.Parse(dateLayout, v); err == nil {
+			kind = TypeDate
+		}
+		sql, err := d.config.TemporalLiteral(kind, v)
+		if err != nil {
+			return dateValue{}, err
+		}
+		return dateValue{sql: sql, kind: kind}, nil
+	case ProcessedValue:
+		if !v.IsSQL {
+			return d.operand(v.Value)
+		}
+		return dateValue{sql: v.Value, kind: TypeTimestamp}, nil
+	case map[string]interface{}:
+		if varName, ok := v[OpVar]; ok && len(v) == 1 {
+			return d.field(varName)
+		}
+		sql, err := d.expression(v)
+		if err != nil {
+			return dateValue{}, err
+		}
+		return dateValue{sql: sql, kind: d.expressionKind(v)}, nil
+	case nil:
+		return dateValue{}, fmt.Errorf("date argument cannot be null")
+	default:
+		return dateValue{}, fmt.Errorf("date argument must be an ISO-8601 string or an expression, got %T", arg)
+	}
+}
+
+// field converts a var date argument to SQL, taking its temporal type from
+// the schema. Fields of a non-temporal type other than string are rejected.
+func (d *DateOperator) field(varName interface{}) (dateValue, error) {
+	sql, err := d.dataOp.ToSQL(OpVar, []interface{}{varName})
+	if err != nil {
+		return dateValue{}, err
+	}
+	value := dateValue{sql: sql, kind: TypeTimestamp}
+
+	name, _ := varName.(string)
+	if arr, ok := varName.([]interface{}); ok && len(arr) > 0 {
+		name, _ = arr[0].(string)
+	}
+	if d.schema() == nil || name == "" {
+		return value, nil
+	}
+
+	switch fieldType := d.schema().GetFieldType(name); fieldType {
+	case TypeDate, TypeDatetime, TypeTimestamp:
+		value.kind = fieldType
+	case "", "string", "enum", "json":
+		// Undeclared, or text that may hold ISO-8601 dates
+	default:
+		return dateValue{}, fmt.Errorf("date operation on non-date field '%s' (type: %s)", name, fieldType)
+	}
+	return value, nil
+}
+
+// expression renders a nested expression through the parser, so that custom
+// operators registered under a date operator name take precedence.
+func (d *DateOperator) expression(expr map[string]interface{}) (string, error) {
+	if d.config == nil || !d.config.HasExpressionParser() {
+		return "", fmt.Errorf("unsupported expression in date operator")
+	}
+	return d.config.ParseExpression(expr, "$")
+}
+
+// expressionKind returns the temporal type of a nested date operator, and
+// timestamp for any other expression. Unlike operand it renders nothing, so
+// that no parameter is bound twice.
+func (d *DateOperator) expressionKind(expr map[string]interface{}) string {
+	for operator, args := range expr {
+		arr, ok := args.([]interface{})
+		if !ok || len(arr) == 0 {
+			break
+		}
+		switch operator {
+		case OpDateAdd, OpDateSub, OpDateTrunc:
+			return d.operandKind(arr[0])
+		}
+	}
+	return TypeTimestamp
+}
+
+// operandKind returns the temporal type of a date argument without rendering it.
+func (d *DateOperator) operandKind(arg interface{}) string {
+	switch v := arg.(type) {
+	case string:
+		if _, err := time.Parse(dateLayout, v); err == nil {
+			return TypeDate
+		}
+	case ProcessedValue:
+		if !v.IsSQL {
+			return d.operandKind(v.Value)
+		}
+	case map[string]interface{}:
+		name, ok := v[OpVar].(string)
+		if !ok || len(v) != 1 {
+			return d.expressionKind(v)
+		}
+		if d.schema() != nil {
+			switch fieldType := d.schema().GetFieldType(name); fieldType {
+			case TypeDate, TypeDatetime:
+				return fieldType
+			}
+		}
+	}
+	return TypeTimestamp
+}
+
+// unit validates a unit argument. Dates can only be shifted, truncated and
+// compared in units of a day or longer.
+func (d *DateOperator) unit(arg interface{}, kind string) (string, error) {
+	if pv, ok := arg.(ProcessedValue); ok && !pv.IsSQL {
+		arg = pv.Value
+	}
+	unit, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf("date unit must be a string, got %T", arg)
+	}
+	for _, known := range dateUnits {
+		if unit != known {
+			continue
+		}
+		if _, subDay := unitSeconds[unit]; subDay && kind == TypeDate {
+			return "", fmt.Errorf("date unit %q is shorter than a day and cannot be used with a date", unit)
+		}
+		return unit, nil
+	}
+	return "", fmt.Errorf("unsupported date unit %q: expected one of %s", unit, strings.Join(dateUnits, ", "))
+}
+
+// interval validates an amount and unit pair for date arithmetic.
+func (d *DateOperator) interval(amount, unitArg interface{}, kind string) (interval, error) {
+	unit, err := d.unit(unitArg, kind)
+	if err != nil {
+		return interval{}, err
+	}
+	iv := interval{unit: unit}
+
+	if pv, ok := amount.(ProcessedValue); ok && !pv.IsSQL {
+		amount = pv.Value
+	}
+	switch v := amount.(type) {
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) > math.MaxInt32 {
+			return interval{}, fmt.Errorf("interval amount must be an integer, got %v", v)
+		}
+		iv.amount = int64(v)
+	case int:
+		iv.amount = int64(v)
+	case int64:
+		iv.amount = v
+	case ProcessedValue:
+		iv.expr = v.Value
+	case map[string]interface{}:
+		if varName, ok := v[OpVar]; ok && len(v) == 1 {
+			iv.expr, err = d.dataOp.ToSQL(OpVar, []interface{}{varName})
+		} else {
+			iv.expr, err = d.expression(v)
+		}
+		if err != nil {
+			return interval{}, err
+		}
+	default:
+		return interval{}, fmt.Errorf("interval amount must be an integer or an expression, got %T", amount)
+	}
+	return iv, nil
+}
+
+// amountSQL renders the amount of iv multiplied by factor, which carries the
+// sign of a subtraction and the scale of a unit conversion.
+func (d *DateOperator) amountSQL(iv interval, factor int64) (string, error) {
+	if iv.expr == "" {
+		return d.config.LiteralToSQL(iv.amount * factor)
+	}
+	switch factor {
+	case 1:
+		return iv.expr, nil
+	case -1:
+		return fmt.Sprintf("-(%s)", iv.expr), nil
+	default:
+		return fmt.Sprintf("(%s) * %d", iv.expr, factor), nil
+	}
+}
+
+// commonKind returns the temporal type that two date operands are compared in.
+func commonKind(a, b string) string {
+	switch {
+	case a == b:
+		return a
+	case a == TypeTimestamp || b == TypeTimestamp:
+		return TypeTimestamp
+	default:
+		return TypeDatetime
+	}
+}
+
+// now returns the current date, datetime or timestamp.
+func (d *DateOperator) now(kind string) dateValue {
+	return dateValue{sql: d.config.Spec().CurrentDate(kind), kind: kind}
+}
+
+// add shifts a date by an interval, forward for sign 1 and back for sign -1.
+func (d *DateOperator) add(v dateValue, iv interval, sign int64) (dateValue, error) {
+	sql, err := d.config.Spec().DateAdd(v.operand(), d.dateInterval(iv, sign))
+	if err != nil {
+		return dateValue{}, err
+	}
+	return dateValue{sql: sql, kind: v.kind}, nil
+}
+
+// dateInterval hands iv to the Spec, shifting forward for sign 1 and back for
+// sign -1.
+func (d *DateOperator) dateInterval(iv interval, sign int64) dialect.DateInterval {
+	return dialect.DateInterval{
+		Unit: iv.unit,
+		Sign: sign,
+		Amount: func(factor int64) (string, error) {
+			return d.amountSQL(iv, factor)
+		},
+		Modifier: func(format string, factor int64) (string, bool, error) {
+			if iv.expr != "" {
+				return "", false, nil
+			}
+			sql, err := d.config.LiteralToSQL(fmt.Sprintf(format, iv.amount*factor))
+			return sql, true, err
+		},
+	}
+}
+
+// diff returns the number of units from start to end, compared as kind.
+func (d *DateOperator) diff(end, start dateValue, unit, kind string) (string, error) {
+	return d.config.Spec().DateDiff(end.operand(), start.operand(), unit, kind)
+}
+
+// standardDiff computes a date difference from date subtraction and EXTRACT,
+// for PostgreSQL and registered dialects.
+func standardDiff(e, s, unit string) string {
+	switch unit {
+	case UnitDay:
+		return fmt.Sprintf("(CAST(%s AS DATE) - CAST(%s AS DATE))", e, s)
+	case UnitWeek:
+		return fmt.Sprintf("((CAST(%s AS DATE) - CAST(%s AS DATE)) / 7)", e, s)
+	case UnitMonth:
+		return fmt.Sprintf("((EXTRACT(YEAR FROM %s) - EXTRACT(YEAR FROM %s)) * 12 + EXTRACT(MONTH FROM %s) - EXTRACT(MONTH FROM %s))", e, s, e, s)
+	case UnitYear:
+		return fmt.Sprintf("(EXTRACT(YEAR FROM %s) - EXTRACT(YEAR FROM %s))", e, s)
+	default:
+		seconds := fmt.Sprintf("EXTRACT(EPOCH FROM (CAST(%s AS TIMESTAMP) - CAST(%s AS TIMESTAMP)))", e, s)
+		if n := unitSeconds[unit]; n > 1 {
+			seconds = fmt.Sprintf("%s / %d", seconds, n)
+		}
+		return fmt.Sprintf("CAST(TRUNC(%s) AS BIGINT)", seconds)
+	}
+}
+
+// sqliteDiff computes a date difference from julianday and strftime.
+func sqliteDiff(e, s, unit string) string {
+	switch unit {
+	case UnitDay:
+		return fmt.Sprintf("CAST(julianday(date(%s)) - julianday(date(%s)) AS INTEGER)", e, s)
+	case UnitWeek:
+		return fmt.Sprintf("(CAST(julianday(date(%s)) - julianday(date(%s)) AS INTEGER) / 7)", e, s)
+	case UnitMonth:
+		return fmt.Sprintf("((CAST(strftime('%%Y', %s) AS INTEGER) - CAST(strftime('%%Y', %s) AS INTEGER)) * 12 + "+
+			"CAST(strftime('%%m', %s) AS INTEGER) - CAST(strftime('%%m', %s) AS INTEGER))", e, s, e, s)
+	case UnitYear:
+		return fmt.Sprintf("(CAST(strftime('%%Y', %s) AS INTEGER) - CAST(strftime('%%Y', %s) AS INTEGER))", e, s)
+	default:
+		seconds := fmt.Sprintf("CAST(ROUND((julianday(%s) - julianday(%s)) * 86400) AS INTEGER)", e, s)
+		if n := unitSeconds[unit]; n > 1 {
+			return fmt.Sprintf("(%s / %d)", seconds, n)
+		}
+		return seconds
+	}
+}
+
+// oracleDiff computes a date difference from date subtraction, which returns
+// days in Oracle, and EXTRACT.
+func oracleDiff(e, s, unit string) string {
+	switch unit {
+	case UnitDay:
+		return fmt.Sprintf("(TRUNC(%s) - TRUNC(%s))", e, s)
+	case UnitWeek:
+		return fmt.Sprintf("TRUNC((TRUNC(%s) - TRUNC(%s)) / 7)", e, s)
+	case UnitMonth, UnitYear:
+		return standardDiff(e, s, unit)
+	default:
+		seconds := fmt.Sprintf("ROUND((CAST(%s AS DATE) - CAST(%s AS DATE)) * 86400)", e, s)
+		if n := unitSeconds[unit]; n > 1 {
+			return fmt.Sprintf("TRUNC(%s / %d)", seconds, n)
+		}
+		return seconds
+	}
+}
+
+// trunc truncates a date to the start of a unit. Weeks start on Monday.
+func (d *DateOperator) trunc(v dateValue, unit string) (string, error) {
+	return d.config.Spec().DateTrunc(v.operand(), unit)
+}
+
+// mysqlTrunc truncates a date with DATE_FORMAT, since MySQL has no DATE_TRUNC.
+func mysqlTrunc(x, unit string) string {
+	switch unit {
+	case UnitSecond:
+		return fmt.Sprintf("CAST(DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:%%i:%%s') AS DATETIME)", x)
+	case UnitMinute:
+		return fmt.Sprintf("CAST(DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:%%i:00') AS DATETIME)", x)
+	case UnitHour:
+		return fmt.Sprintf("CAST(DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:00:00') AS DATETIME)", x)
+	case UnitWeek:
+		return fmt.Sprintf("DATE_SUB(DATE(%s), INTERVAL WEEKDAY(%s) DAY)", x, x)
+	case UnitMonth:
+		return fmt.Sprintf("CAST(DATE_FORMAT(%s, '%%Y-%%m-01') AS DATE)", x)
+	case UnitYear:
+		return fmt.Sprintf("CAST(DATE_FORMAT(%s, '%%Y-01-01') AS DATE)", x)
+	default:
+		return fmt.Sprintf("DATE(%s)", x)
+	}
+}
+
+// sqliteTrunc truncates a date with strftime and date modifiers.
+func sqliteTrunc(x, unit string) string {
+	switch unit {
+	case UnitSecond:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d %%H:%%M:%%S', %s)", x)
+	case UnitMinute:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d %%H:%%M:00', %s)", x)
+	case UnitHour:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d %%H:00:00', %s)", x)
+	case UnitWeek:
+		// The Monday on or before the date.
+		return fmt.Sprintf("date(%s, '-6 days', 'weekday 1')", x)
+	case UnitMonth:
+		return fmt.Sprintf("date(%s, 'start of month')", x)
+	case UnitYear:
+		return fmt.Sprintf("date(%s, 'start of year')", x)
+	default:
+		return fmt.Sprintf("date(%s)", x)
+	}
+}
+
+// oracleTrunc truncates a date with TRUNC and its format models.
+func oracleTrunc(x, unit string) string {
+	switch unit {
+	case UnitSecond:
+		return fmt.Sprintf("CAST(%s AS DATE)", x)
+	case UnitMinute:
+		return fmt.Sprintf("TRUNC(%s, 'MI')", x)
+	case UnitHour:
+		return fmt.Sprintf("TRUNC(%s, 'HH24')", x)
+	case UnitWeek:
+		return fmt.Sprintf("TRUNC(%s, 'IW')", x)
+	case UnitMonth:
+		return fmt.Sprintf("TRUNC(%s, 'MM')", x)
+	case UnitYear:
+		return fmt.Sprintf("TRUNC(%s, 'YYYY')", x)
+	default:
+		return fmt.Sprintf("TRUNC(%s)", x)
+	}
+}
+
+// bigQueryType returns the BigQuery type, and function prefix, of a temporal type.
+func bigQueryType(kind string) string {
+	switch kind {
+	case TypeDate:
+		return "DATE"
+	case TypeDatetime:
+		return "DATETIME"
+	default:
+		return "TIMESTAMP"
+	}
+}
+
+// bigQueryAs converts a BigQuery date operand to another temporal type.
+func bigQueryAs(v dialect.DateOperand, kind string) string {
+	if v.Kind == kind {
+		return v.SQL
+	}
+	return fmt.Sprintf("%s(%s)", bigQueryType(kind), v.SQL)
+}
+
+// bigQueryDate converts a BigQuery date operand to a DATE.
+func bigQueryDate(v dialect.DateOperand) string {
+	return bigQueryAs(v, TypeDate)
+}
+
+// spannerDate converts a Spanner date operand to a DATE in UTC.
+func spannerDate(v dialect.DateOperand) string {
+	if v.Kind == TypeDate {
+		return v.SQL
+	}
+	return fmt.Sprintf("DATE(%s, 'UTC')", v.SQL)
+}
+
+// spannerTimestamp converts a Spanner date operand to a TIMESTAMP in UTC.
+func spannerTimestamp(v dialect.DateOperand) string {
+	if v.Kind == TypeDate {
+		return fmt.Sprintf("TIMESTAMP(%s, 'UTC')", v.SQL)
+	}
+	return v.SQL
+}
@@ -0,0 +1,377 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// intervalOperator returns the arithmetic operator that shifts a date in the
+// direction of sign.
+func intervalOperator(sign int64) string {
+	if sign < 0 {
+		return "-"
+	}
+	return "+"
+}
+
+// intervalSuffix returns the suffix of the _ADD or _SUB function that shifts
+// a date in the direction of sign.
+func intervalSuffix(sign int64) string {
+	if sign < 0 {
+		return "_SUB"
+	}
+	return "_ADD"
+}
+
+// CurrentDate uses CURRENT_DATE and CURRENT_TIMESTAMP.
+func (standardSpec) CurrentDate(kind string) string {
+	if kind == TypeDate {
+		return "CURRENT_DATE"
+	}
+	return "CURRENT_TIMESTAMP"
+}
+
+// DateAdd adds a multiple of a one-unit INTERVAL, counting weeks in days.
+func (standardSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	unit, factor := strings.ToUpper(iv.Unit), int64(1)
+	if iv.Unit == UnitWeek {
+		unit, factor = "DAY", 7
+	}
+	amount, err := iv.Amount(factor)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s * INTERVAL '1' %s)", date.SQL, intervalOperator(iv.Sign), amount, unit), nil
+}
+
+// DateDiff uses date subtraction and EXTRACT.
+func (standardSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return standardDiff(end.SQL, start.SQL, unit), nil
+}
+
+// DateTrunc uses DATE_TRUNC('unit', date).
+func (standardSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	return fmt.Sprintf("DATE_TRUNC('%s', %s)", unit, date.SQL), nil
+}
+
+// CurrentDate calls CURRENT_DATE, CURRENT_DATETIME or CURRENT_TIMESTAMP.
+func (bigQuerySpec) CurrentDate(kind string) string {
+	switch kind {
+	case TypeDate:
+		return "CURRENT_DATE()"
+	case TypeDatetime:
+		return "CURRENT_DATETIME()"
+	default:
+		return "CURRENT_TIMESTAMP()"
+	}
+}
+
+// DateAdd uses the DATE, DATETIME or TIMESTAMP _ADD and _SUB function of the
+// date's type.
+func (bigQuerySpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	amount, err := iv.Amount(1)
+	if err != nil {
+		return "", err
+	}
+	unit, suffix := strings.ToUpper(iv.Unit), intervalSuffix(iv.Sign)
+	if date.Kind == TypeTimestamp && unitSeconds[iv.Unit] == 0 && iv.Unit != UnitDay {
+		// TIMESTAMP_ADD only takes units up to a day.
+		return fmt.Sprintf("TIMESTAMP(DATETIME%s(DATETIME(%s), INTERVAL %s %s))", suffix, date.SQL, amount, unit), nil
+	}
+	return fmt.Sprintf("%s%s(%s, INTERVAL %s %s)", bigQueryType(date.Kind), suffix, date.SQL, amount, unit), nil
+}
+
+// DateDiff uses the _DIFF function of the type the dates are compared as.
+func (bigQuerySpec) DateDiff(end, start dialect.DateOperand, unit, kind string) (string, error) {
+	upper := strings.ToUpper(unit)
+	if kind == TypeTimestamp && unitSeconds[unit] == 0 && unit != UnitDay {
+		// TIMESTAMP_DIFF only takes units up to a day.
+		return fmt.Sprintf("DATE_DIFF(%s, %s, %s)", bigQueryDate(end), bigQueryDate(start), upper), nil
+	}
+	return fmt.Sprintf("%s_DIFF(%s, %s, %s)", bigQueryType(kind), bigQueryAs(end, kind), bigQueryAs(start, kind), upper), nil
+}
+
+// DateTrunc uses the _TRUNC function of the date's type, with ISO weeks.
+func (bigQuerySpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	upper := strings.ToUpper(unit)
+	if unit == UnitWeek {
+		upper = "ISOWEEK"
+	}
+	return fmt.Sprintf("%s_TRUNC(%s, %s)", bigQueryType(date.Kind), date.SQL, upper), nil
+}
+
+// CurrentDate calls CURRENT_DATE or CURRENT_TIMESTAMP.
+func (spannerSpec) CurrentDate(kind string) string {
+	return standardSpec{}.CurrentDate(kind) + "()"
+}
+
+// DateAdd uses DATE_ADD and TIMESTAMP_ADD, whose timestamps are shifted by at
+// most a day at a time.
+func (spannerSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	unit, factor, suffix := strings.ToUpper(iv.Unit), int64(1), intervalSuffix(iv.Sign)
+	if date.Kind == TypeDate {
+		amount, err := iv.Amount(factor)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("DATE%s(%s, INTERVAL %s %s)", suffix, date.SQL, amount, unit), nil
+	}
+	switch iv.Unit {
+	case UnitWeek:
+		unit, factor = "DAY", 7
+	case UnitMonth, UnitYear:
+		return "", fmt.Errorf("spanner cannot shift a timestamp by %s intervals", iv.Unit)
+	}
+	amount, err := iv.Amount(factor)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("TIMESTAMP%s(%s, INTERVAL %s %s)", suffix, date.SQL, amount, unit), nil
+}
+
+// DateDiff uses DATE_DIFF for dates and units of a day or longer, and
+// TIMESTAMP_DIFF otherwise, converting in UTC.
+func (spannerSpec) DateDiff(end, start dialect.DateOperand, unit, kind string) (string, error) {
+	upper := strings.ToUpper(unit)
+	if kind == TypeDate {
+		return fmt.Sprintf("DATE_DIFF(%s, %s, %s)", end.SQL, start.SQL, upper), nil
+	}
+	if unitSeconds[unit] == 0 && unit != UnitDay {
+		return fmt.Sprintf("DATE_DIFF(%s, %s, %s)", spannerDate(end), spannerDate(start), upper), nil
+	}
+	return fmt.Sprintf("TIMESTAMP_DIFF(%s, %s, %s)", spannerTimestamp(end), spannerTimestamp(start), upper), nil
+}
+
+// DateTrunc uses DATE_TRUNC and TIMESTAMP_TRUNC in UTC, with ISO weeks.
+func (spannerSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	upper := strings.ToUpper(unit)
+	if unit == UnitWeek {
+		upper = "ISOWEEK"
+	}
+	if date.Kind == TypeDate {
+		return fmt.Sprintf("DATE_TRUNC(%s, %s)", date.SQL, upper), nil
+	}
+	return fmt.Sprintf("TIMESTAMP_TRUNC(%s, %s, 'UTC')", date.SQL, upper), nil
+}
+
+// DateDiff uses date_diff('unit', start, end).
+func (duckDBSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("date_diff('%s', %s, %s)", unit, start.SQL, end.SQL), nil
+}
+
+// CurrentDate uses today() and now().
+func (clickHouseSpec) CurrentDate(kind string) string {
+	if kind == TypeDate {
+		return "today()"
+	}
+	return "now()"
+}
+
+// DateAdd adds a toIntervalUnit interval.
+func (clickHouseSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	amount, err := iv.Amount(1)
+	if err != nil {
+		return "", err
+	}
+	unit := strings.ToUpper(iv.Unit[:1]) + iv.Unit[1:]
+	return fmt.Sprintf("(%s %s toInterval%s(%s))", date.SQL, intervalOperator(iv.Sign), unit, amount), nil
+}
+
+// DateDiff uses dateDiff('unit', start, end).
+func (clickHouseSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("dateDiff('%s', %s, %s)", unit, start.SQL, end.SQL), nil
+}
+
+// DateTrunc uses dateTrunc('unit', date).
+func (clickHouseSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	return fmt.Sprintf("dateTrunc('%s', %s)", unit, date.SQL), nil
+}
+
+// DateAdd uses DATE_ADD and DATE_SUB.
+func (mySQLSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	amount, err := iv.Amount(1)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("DATE%s(%s, INTERVAL %s %s)", intervalSuffix(iv.Sign), date.SQL, amount, strings.ToUpper(iv.Unit)), nil
+}
+
+// DateDiff uses TIMESTAMPDIFF(UNIT, start, end).
+func (mySQLSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("TIMESTAMPDIFF(%s, %s, %s)", strings.ToUpper(unit), start.SQL, end.SQL), nil
+}
+
+// DateTrunc formats the date down to the unit, since MySQL has no DATE_TRUNC.
+func (mySQLSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	return mysqlTrunc(date.SQL, unit), nil
+}
+
+// DateAdd applies a date modifier such as '+30 days' with date or datetime.
+func (sqliteSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	unit, factor := iv.Unit, iv.Sign
+	if unit == UnitWeek {
+		unit, factor = UnitDay, 7*iv.Sign
+	}
+	fn := "datetime"
+	if date.Kind == TypeDate {
+		fn = "date"
+	}
+
+	modifier, ok, err := iv.Modifier("%+d "+unit+"s", factor)
+	if err == nil && !ok {
+		var amount string
+		amount, err = iv.Amount(factor)
+		modifier = fmt.Sprintf("printf('%%+d %ss', %s)", unit, amount)
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s(%s, %s)", fn, date.SQL, modifier), nil
+}
+
+// DateDiff uses julianday and strftime.
+func (sqliteSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return sqliteDiff(end.SQL, start.SQL, unit), nil
+}
+
+// DateTrunc uses strftime and date modifiers.
+func (sqliteSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	return sqliteTrunc(date.SQL, unit), nil
+}
+
+// CurrentDate calls CURRENT_DATE or CURRENT_TIMESTAMP.
+func (snowflakeSpec) CurrentDate(kind string) string {
+	return standardSpec{}.CurrentDate(kind) + "()"
+}
+
+// DateAdd uses DATEADD(unit, amount, date).
+func (snowflakeSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	return dateAddCall(date, iv)
+}
+
+// DateDiff uses DATEDIFF(unit, start, end).
+func (snowflakeSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("DATEDIFF(%s, %s, %s)", unit, start.SQL, end.SQL), nil
+}
+
+// CurrentDate casts GETDATE() to DATE for dates.
+func (sqlServerSpec) CurrentDate(kind string) string {
+	if kind == TypeDate {
+		return "CAST(GETDATE() AS DATE)"
+	}
+	return "CURRENT_TIMESTAMP"
+}
+
+// DateAdd uses DATEADD(unit, amount, date).
+func (sqlServerSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	return dateAddCall(date, iv)
+}
+
+// DateDiff uses DATEDIFF(unit, start, end).
+func (sqlServerSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("DATEDIFF(%s, %s, %s)", unit, start.SQL, end.SQL), nil
+}
+
+// DateTrunc uses DATETRUNC (SQL Server 2022+), with ISO weeks.
+func (sqlServerSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	if unit == UnitWeek {
+		unit = "iso_week"
+	}
+	return fmt.Sprintf("DATETRUNC(%s, %s)", unit, date.SQL), nil
+}
+
+// dateAddCall shifts a date with DATEADD(unit, amount, date), as in Snowflake
+// and SQL Server.
+func dateAddCall(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	amount, err := iv.Amount(iv.Sign)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("DATEADD(%s, %s, %s)", iv.Unit, amount, date.SQL), nil
+}
+
+// DateAdd uses date_add('unit', amount, date).
+func (trinoSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	amount, err := iv.Amount(iv.Sign)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("date_add('%s', %s, %s)", iv.Unit, amount, date.SQL), nil
+}
+
+// DateDiff uses date_diff('unit', start, end).
+func (trinoSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("date_diff('%s', %s, %s)", unit, start.SQL, end.SQL), nil
+}
+
+// DateTrunc uses date_trunc('unit', date).
+func (trinoSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	return fmt.Sprintf("date_trunc('%s', %s)", unit, date.SQL), nil
+}
+
+// CurrentDate truncates CURRENT_DATE for dates, since it has a time of day
+// in Oracle.
+func (oracleSpec) CurrentDate(kind string) string {
+	if kind == TypeDate {
+		return "TRUNC(CURRENT_DATE)"
+	}
+	return "CURRENT_TIMESTAMP"
+}
+
+// DateAdd uses ADD_MONTHS for months and years, and adds a NUMTODSINTERVAL
+// otherwise.
+func (oracleSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	switch iv.Unit {
+	case UnitMonth, UnitYear:
+		factor := iv.Sign
+		if iv.Unit == UnitYear {
+			factor *= 12
+		}
+		amount, err := iv.Amount(factor)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("ADD_MONTHS(%s, %s)", date.SQL, amount), nil
+	}
+
+	unit, factor := strings.ToUpper(iv.Unit), int64(1)
+	if iv.Unit == UnitWeek {
+		unit, factor = "DAY", 7
+	}
+	amount, err := iv.Amount(factor)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s NUMTODSINTERVAL(%s, '%s'))", date.SQL, intervalOperator(iv.Sign), amount, unit), nil
+}
+
+// DateDiff uses date subtraction, which returns days in Oracle, and EXTRACT.
+func (oracleSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return oracleDiff(end.SQL, start.SQL, unit), nil
+}
+
+// DateTrunc uses TRUNC and its format models.
+func (oracleSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	return oracleTrunc(date.SQL, unit), nil
+}
+
+// DateAdd uses timestampadd(UNIT, amount, date).
+func (sparkSQLSpec) DateAdd(date dialect.DateOperand, iv dialect.DateInterval) (string, error) {
+	amount, err := iv.Amount(iv.Sign)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("timestampadd(%s, %s, %s)", strings.ToUpper(iv.Unit), amount, date.SQL), nil
+}
+
+// DateDiff uses timestampdiff(UNIT, start, end).
+func (sparkSQLSpec) DateDiff(end, start dialect.DateOperand, unit, _ string) (string, error) {
+	return fmt.Sprintf("timestampdiff(%s, %s, %s)", strings.ToUpper(unit), start.SQL, end.SQL), nil
+}
+
+// DateTrunc uses date_trunc('UNIT', date).
+func (sparkSQLSpec) DateTrunc(date dialect.DateOperand, unit string) (string, error) {
+	return fmt.Sprintf("date_trunc('%s', %s)", strings.ToUpper(unit), date.SQL), nil
+}
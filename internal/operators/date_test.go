@@ -0,0 +1,217 @@
+package operators
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+func TestDateOperator_Dialects(t *testing.T) {
+	created := map[string]interface{}{"var": "created_at"}
+
+	tests := []struct {
+		dialect  dialect.Dialect
+		operator string
+		args     []interface{}
+		expected string
+	}{
+		{dialect.DialectBigQuery, OpNow, nil, "CURRENT_TIMESTAMP()"},
+		{dialect.DialectClickHouse, OpNow, nil, "now()"},
+		{dialect.DialectPostgreSQL, OpNow, nil, "CURRENT_TIMESTAMP"},
+
+		{dialect.DialectBigQuery, OpDateAdd, []interface{}{created, 3, "day"}, "TIMESTAMP_ADD(created_at, INTERVAL 3 DAY)"},
+		{dialect.DialectBigQuery, OpDateAdd, []interface{}{created, 1, "month"}, "TIMESTAMP(DATETIME_ADD(DATETIME(created_at), INTERVAL 1 MONTH))"},
+		{dialect.DialectSpanner, OpDateAdd, []interface{}{created, 2, "week"}, "TIMESTAMP_ADD(created_at, INTERVAL 14 DAY)"},
+		{dialect.DialectPostgreSQL, OpDateAdd, []interface{}{created, 3, "hour"}, "(created_at + 3 * INTERVAL '1' HOUR)"},
+		{dialect.DialectDuckDB, OpDateSub, []interface{}{created, 1, "week"}, "(created_at - 7 * INTERVAL '1' DAY)"},
+		{dialect.DialectMySQL, OpDateSub, []interface{}{created, 3, "month"}, "DATE_SUB(created_at, INTERVAL 3 MONTH)"},
+		{dialect.DialectSQLServer, OpDateSub, []interface{}{created, 3, "day"}, "DATEADD(day, -3, created_at)"},
+		{dialect.DialectSnowflake, OpDateAdd, []interface{}{created, 3, "day"}, "DATEADD(day, 3, created_at)"},
+		{dialect.DialectClickHouse, OpDateSub, []interface{}{created, 3, "day"}, "(created_at - toIntervalDay(3))"},
+		{dialect.DialectTrino, OpDateSub, []interface{}{created, 3, "day"}, "date_add('day', -3, created_at)"},
+		{dialect.DialectSparkSQL, OpDateAdd, []interface{}{created, 3, "minute"}, "timestampadd(MINUTE, 3, created_at)"},
+		{dialect.DialectOracle, OpDateSub, []interface{}{created, 2, "year"}, "ADD_MONTHS(created_at, -24)"},
+		{dialect.DialectOracle, OpDateAdd, []interface{}{created, 3, "day"}, "(created_at + NUMTODSINTERVAL(3, 'DAY'))"},
+		{dialect.DialectSQLite, OpDateSub, []interface{}{created, 1, "week"}, "datetime(created_at, '-7 days')"},
+		{dialect.DialectSQLite, OpDateAdd, []interface{}{"2024-01-31", 1, "month"}, "date('2024-01-31', '+1 months')"},
+
+		{dialect.DialectBigQuery, OpDateDiff, []interface{}{"2024-03-01", created, "day"}, "TIMESTAMP_DIFF(TIMESTAMP(DATE '2024-03-01'), created_at, DAY)"},
+		{dialect.DialectSpanner, OpDateDiff, []interface{}{created, "2024-01-01", "month"}, "DATE_DIFF(DATE(created_at, 'UTC'), DATE '2024-01-01', MONTH)"},
+		{dialect.DialectPostgreSQL, OpDateDiff, []interface{}{created, "2024-01-01", "day"}, "(CAST(created_at AS DATE) - CAST(DATE '2024-01-01' AS DATE))"},
+		{dialect.DialectPostgreSQL, OpDateDiff, []interface{}{created, "2024-01-01", "hour"}, "CAST(TRUNC(EXTRACT(EPOCH FROM (CAST(created_at AS TIMESTAMP) - CAST(DATE '2024-01-01' AS TIMESTAMP))) / 3600) AS BIGINT)"},
+		{dialect.DialectDuckDB, OpDateDiff, []interface{}{created, "2024-01-01", "day"}, "date_diff('day', DATE '2024-01-01', created_at)"},
+		{dialect.DialectMySQL, OpDateDiff, []interface{}{created, "2024-01-01", "week"}, "TIMESTAMPDIFF(WEEK, DATE '2024-01-01', created_at)"},
+		{dialect.DialectSQLServer, OpDateDiff, []interface{}{created, "2024-01-01", "day"}, "DATEDIFF(day, CAST('2024-01-01' AS DATE), created_at)"},
+		{dialect.DialectClickHouse, OpDateDiff, []interface{}{created, "2024-01-01", "day"}, "dateDiff('day', toDate('2024-01-01'), created_at)"},
+		{dialect.DialectSparkSQL, OpDateDiff, []interface{}{created, "2024-01-01", "day"}, "timestampdiff(DAY, DATE '2024-01-01', created_at)"},
+		{dialect.DialectOracle, OpDateDiff, []interface{}{created, "2024-01-01", "day"}, "(TRUNC(created_at) - TRUNC(DATE '2024-01-01'))"},
+		{dialect.DialectSQLite, OpDateDiff, []interface{}{created, "2024-01-01", "day"}, "CAST(julianday(date(created_at)) - julianday(date('2024-01-01')) AS INTEGER)"},
+
+		{dialect.DialectBigQuery, OpDateTrunc, []interface{}{created, "week"}, "TIMESTAMP_TRUNC(created_at, ISOWEEK)"},
+		{dialect.DialectSpanner, OpDateTrunc, []interface{}{created, "day"}, "TIMESTAMP_TRUNC(created_at, DAY, 'UTC')"},
+		{dialect.DialectPostgreSQL, OpDateTrunc, []interface{}{created, "month"}, "DATE_TRUNC('month', created_at)"},
+		{dialect.DialectTrino, OpDateTrunc, []interface{}{created, "month"}, "date_trunc('month', created_at)"},
+		{dialect.DialectSparkSQL, OpDateTrunc, []interface{}{created, "month"}, "date_trunc('MONTH', created_at)"},
+		{dialect.DialectClickHouse, OpDateTrunc, []interface{}{created, "month"}, "dateTrunc('month', created_at)"},
+		{dialect.DialectSQLServer, OpDateTrunc, []interface{}{created, "week"}, "DATETRUNC(iso_week, created_at)"},
+		{dialect.DialectMySQL, OpDateTrunc, []interface{}{created, "month"}, "CAST(DATE_FORMAT(created_at, '%Y-%m-01') AS DATE)"},
+		{dialect.DialectOracle, OpDateTrunc, []interface{}{created, "week"}, "TRUNC(created_at, 'IW')"},
+		{dialect.DialectSQLite, OpDateTrunc, []interface{}{created, "week"}, "date(created_at, '-6 days', 'weekday 1')"},
+
+		{dialect.DialectBigQuery, OpDaysAgo, []interface{}{30}, "TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL 30 DAY)"},
+		{dialect.DialectPostgreSQL, OpDaysAgo, []interface{}{float64(30)}, "(CURRENT_TIMESTAMP - 30 * INTERVAL '1' DAY)"},
+		{dialect.DialectSQLite, OpDaysAgo, []interface{}{30}, "datetime(CURRENT_TIMESTAMP, '-30 days')"},
+
+		{dialect.DialectPostgreSQL, OpWithinLast, []interface{}{created, 30}, "(created_at >= (CURRENT_TIMESTAMP - 30 * INTERVAL '1' DAY) AND created_at <= CURRENT_TIMESTAMP)"},
+		{dialect.DialectSnowflake, OpWithinLast, []interface{}{created, 2, "hour"}, "(created_at >= DATEADD(hour, -2, CURRENT_TIMESTAMP()) AND created_at <= CURRENT_TIMESTAMP())"},
+		{dialect.DialectClickHouse, OpWithinLast, []interface{}{created, 1, "week"}, "(created_at >= (now() - toIntervalWeek(1)) AND created_at <= now())"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String()+" "+tt.operator, func(t *testing.T) {
+			op := NewDateOperator(NewOperatorConfig(tt.dialect, nil))
+			got, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToSQL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDateOperator_Schema(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"signup_date": "date",
+			"updated_at":  "datetime",
+			"created_at":  "timestamp",
+			"opens_at":    "time",
+			"age":         "integer",
+			"code":        "string",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		operator string
+		args     []interface{}
+		expected string
+		errMsg   string
+	}{
+		{"BigQuery date", dialect.DialectBigQuery, OpDateAdd, []interface{}{map[string]interface{}{"var": "signup_date"}, 1, "month"}, "DATE_ADD(signup_date, INTERVAL 1 MONTH)", ""},
+		{"BigQuery datetime", dialect.DialectBigQuery, OpDateTrunc, []interface{}{map[string]interface{}{"var": "updated_at"}, "day"}, "DATETIME_TRUNC(updated_at, DAY)", ""},
+		{
+			"BigQuery date and timestamp", dialect.DialectBigQuery, OpDateDiff,
+			[]interface{}{map[string]interface{}{"var": "created_at"}, map[string]interface{}{"var": "signup_date"}, "hour"},
+			"TIMESTAMP_DIFF(created_at, TIMESTAMP(signup_date), HOUR)", "",
+		},
+		{"Spanner date", dialect.DialectSpanner, OpDateTrunc, []interface{}{map[string]interface{}{"var": "signup_date"}, "week"}, "DATE_TRUNC(signup_date, ISOWEEK)", ""},
+		{"SQLite date", dialect.DialectSQLite, OpDateSub, []interface{}{map[string]interface{}{"var": "signup_date"}, 3, "day"}, "date(signup_date, '-3 days')", ""},
+		{
+			"date within last days", dialect.DialectBigQuery, OpWithinLast,
+			[]interface{}{map[string]interface{}{"var": "signup_date"}, 30},
+			"(signup_date >= DATE_SUB(CURRENT_DATE(), INTERVAL 30 DAY) AND signup_date <= CURRENT_DATE())", "",
+		},
+		{
+			"Oracle date within last days", dialect.DialectOracle, OpWithinLast,
+			[]interface{}{map[string]interface{}{"var": "signup_date"}, 7},
+			"(signup_date >= (TRUNC(CURRENT_DATE) - NUMTODSINTERVAL(7, 'DAY')) AND signup_date <= TRUNC(CURRENT_DATE))", "",
+		},
+		{"string field", dialect.DialectPostgreSQL, OpDateTrunc, []interface{}{map[string]interface{}{"var": "code"}, "day"}, "DATE_TRUNC('day', code)", ""},
+		{"hours on a date", dialect.DialectPostgreSQL, OpDateAdd, []interface{}{map[string]interface{}{"var": "signup_date"}, 3, "hour"}, "", "shorter than a day"},
+		{"time field", dialect.DialectPostgreSQL, OpDateTrunc, []interface{}{map[string]interface{}{"var": "opens_at"}, "day"}, "", "non-date field 'opens_at' (type: time)"},
+		{"integer field", dialect.DialectPostgreSQL, OpWithinLast, []interface{}{map[string]interface{}{"var": "age"}, 3}, "", "non-date field 'age' (type: integer)"},
+		{"Spanner timestamp months", dialect.DialectSpanner, OpDateAdd, []interface{}{map[string]interface{}{"var": "created_at"}, 1, "month"}, "", "cannot shift a timestamp by month intervals"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := NewDateOperator(NewOperatorConfig(tt.dialect, schema))
+			got, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("ToSQL() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToSQL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDateOperator_Errors(t *testing.T) {
+	op := NewDateOperator(NewOperatorConfig(dialect.DialectPostgreSQL, nil))
+	created := map[string]interface{}{"var": "created_at"}
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		errMsg   string
+	}{
+		{"now with arguments", OpNow, []interface{}{1}, "now takes no arguments"},
+		{"date_add without unit", OpDateAdd, []interface{}{created, 1}, "requires exactly 3 arguments"},
+		{"unknown unit", OpDateAdd, []interface{}{created, 1, "fortnight"}, `unsupported date unit "fortnight"`},
+		{"non-string unit", OpDateTrunc, []interface{}{created, 1}, "date unit must be a string"},
+		{"fractional amount", OpDateAdd, []interface{}{created, 1.5, "day"}, "interval amount must be an integer"},
+		{"string amount", OpDaysAgo, []interface{}{"30"}, "interval amount must be an integer or an expression"},
+		{"null date", OpDateTrunc, []interface{}{nil, "day"}, "date argument cannot be null"},
+		{"numeric date", OpDateDiff, []interface{}{20240101, created, "day"}, "date argument must be an ISO-8601 string"},
+		{"invalid date", OpDateTrunc, []interface{}{"yesterday", "day"}, `invalid timestamp "yesterday"`},
+		{"within_last arity", OpWithinLast, []interface{}{created}, "within_last requires 2 or 3 arguments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := op.ToSQL(tt.operator, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("ToSQL() error = %v, want it to contain %q", err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestDateOperator_Parameterized(t *testing.T) {
+	tests := []struct {
+		dialect  dialect.Dialect
+		operator string
+		args     []interface{}
+		expected string
+		params   []any
+	}{
+		{dialect.DialectPostgreSQL, OpDaysAgo, []interface{}{float64(30)}, "(CURRENT_TIMESTAMP - $1 * INTERVAL '1' DAY)", []any{int64(30)}},
+		{dialect.DialectSQLServer, OpDaysAgo, []interface{}{float64(30)}, "DATEADD(day, @p1, CURRENT_TIMESTAMP)", []any{int64(-30)}},
+		{dialect.DialectSQLite, OpDaysAgo, []interface{}{float64(30)}, "datetime(CURRENT_TIMESTAMP, ?1)", []any{"-30 days"}},
+		{
+			dialect.DialectClickHouse, OpDateAdd, []interface{}{"2024-01-01", float64(1), "month"},
+			"(toDate({p1:String}) + toIntervalMonth({p2:Int64}))", []any{"2024-01-01", int64(1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String()+" "+tt.operator, func(t *testing.T) {
+			config := NewOperatorConfig(tt.dialect, nil)
+			config.Params = NewParamCollector(tt.dialect)
+			got, err := NewDateOperator(config).ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToSQL() = %q, want %q", got, tt.expected)
+			}
+			if args := config.Params.Args(); !reflect.DeepEqual(args, tt.params) {
+				t.Errorf("ToSQL() args = %#v, want %#v", args, tt.params)
+			}
+		})
+	}
+}
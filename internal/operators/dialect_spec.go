@@ -0,0 +1,1180 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// builtinSpecs maps every built-in dialect to the Spec that renders it.
+var builtinSpecs = map[dialect.Dialect]dialect.Spec{
+	dialect.DialectBigQuery:   bigQuerySpec{standardSpec{d: dialect.DialectBigQuery}},
+	dialect.DialectSpanner:    spannerSpec{standardSpec{d: dialect.DialectSpanner}},
+	dialect.DialectPostgreSQL: postgreSQLSpec{standardSpec{d: dialect.DialectPostgreSQL}},
+	dialect.DialectDuckDB:     duckDBSpec{standardSpec{d: dialect.DialectDuckDB}},
+	dialect.DialectClickHouse: clickHouseSpec{standardSpec{d: dialect.DialectClickHouse}},
+	dialect.DialectMySQL:      mySQLSpec{standardSpec{d: dialect.DialectMySQL}},
+	dialect.DialectSQLite:     sqliteSpec{standardSpec{d: dialect.DialectSQLite}},
+	dialect.DialectSnowflake:  snowflakeSpec{standardSpec{d: dialect.DialectSnowflake}},
+	dialect.DialectSQLServer:  sqlServerSpec{standardSpec{d: dialect.DialectSQLServer}},
+	dialect.DialectTrino:      trinoSpec{standardSpec{d: dialect.DialectTrino}},
+	dialect.DialectOracle:     oracleSpec{standardSpec{d: dialect.DialectOracle}},
+	dialect.DialectSparkSQL:   sparkSQLSpec{standardSpec{d: dialect.DialectSparkSQL}},
+}
+
+// SpecFor returns the Spec of a built-in or registered dialect, or nil for
+// unspecified and unknown dialects.
+func SpecFor(d dialect.Dialect) dialect.Spec {
+	if spec, ok := builtinSpecs[d]; ok {
+		return spec
+	}
+	if spec, ok := dialect.CustomSpec(d); ok {
+		return spec
+	}
+	return nil
+}
+
+// aggregateInto combines initial with agg, the aggregate of the elements,
+// which is NULL when there are none. A SUM is added to initial, while a MIN or
+// MAX is compared with it using the least or greatest function, since adding
+// it would count the initial value twice.
+func aggregateInto(initial, function, agg, least, greatest, coalesce string) string {
+	switch function {
+	case AggregateMIN:
+		return fmt.Sprintf("%s(%s, %s(%s, %s))", least, initial, coalesce, agg, initial)
+	case AggregateMAX:
+		return fmt.Sprintf("%s(%s, %s(%s, %s))", greatest, initial, coalesce, agg, initial)
+	default:
+		return fmt.Sprintf("%s + %s(%s, 0)", initial, coalesce, agg)
+	}
+}
+
+// elementRef returns the reference to the aggregated value of an element,
+// the element itself or its field at quotedPath.
+func elementRef(quotedPath []string) string {
+	return strings.Join(append([]string{ElemVar}, quotedPath...), ".")
+}
+
+// jsonPathLiteral returns the quoted JSON path of an element field for d.
+func jsonPathLiteral(d dialect.Dialect, field []string) (string, error) {
+	path, err := d.QuoteString(jsonPathExpression(field))
+	if err != nil {
+		return "", fmt.Errorf("invalid reduce field: %w", err)
+	}
+	return path, nil
+}
+
+// substringCall renders a substring function call, leaving out an empty length.
+func substringCall(function, str, start, length string) string {
+	if length == "" {
+		return fmt.Sprintf("%s(%s, %s)", function, str, start)
+	}
+	return fmt.Sprintf("%s(%s, %s, %s)", function, str, start, length)
+}
+
+// pipeConcat concatenates operands with the standard || operator.
+func pipeConcat(operands []string) string {
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(operands, " || "))
+}
+
+// standardSpec renders the standard SQL forms used by BigQuery, Spanner and
+// DuckDB, and by default for the other built-in dialects: arrays are unnested
+// with UNNEST subqueries, strings use CONCAT, SUBSTR and STRPOS, and JSON
+// columns are read with JSON_VALUE and JSON_QUERY.
+type standardSpec struct {
+	d dialect.Dialect
+}
+
+// Name returns the dialect name.
+func (s standardSpec) Name() string {
+	return s.d.String()
+}
+
+// QuoteIdentifier quotes name with the dialect's identifier quotes.
+func (s standardSpec) QuoteIdentifier(name string) (string, error) {
+	return s.d.QuoteIdentifier(name)
+}
+
+// IsReservedKeyword reports whether word is reserved in the dialect.
+func (s standardSpec) IsReservedKeyword(word string) bool {
+	return s.d.IsReservedKeyword(word)
+}
+
+// QuoteString quotes str with the dialect's escaping rules.
+func (s standardSpec) QuoteString(str string) (string, error) {
+	return s.d.QuoteString(str)
+}
+
+// BoolLiteral returns TRUE or FALSE.
+func (standardSpec) BoolLiteral(v bool) string {
+	if v {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+// CastToNumber casts operand to NUMERIC.
+func (standardSpec) CastToNumber(operand string) string {
+	return fmt.Sprintf("CAST(%s AS NUMERIC)", operand)
+}
+
+// Modulo uses the % operator.
+func (standardSpec) Modulo(operands []string) string {
+	return fmt.Sprintf("(%s)", strings.Join(operands, " % "))
+}
+
+// Greatest uses GREATEST.
+func (standardSpec) Greatest(operands []string) string {
+	return fmt.Sprintf("GREATEST(%s)", strings.Join(operands, ", "))
+}
+
+// Least uses LEAST.
+func (standardSpec) Least(operands []string) string {
+	return fmt.Sprintf("LEAST(%s)", strings.Join(operands, ", "))
+}
+
+// Truthiness compares operand with each falsy value.
+func (standardSpec) Truthiness(operand string) string {
+	return fmt.Sprintf("(%s IS NOT NULL AND %s != FALSE AND %s != 0 AND %s != '')", operand, operand, operand, operand)
+}
+
+// IsTrue uses IS TRUE, which is false for NULL.
+func (standardSpec) IsTrue(operand string) string {
+	return fmt.Sprintf("%s IS TRUE", operand)
+}
+
+// StringNotEmpty compares operand with the empty string.
+func (standardSpec) StringNotEmpty(operand string) string {
+	return fmt.Sprintf("(%s IS NOT NULL AND %s != '')", operand, operand)
+}
+
+// Conditional uses a searched CASE.
+func (standardSpec) Conditional(condition, then, otherwise string) string {
+	return fmt.Sprintf("CASE WHEN %s THEN %s ELSE %s END", condition, then, otherwise)
+}
+
+// Concat uses the CONCAT function.
+func (standardSpec) Concat(operands []string) string {
+	return fmt.Sprintf("CONCAT(%s)", strings.Join(operands, ", "))
+}
+
+// Substring uses SUBSTR.
+func (standardSpec) Substring(str, start, length string) string {
+	return substringCall("SUBSTR", str, start, length)
+}
+
+// StringPosition uses STRPOS(haystack, needle).
+func (standardSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("STRPOS(%s, %s)", haystack, needle)
+}
+
+// ArrayLiteral uses bracketed array literals.
+func (standardSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("[%s]", strings.Join(elements, " ")), nil
+}
+
+// ArrayContains uses IN with the array as its right operand.
+func (standardSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf("%s IN %s", value, array), nil
+}
+
+// ArrayElement reads fields of the unnested element with dot notation.
+func (standardSpec) ArrayElement(_, quotedPath []string) (string, error) {
+	return strings.Join(append([]string{ElemVar}, quotedPath...), "."), nil
+}
+
+// ArrayMap collects body over the unnested elements into a new array.
+func (standardSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("ARRAY(SELECT %s FROM UNNEST(%s) AS elem)", body, array), nil
+}
+
+// ArrayFilter collects the matching unnested elements into a new array.
+func (standardSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("ARRAY(SELECT elem FROM UNNEST(%s) AS elem WHERE %s)", array, condition), nil
+}
+
+// ArrayAll checks that no unnested element fails condition.
+func (standardSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM UNNEST(%s) AS elem WHERE NOT (%s))", array, condition), nil
+}
+
+// ArraySome checks that an unnested element matches condition.
+func (standardSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM UNNEST(%s) AS elem WHERE %s)", array, condition), nil
+}
+
+// ArrayNone checks that no unnested element matches condition.
+func (standardSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM UNNEST(%s) AS elem WHERE %s)", array, condition), nil
+}
+
+// ArrayAccumulator is initial: without a fold, the body is evaluated for each
+// unnested element from the initial value.
+func (standardSpec) ArrayAccumulator(initial string) string {
+	return initial
+}
+
+// ArrayReduce evaluates body over the unnested elements.
+func (standardSpec) ArrayReduce(array, _, body string) (string, error) {
+	return fmt.Sprintf("(SELECT %s FROM UNNEST(%s) AS elem)", body, array), nil
+}
+
+// ArrayNotEmpty uses CARDINALITY.
+func (standardSpec) ArrayNotEmpty(array string) (string, error) {
+	return fmt.Sprintf("CARDINALITY(%s) > 0", array), nil
+}
+
+// JSONPath uses JSON_VALUE for scalars and JSON_QUERY for JSON leaves, casting
+// scalars to INT64, FLOAT64 or BOOL.
+func (s standardSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathGoogleSQL(s.d, column, path, leafType)
+}
+
+// errUnsupportedReduce reports a reduce body that a dialect without a fold
+// primitive cannot evaluate. Evaluating it once per row would ignore the
+// accumulator and return one value per element.
+func errUnsupportedReduce(dialectName string) error {
+	return fmt.Errorf("unsupported reduce body on %s: only the sum, min or max of accumulator and current is supported", dialectName)
+}
+
+// ArrayMerge uses ARRAY_CONCAT.
+func (standardSpec) ArrayMerge(arrays []string) (string, error) {
+	return fmt.Sprintf("ARRAY_CONCAT(%s)", strings.Join(arrays, ", ")), nil
+}
+
+// ArrayAggregate combines the aggregate of the unnested elements with initial.
+func (standardSpec) ArrayAggregate(array, initial, function string, _, quotedPath []string) (string, error) {
+	agg := fmt.Sprintf("(SELECT %s(%s) FROM UNNEST(%s) AS elem)", function, elementRef(quotedPath), array)
+	return aggregateInto(initial, function, agg, "LEAST", "GREATEST", "COALESCE"), nil
+}
+
+// bigQuerySpec renders BigQuery, which has the standard forms and a family of
+// date functions per temporal type.
+type bigQuerySpec struct {
+	standardSpec
+}
+
+// spannerSpec renders Spanner, which has the standard forms and BigQuery-style
+// date functions that work in UTC.
+type spannerSpec struct {
+	standardSpec
+}
+
+// postgreSQLSpec renders PostgreSQL, which finds substrings with POSITION and
+// concatenates arrays with the || operator.
+type postgreSQLSpec struct {
+	standardSpec
+}
+
+// StringPosition uses POSITION(needle IN haystack).
+func (postgreSQLSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("POSITION(%s IN %s)", needle, haystack)
+}
+
+// ArrayMerge uses the || operator.
+func (postgreSQLSpec) ArrayMerge(arrays []string) (string, error) {
+	return pipeConcat(arrays), nil
+}
+
+// JSONPath chains the -> and ->> operators.
+func (postgreSQLSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathPostgreSQL(column, path, leafType)
+}
+
+// duckDBSpec renders DuckDB, which has the standard array forms but its own
+// JSON functions.
+type duckDBSpec struct {
+	standardSpec
+}
+
+// JSONPath uses json_extract_string and json_extract.
+func (duckDBSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathDuckDB(column, path, leafType)
+}
+
+// clickHouseSpec renders ClickHouse, which has lambda array functions such as
+// arrayMap and arrayExists.
+type clickHouseSpec struct {
+	standardSpec
+}
+
+// Substring uses substring.
+func (clickHouseSpec) Substring(str, start, length string) string {
+	return substringCall("substring", str, start, length)
+}
+
+// StringPosition uses position(haystack, needle).
+func (clickHouseSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("position(%s, %s)", haystack, needle)
+}
+
+// ArrayMap uses arrayMap.
+func (clickHouseSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("arrayMap(elem -> %s, %s)", body, array), nil
+}
+
+// ArrayFilter uses arrayFilter.
+func (clickHouseSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("arrayFilter(elem -> %s, %s)", condition, array), nil
+}
+
+// ArrayAll uses arrayAll.
+func (clickHouseSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("arrayAll(elem -> %s, %s)", condition, array), nil
+}
+
+// ArraySome uses arrayExists.
+func (clickHouseSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("arrayExists(elem -> %s, %s)", condition, array), nil
+}
+
+// ArrayNone negates arrayExists.
+func (clickHouseSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT arrayExists(elem -> %s, %s)", condition, array), nil
+}
+
+// ArrayReduce uses arrayFold (ClickHouse 22.8+).
+func (clickHouseSpec) ArrayReduce(array, initial, body string) (string, error) {
+	return fmt.Sprintf("arrayFold((acc, elem) -> %s, %s, %s)", body, array, initial), nil
+}
+
+// ArrayMerge uses arrayConcat.
+func (clickHouseSpec) ArrayMerge(arrays []string) (string, error) {
+	return fmt.Sprintf("arrayConcat(%s)", strings.Join(arrays, ", ")), nil
+}
+
+// ArrayNotEmpty uses length.
+func (clickHouseSpec) ArrayNotEmpty(array string) (string, error) {
+	return fmt.Sprintf("length(%s) > 0", array), nil
+}
+
+// JSONPath uses the typed JSONExtract functions.
+func (clickHouseSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathClickHouse(column, path, leafType)
+}
+
+// ArrayAggregate uses arrayReduce, mapping object elements to the field first.
+func (clickHouseSpec) ArrayAggregate(array, initial, function string, _, quotedPath []string) (string, error) {
+	if len(quotedPath) > 0 {
+		array = fmt.Sprintf("arrayMap(x -> x.%s, %s)", strings.Join(quotedPath, "."), array)
+	}
+	agg := fmt.Sprintf("arrayReduce('%s', %s)", strings.ToLower(function), array)
+	return aggregateInto(initial, function, agg, "least", "greatest", "coalesce"), nil
+}
+
+// mySQLSpec renders MySQL, which stores arrays as JSON documents and unnests
+// them with JSON_TABLE.
+type mySQLSpec struct {
+	standardSpec
+}
+
+// CastToNumber casts to DOUBLE, since MySQL has no NUMERIC cast target and a
+// bare DECIMAL would drop the fractional part.
+func (mySQLSpec) CastToNumber(operand string) string {
+	return fmt.Sprintf("CAST(%s AS DOUBLE)", operand)
+}
+
+// Substring uses SUBSTRING.
+func (mySQLSpec) Substring(str, start, length string) string {
+	return substringCall("SUBSTRING", str, start, length)
+}
+
+// StringPosition uses LOCATE(needle, haystack).
+func (mySQLSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("LOCATE(%s, %s)", needle, haystack)
+}
+
+// ArrayLiteral uses JSON_ARRAY.
+func (mySQLSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("JSON_ARRAY(%s)", strings.Join(elements, ", ")), nil
+}
+
+// ArrayContains uses JSON_CONTAINS against a one-element array, which keeps
+// the value's JSON type.
+func (mySQLSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf("JSON_CONTAINS(%s, JSON_ARRAY(%s))", array, value), nil
+}
+
+// ArrayElement reads the JSON_TABLE column, extracting fields of object
+// elements with JSON_EXTRACT, since MySQL array elements are JSON values
+// rather than structs.
+func (mySQLSpec) ArrayElement(path, _ []string) (string, error) {
+	if len(path) == 0 {
+		return ElemVar, nil
+	}
+	literal, err := dialect.DialectMySQL.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("JSON_EXTRACT(%s, %s)", ElemVar, literal), nil
+}
+
+// ArrayMap aggregates body over the JSON_TABLE rows with JSON_ARRAYAGG.
+func (mySQLSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("COALESCE((SELECT JSON_ARRAYAGG(%s) FROM %s), JSON_ARRAY())",
+		body, mysqlElementTable(array, "JSON", "'$'")), nil
+}
+
+// ArrayFilter aggregates the matching JSON_TABLE rows with JSON_ARRAYAGG.
+func (mySQLSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("COALESCE((SELECT JSON_ARRAYAGG(elem) FROM %s WHERE %s), JSON_ARRAY())",
+		mysqlElementTable(array, "JSON", "'$'"), condition), nil
+}
+
+// ArrayAll checks that no JSON_TABLE row fails condition.
+func (mySQLSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE NOT (%s))",
+		mysqlElementTable(array, "JSON", "'$'"), condition), nil
+}
+
+// ArraySome checks that a JSON_TABLE row matches condition.
+func (mySQLSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s)",
+		mysqlElementTable(array, "JSON", "'$'"), condition), nil
+}
+
+// ArrayNone checks that no JSON_TABLE row matches condition.
+func (mySQLSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s)",
+		mysqlElementTable(array, "JSON", "'$'"), condition), nil
+}
+
+// ArrayReduce rejects the body: MySQL has no fold over JSON_TABLE rows, so
+// only the sum, min and max patterns are rendered, as aggregates.
+func (mySQLSpec) ArrayReduce(_, _, _ string) (string, error) {
+	return "", errUnsupportedReduce("MySQL")
+}
+
+// ArrayMerge uses JSON_MERGE_PRESERVE, which needs two arguments.
+func (mySQLSpec) ArrayMerge(arrays []string) (string, error) {
+	if len(arrays) == 1 {
+		return arrays[0], nil
+	}
+	return fmt.Sprintf("JSON_MERGE_PRESERVE(%s)", strings.Join(arrays, ", ")), nil
+}
+
+// ArrayNotEmpty uses JSON_LENGTH.
+func (mySQLSpec) ArrayNotEmpty(array string) (string, error) {
+	return fmt.Sprintf("JSON_LENGTH(%s) > 0", array), nil
+}
+
+// JSONPath uses JSON_EXTRACT.
+func (mySQLSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathMySQL(column, path, leafType)
+}
+
+// ArrayAggregate extracts the element (or its field) as a number in the
+// JSON_TABLE column.
+func (mySQLSpec) ArrayAggregate(array, initial, function string, path, _ []string) (string, error) {
+	literal, err := jsonPathLiteral(dialect.DialectMySQL, path)
+	if err != nil {
+		return "", err
+	}
+	agg := fmt.Sprintf("(SELECT %s(elem) FROM %s)", function, mysqlElementTable(array, "DOUBLE", literal))
+	return aggregateInto(initial, function, agg, "LEAST", "GREATEST", "COALESCE"), nil
+}
+
+// sqliteSpec renders SQLite, which stores arrays as JSON text and unnests
+// them with json_each.
+type sqliteSpec struct {
+	standardSpec
+}
+
+// Greatest uses the multi-argument max() scalar function, since SQLite has no
+// GREATEST.
+func (sqliteSpec) Greatest(operands []string) string {
+	return fmt.Sprintf("max(%s)", strings.Join(operands, ", "))
+}
+
+// Least uses the multi-argument min() scalar function, since SQLite has no
+// LEAST.
+func (sqliteSpec) Least(operands []string) string {
+	return fmt.Sprintf("min(%s)", strings.Join(operands, ", "))
+}
+
+// Concat uses the || operator, since SQLite has no CONCAT before 3.44.
+func (sqliteSpec) Concat(operands []string) string {
+	return pipeConcat(operands)
+}
+
+// Substring uses substr.
+func (sqliteSpec) Substring(str, start, length string) string {
+	return substringCall("substr", str, start, length)
+}
+
+// StringPosition uses instr(haystack, needle).
+func (sqliteSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("instr(%s, %s)", haystack, needle)
+}
+
+// ArrayLiteral uses json_array.
+func (sqliteSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("json_array(%s)", strings.Join(elements, ", ")), nil
+}
+
+// ArrayContains compares against the json_each rows.
+func (sqliteSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf("%s IN (SELECT value FROM json_each(%s))", value, array), nil
+}
+
+// ArrayElement reads the json_each value, extracting fields of object
+// elements with json_extract.
+func (sqliteSpec) ArrayElement(path, _ []string) (string, error) {
+	return jsonElement(dialect.DialectSQLite, "json_extract", path)
+}
+
+// ArrayMap aggregates body over the json_each rows with json_group_array.
+func (sqliteSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("(SELECT json_group_array(%s) FROM %s)", body, sqliteElementTable(array)), nil
+}
+
+// ArrayFilter aggregates the matching json_each rows with json_group_array.
+func (sqliteSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("(SELECT json_group_array(%s) FROM %s WHERE %s)",
+		sqliteElementValue, sqliteElementTable(array), condition), nil
+}
+
+// ArrayAll checks that no json_each row fails condition.
+func (sqliteSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE NOT (%s))", sqliteElementTable(array), condition), nil
+}
+
+// ArraySome checks that a json_each row matches condition.
+func (sqliteSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s)", sqliteElementTable(array), condition), nil
+}
+
+// ArrayNone checks that no json_each row matches condition.
+func (sqliteSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s)", sqliteElementTable(array), condition), nil
+}
+
+// ArrayReduce rejects the body: SQLite has no fold over json_each rows, so
+// only the sum, min and max patterns are rendered, as aggregates.
+func (sqliteSpec) ArrayReduce(_, _, _ string) (string, error) {
+	return "", errUnsupportedReduce("SQLite")
+}
+
+// ArrayMerge wraps the arrays in one JSON array and unnests it twice.
+func (sqliteSpec) ArrayMerge(arrays []string) (string, error) {
+	if len(arrays) == 1 {
+		return arrays[0], nil
+	}
+	wrapped := make([]string, len(arrays))
+	for i, array := range arrays {
+		wrapped[i] = fmt.Sprintf("json(%s)", array)
+	}
+	return fmt.Sprintf("(SELECT json_group_array(%s) FROM json_each(json_array(%s)) AS src, json_each(src.value) AS je)",
+		sqliteElementValue, strings.Join(wrapped, ", ")), nil
+}
+
+// ArrayNotEmpty uses json_array_length.
+func (sqliteSpec) ArrayNotEmpty(array string) (string, error) {
+	return fmt.Sprintf("json_array_length(%s) > 0", array), nil
+}
+
+// JSONPath uses json_extract.
+func (sqliteSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathSQLite(column, path, leafType)
+}
+
+// ArrayAggregate aggregates the json_each values, extracting the field from
+// object elements. SQLite has no LEAST or GREATEST, so the scalar min and max
+// combine a MIN or MAX with initial.
+func (sqliteSpec) ArrayAggregate(array, initial, function string, path, _ []string) (string, error) {
+	value := sqliteElementValue
+	if len(path) > 0 {
+		literal, err := jsonPathLiteral(dialect.DialectSQLite, path)
+		if err != nil {
+			return "", err
+		}
+		value = fmt.Sprintf("json_extract(%s, %s)", sqliteElementValue, literal)
+	}
+	agg := fmt.Sprintf("(SELECT %s(%s) FROM %s)", function, value, sqliteElementTable(array))
+	return aggregateInto(initial, function, agg, "min", "max", "COALESCE"), nil
+}
+
+// snowflakeSpec renders Snowflake, whose arrays hold VARIANT elements and are
+// processed with the TRANSFORM, FILTER and REDUCE higher-order functions.
+type snowflakeSpec struct {
+	standardSpec
+}
+
+// CastToNumber casts to DOUBLE, since Snowflake's NUMERIC is NUMBER(38, 0)
+// and would drop the fractional part.
+func (snowflakeSpec) CastToNumber(operand string) string {
+	return fmt.Sprintf("CAST(%s AS DOUBLE)", operand)
+}
+
+// Concat uses the || operator, since Snowflake's CONCAT only accepts strings.
+func (snowflakeSpec) Concat(operands []string) string {
+	return pipeConcat(operands)
+}
+
+// IsTrue maps NULL to FALSE with COALESCE, since Snowflake has no IS TRUE.
+func (snowflakeSpec) IsTrue(operand string) string {
+	return fmt.Sprintf("COALESCE(%s, FALSE)", operand)
+}
+
+// Conditional uses IFF.
+func (snowflakeSpec) Conditional(condition, then, otherwise string) string {
+	return fmt.Sprintf("IFF(%s, %s, %s)", condition, then, otherwise)
+}
+
+// StringPosition uses POSITION(needle IN haystack).
+func (snowflakeSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("POSITION(%s IN %s)", needle, haystack)
+}
+
+// ArrayLiteral uses ARRAY_CONSTRUCT.
+func (snowflakeSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("ARRAY_CONSTRUCT(%s)", strings.Join(elements, ", ")), nil
+}
+
+// ArrayContains uses ARRAY_CONTAINS with the value cast to VARIANT.
+func (snowflakeSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf("ARRAY_CONTAINS(%s::VARIANT, %s)", value, array), nil
+}
+
+// ArrayElement reads fields of the lambda element with VARIANT path access,
+// since Snowflake array elements are VARIANT values rather than structs:
+// item.price becomes elem:price. Keys are case-sensitive, so they are quoted
+// as written when they are reserved words or unsafe.
+func (s snowflakeSpec) ArrayElement(path, _ []string) (string, error) {
+	var b strings.Builder
+	b.WriteString(ElemVar)
+	for i, segment := range path {
+		if i == 0 {
+			b.WriteString(":")
+		} else {
+			b.WriteString(".")
+		}
+		if dialect.IsSafeIdentifier(segment) && !s.IsReservedKeyword(segment) {
+			b.WriteString(segment)
+		} else {
+			b.WriteString(`"` + strings.ReplaceAll(segment, `"`, `""`) + `"`)
+		}
+	}
+	return b.String(), nil
+}
+
+// ArrayMap uses TRANSFORM.
+func (snowflakeSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("TRANSFORM(%s, elem -> %s)", array, body), nil
+}
+
+// ArrayFilter uses FILTER.
+func (snowflakeSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("FILTER(%s, elem -> %s)", array, condition), nil
+}
+
+// ArrayAll checks that FILTER keeps no failing element.
+func (snowflakeSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("ARRAY_SIZE(FILTER(%s, elem -> NOT (%s))) = 0", array, condition), nil
+}
+
+// ArraySome checks that FILTER keeps at least one element.
+func (snowflakeSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("ARRAY_SIZE(FILTER(%s, elem -> %s)) > 0", array, condition), nil
+}
+
+// ArrayNone checks that FILTER keeps no element.
+func (snowflakeSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("ARRAY_SIZE(FILTER(%s, elem -> %s)) = 0", array, condition), nil
+}
+
+// ArrayAccumulator is the acc parameter of the fold lambda.
+func (snowflakeSpec) ArrayAccumulator(string) string {
+	return "acc"
+}
+
+// ArrayReduce folds with REDUCE and a real accumulator lambda parameter.
+func (snowflakeSpec) ArrayReduce(array, initial, body string) (string, error) {
+	return fmt.Sprintf("REDUCE(%s, %s, (acc, elem) -> %s)", array, initial, body), nil
+}
+
+// ArrayMerge nests ARRAY_CAT calls, which take exactly two arrays.
+func (snowflakeSpec) ArrayMerge(arrays []string) (string, error) {
+	merged := arrays[0]
+	for _, array := range arrays[1:] {
+		merged = fmt.Sprintf("ARRAY_CAT(%s, %s)", merged, array)
+	}
+	return merged, nil
+}
+
+// ArrayNotEmpty uses ARRAY_SIZE.
+func (snowflakeSpec) ArrayNotEmpty(array string) (string, error) {
+	return fmt.Sprintf("ARRAY_SIZE(%s) > 0", array), nil
+}
+
+// JSONPath uses VARIANT path access.
+func (snowflakeSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathSnowflake(column, path, leafType)
+}
+
+// ArrayAggregate folds with REDUCE, reading the field from object elements.
+func (s snowflakeSpec) ArrayAggregate(array, initial, function string, path, _ []string) (string, error) {
+	value, err := s.ArrayElement(path, nil)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("REDUCE(%s, %s, (acc, elem) -> %s)",
+		array, initial, lambdaFold(function, value)), nil
+}
+
+// sqlServerSpec renders SQL Server, which has no boolean type in conditions,
+// stores arrays as JSON text and unnests them with OPENJSON.
+type sqlServerSpec struct {
+	standardSpec
+}
+
+// BoolLiteral returns 1 or 0.
+func (sqlServerSpec) BoolLiteral(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// CastToNumber casts to FLOAT, since SQL Server's NUMERIC defaults to
+// NUMERIC(18, 0) and its DOUBLE is spelled FLOAT.
+func (sqlServerSpec) CastToNumber(operand string) string {
+	return fmt.Sprintf("CAST(%s AS FLOAT)", operand)
+}
+
+// Truthiness leaves out the comparison with FALSE, which the comparison with
+// zero already covers for BIT values.
+func (sqlServerSpec) Truthiness(operand string) string {
+	return fmt.Sprintf("(%s IS NOT NULL AND %s != 0 AND %s != '')", operand, operand, operand)
+}
+
+// IsTrue compares with 1, which is false for NULL.
+func (sqlServerSpec) IsTrue(operand string) string {
+	return fmt.Sprintf("%s = 1", operand)
+}
+
+// Substring uses SUBSTRING, which always takes a length, so the byte length
+// of the string serves as an upper bound when none is given.
+func (sqlServerSpec) Substring(str, start, length string) string {
+	if length == "" {
+		length = fmt.Sprintf("DATALENGTH(%s)", str)
+	}
+	return substringCall("SUBSTRING", str, start, length)
+}
+
+// StringPosition uses CHARINDEX(needle, haystack).
+func (sqlServerSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("CHARINDEX(%s, %s)", needle, haystack)
+}
+
+// ArrayLiteral uses JSON_ARRAY.
+func (sqlServerSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("JSON_ARRAY(%s)", strings.Join(elements, ", ")), nil
+}
+
+// ArrayContains compares against the OPENJSON rows.
+func (sqlServerSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf("%s IN (SELECT value FROM OPENJSON(%s))", value, array), nil
+}
+
+// ArrayElement reads the OPENJSON value, extracting fields of object
+// elements with JSON_VALUE.
+func (sqlServerSpec) ArrayElement(path, _ []string) (string, error) {
+	return jsonElement(dialect.DialectSQLServer, "JSON_VALUE", path)
+}
+
+// ArrayMap joins the JSON of each result with STRING_AGG. JSON_ARRAY encodes
+// each result as JSON; its brackets are stripped before aggregating.
+func (sqlServerSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("(SELECT %s FROM %s CROSS APPLY (SELECT JSON_ARRAY(%s NULL ON NULL) AS j) AS m)",
+		sqlServerJSONArrayAgg("SUBSTRING(m.j, 2, LEN(m.j) - 2)", sqlServerElementOrder),
+		sqlServerElementTable(array), body), nil
+}
+
+// ArrayFilter joins the JSON of the matching OPENJSON rows with STRING_AGG.
+func (sqlServerSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("(SELECT %s FROM %s WHERE %s)",
+		sqlServerJSONArrayAgg(sqlServerElementJSON, sqlServerElementOrder), sqlServerElementTable(array),
+		condition), nil
+}
+
+// ArrayAll checks that no OPENJSON row fails condition.
+func (sqlServerSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE NOT (%s))", sqlServerElementTable(array), condition), nil
+}
+
+// ArraySome checks that an OPENJSON row matches condition.
+func (sqlServerSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s)", sqlServerElementTable(array), condition), nil
+}
+
+// ArrayNone checks that no OPENJSON row matches condition.
+func (sqlServerSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s)", sqlServerElementTable(array), condition), nil
+}
+
+// ArrayReduce rejects the body: SQL Server has no fold over OPENJSON rows, so
+// only the sum, min and max patterns are rendered, as aggregates.
+func (sqlServerSpec) ArrayReduce(_, _, _ string) (string, error) {
+	return "", errUnsupportedReduce("SQL Server")
+}
+
+// ArrayMerge numbers the arrays in a VALUES list and unnests each one in order.
+func (sqlServerSpec) ArrayMerge(arrays []string) (string, error) {
+	if len(arrays) == 1 {
+		return arrays[0], nil
+	}
+	rows := make([]string, len(arrays))
+	for i, array := range arrays {
+		rows[i] = fmt.Sprintf("(%d, %s)", i+1, array)
+	}
+	return fmt.Sprintf("(SELECT %s FROM (VALUES %s) AS src(n, arr) CROSS APPLY OPENJSON(src.arr) AS je)",
+		sqlServerJSONArrayAgg(sqlServerElementJSON, "src.n, "+sqlServerElementOrder), strings.Join(rows, ", ")), nil
+}
+
+// ArrayNotEmpty counts the OPENJSON rows.
+func (sqlServerSpec) ArrayNotEmpty(array string) (string, error) {
+	return fmt.Sprintf("(SELECT COUNT(*) FROM OPENJSON(%s)) > 0", array), nil
+}
+
+// JSONPath uses JSON_VALUE and JSON_QUERY.
+func (sqlServerSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathSQLServer(column, path, leafType)
+}
+
+// ArrayAggregate casts the OPENJSON values, which are text, before aggregating.
+// SQL Server has no LEAST or GREATEST before 2022, so a MIN or MAX is combined
+// with initial by aggregating both again over a VALUES list, which skips the
+// NULL aggregate of an empty array.
+func (sqlServerSpec) ArrayAggregate(array, initial, function string, path, _ []string) (string, error) {
+	value := sqlServerElementValue
+	if len(path) > 0 {
+		literal, err := jsonPathLiteral(dialect.DialectSQLServer, path)
+		if err != nil {
+			return "", err
+		}
+		value = fmt.Sprintf("JSON_VALUE(%s, %s)", sqlServerElementValue, literal)
+	}
+	agg := fmt.Sprintf("(SELECT %s(CAST(%s AS FLOAT)) FROM %s)", function, value, sqlServerElementTable(array))
+	if function == AggregateSUM {
+		return fmt.Sprintf("%s + COALESCE(%s, 0)", initial, agg), nil
+	}
+	return fmt.Sprintf("(SELECT %s(v) FROM (VALUES (%s), (%s)) AS t(v))", function, initial, agg), nil
+}
+
+// trinoSpec renders Trino, which processes arrays with lambda functions and
+// has no IN over arrays.
+type trinoSpec struct {
+	standardSpec
+}
+
+// CastToNumber casts to DOUBLE, since Trino's DECIMAL would drop the
+// fractional part.
+func (trinoSpec) CastToNumber(operand string) string {
+	return fmt.Sprintf("CAST(%s AS DOUBLE)", operand)
+}
+
+// IsTrue maps NULL to FALSE with COALESCE, since Trino has no IS TRUE.
+func (trinoSpec) IsTrue(operand string) string {
+	return fmt.Sprintf("COALESCE(%s, FALSE)", operand)
+}
+
+// ArrayLiteral uses the ARRAY constructor.
+func (trinoSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("ARRAY[%s]", strings.Join(elements, ", ")), nil
+}
+
+// ArrayContains uses contains(array, value).
+func (trinoSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf("contains(%s, %s)", array, value), nil
+}
+
+// ArrayMap uses transform.
+func (trinoSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("transform(%s, elem -> %s)", array, body), nil
+}
+
+// ArrayFilter uses filter.
+func (trinoSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("filter(%s, elem -> %s)", array, condition), nil
+}
+
+// ArrayAll uses all_match.
+func (trinoSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("all_match(%s, elem -> %s)", array, condition), nil
+}
+
+// ArraySome uses any_match.
+func (trinoSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("any_match(%s, elem -> %s)", array, condition), nil
+}
+
+// ArrayNone uses none_match.
+func (trinoSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("none_match(%s, elem -> %s)", array, condition), nil
+}
+
+// ArrayAccumulator is the acc parameter of the fold lambda.
+func (trinoSpec) ArrayAccumulator(string) string {
+	return "acc"
+}
+
+// ArrayReduce uses reduce, whose output function returns the state as is.
+func (trinoSpec) ArrayReduce(array, initial, body string) (string, error) {
+	return fmt.Sprintf("reduce(%s, %s, (acc, elem) -> %s, acc -> acc)", array, initial, body), nil
+}
+
+// ArrayMerge uses concat, which is overloaded for arrays but needs two arguments.
+func (trinoSpec) ArrayMerge(arrays []string) (string, error) {
+	if len(arrays) == 1 {
+		return arrays[0], nil
+	}
+	return fmt.Sprintf("concat(%s)", strings.Join(arrays, ", ")), nil
+}
+
+// JSONPath uses json_extract_scalar and json_extract.
+func (trinoSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathTrino(column, path, leafType)
+}
+
+// ArrayAggregate folds with reduce; elements are ROW values, so fields are
+// read with elem.field.
+func (trinoSpec) ArrayAggregate(array, initial, function string, _, quotedPath []string) (string, error) {
+	return fmt.Sprintf("reduce(%s, %s, (acc, elem) -> %s, acc -> acc)",
+		array, initial, lambdaFold(function, elementRef(quotedPath))), nil
+}
+
+// oracleSpec renders Oracle, which has no boolean type in conditions before
+// 23ai, stores arrays as JSON text and unnests them with JSON_TABLE.
+type oracleSpec struct {
+	standardSpec
+}
+
+// BoolLiteral returns 1 or 0.
+func (oracleSpec) BoolLiteral(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// CastToNumber casts to the unconstrained NUMBER, which keeps the fractional part.
+func (oracleSpec) CastToNumber(operand string) string {
+	return fmt.Sprintf("CAST(%s AS NUMBER)", operand)
+}
+
+// Modulo nests MOD calls, since Oracle has no % operator.
+func (oracleSpec) Modulo(operands []string) string {
+	sql := operands[0]
+	for _, operand := range operands[1:] {
+		sql = fmt.Sprintf("MOD(%s, %s)", sql, operand)
+	}
+	return sql
+}
+
+// Truthiness leaves out the comparisons with FALSE, which the comparison with
+// zero already covers, and with the empty string, which Oracle stores as NULL.
+func (oracleSpec) Truthiness(operand string) string {
+	return fmt.Sprintf("(%s IS NOT NULL AND %s != 0)", operand, operand)
+}
+
+// IsTrue compares with 1, which is false for NULL.
+func (oracleSpec) IsTrue(operand string) string {
+	return fmt.Sprintf("%s = 1", operand)
+}
+
+// StringNotEmpty only checks for NULL, since Oracle stores the empty string
+// as NULL, where a comparison with it would never match.
+func (oracleSpec) StringNotEmpty(operand string) string {
+	return fmt.Sprintf("%s IS NOT NULL", operand)
+}
+
+// Concat uses the || operator, since Oracle's CONCAT only takes two arguments.
+func (oracleSpec) Concat(operands []string) string {
+	return pipeConcat(operands)
+}
+
+// StringPosition uses INSTR(haystack, needle).
+func (oracleSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("INSTR(%s, %s)", haystack, needle)
+}
+
+// ArrayLiteral uses JSON_ARRAY.
+func (oracleSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("JSON_ARRAY(%s)", strings.Join(elements, ", ")), nil
+}
+
+// ArrayContains matches the value with a JSON_EXISTS filter, passing it as a
+// bind variable of the path expression.
+func (oracleSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf(`JSON_EXISTS(%s, '$[*]?(@ == $v)' PASSING %s AS "v")`, array, value), nil
+}
+
+// ArrayElement reads the JSON_TABLE text column, extracting fields of object
+// elements with JSON_VALUE on the wrapped element JSON, since Oracle array
+// elements are JSON values rather than structs.
+func (oracleSpec) ArrayElement(path, _ []string) (string, error) {
+	if len(path) == 0 {
+		return ElemVar, nil
+	}
+	literal, err := dialect.DialectOracle.QuoteString("$[0]" + jsonPathExpression(path)[1:])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("JSON_VALUE(%s_json, %s)", ElemVar, literal), nil
+}
+
+// ArrayMap aggregates body over the JSON_TABLE rows with JSON_ARRAYAGG.
+func (oracleSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("COALESCE((SELECT JSON_ARRAYAGG(%s ORDER BY elem_pos NULL ON NULL) FROM %s), '[]')",
+		body, oracleElementTable(array, oracleElementColumns)), nil
+}
+
+// ArrayFilter aggregates the JSON of the matching JSON_TABLE rows with JSON_ARRAYAGG.
+func (oracleSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("COALESCE((SELECT JSON_ARRAYAGG(%s FORMAT JSON ORDER BY elem_pos NULL ON NULL) FROM %s WHERE %s), '[]')",
+		oracleElementJSON, oracleElementTable(array, oracleElementColumns), condition), nil
+}
+
+// ArrayAll checks that no JSON_TABLE row fails condition.
+func (oracleSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE NOT (%s))",
+		oracleElementTable(array, oracleElementColumns), condition), nil
+}
+
+// ArraySome checks that a JSON_TABLE row matches condition.
+func (oracleSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s)",
+		oracleElementTable(array, oracleElementColumns), condition), nil
+}
+
+// ArrayNone checks that no JSON_TABLE row matches condition.
+func (oracleSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s)",
+		oracleElementTable(array, oracleElementColumns), condition), nil
+}
+
+// ArrayReduce rejects the body: Oracle has no fold over JSON_TABLE rows, so
+// only the sum, min and max patterns are rendered, as aggregates.
+func (oracleSpec) ArrayReduce(_, _, _ string) (string, error) {
+	return "", errUnsupportedReduce("Oracle")
+}
+
+// ArrayMerge numbers the arrays in a UNION ALL of DUAL rows and unnests each
+// one in order.
+func (oracleSpec) ArrayMerge(arrays []string) (string, error) {
+	if len(arrays) == 1 {
+		return arrays[0], nil
+	}
+	rows := make([]string, len(arrays))
+	for i, array := range arrays {
+		rows[i] = fmt.Sprintf("SELECT %d AS n, %s AS arr FROM DUAL", i+1, array)
+	}
+	return fmt.Sprintf("COALESCE((SELECT JSON_ARRAYAGG(%s FORMAT JSON ORDER BY src.n, elem_pos NULL ON NULL) FROM (%s) src, %s), '[]')",
+		oracleElementJSON, strings.Join(rows, " UNION ALL "), oracleElementTable("src.arr", oracleElementColumns)), nil
+}
+
+// ArrayNotEmpty checks that a first element exists.
+func (oracleSpec) ArrayNotEmpty(array string) (string, error) {
+	return fmt.Sprintf("JSON_EXISTS(%s, '$[0]')", array), nil
+}
+
+// JSONPath uses JSON_VALUE and JSON_QUERY.
+func (oracleSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathOracle(column, path, leafType)
+}
+
+// ArrayAggregate extracts the element (or its field) as a number in the
+// JSON_TABLE column.
+func (oracleSpec) ArrayAggregate(array, initial, function string, path, _ []string) (string, error) {
+	literal, err := jsonPathLiteral(dialect.DialectOracle, path)
+	if err != nil {
+		return "", err
+	}
+	agg := fmt.Sprintf("(SELECT %s(elem) FROM %s)", function, oracleElementTable(array, "elem NUMBER PATH "+literal))
+	return aggregateInto(initial, function, agg, "LEAST", "GREATEST", "NVL"), nil
+}
+
+// sparkSQLSpec renders Spark SQL, which processes arrays with higher-order
+// functions such as transform and aggregate.
+type sparkSQLSpec struct {
+	standardSpec
+}
+
+// CastToNumber casts to DOUBLE, since Spark SQL's NUMERIC is DECIMAL(10, 0)
+// and would drop the fractional part.
+func (sparkSQLSpec) CastToNumber(operand string) string {
+	return fmt.Sprintf("CAST(%s AS DOUBLE)", operand)
+}
+
+// StringPosition uses instr(haystack, needle).
+func (sparkSQLSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("instr(%s, %s)", haystack, needle)
+}
+
+// ArrayLiteral uses the array function.
+func (sparkSQLSpec) ArrayLiteral(elements []string) (string, error) {
+	return fmt.Sprintf("array(%s)", strings.Join(elements, ", ")), nil
+}
+
+// ArrayContains uses array_contains(array, value).
+func (sparkSQLSpec) ArrayContains(array, value string) (string, error) {
+	return fmt.Sprintf("array_contains(%s, %s)", array, value), nil
+}
+
+// ArrayMap uses transform.
+func (sparkSQLSpec) ArrayMap(array, body string) (string, error) {
+	return fmt.Sprintf("transform(%s, elem -> %s)", array, body), nil
+}
+
+// ArrayFilter uses filter.
+func (sparkSQLSpec) ArrayFilter(array, condition string) (string, error) {
+	return fmt.Sprintf("filter(%s, elem -> %s)", array, condition), nil
+}
+
+// ArrayAll uses forall.
+func (sparkSQLSpec) ArrayAll(array, condition string) (string, error) {
+	return fmt.Sprintf("forall(%s, elem -> %s)", array, condition), nil
+}
+
+// ArraySome uses exists.
+func (sparkSQLSpec) ArraySome(array, condition string) (string, error) {
+	return fmt.Sprintf("exists(%s, elem -> %s)", array, condition), nil
+}
+
+// ArrayNone negates exists.
+func (sparkSQLSpec) ArrayNone(array, condition string) (string, error) {
+	return fmt.Sprintf("NOT exists(%s, elem -> %s)", array, condition), nil
+}
+
+// ArrayAccumulator is the acc parameter of the fold lambda.
+func (sparkSQLSpec) ArrayAccumulator(string) string {
+	return "acc"
+}
+
+// ArrayReduce uses aggregate, whose finish function is optional.
+func (sparkSQLSpec) ArrayReduce(array, initial, body string) (string, error) {
+	return fmt.Sprintf("aggregate(%s, %s, (acc, elem) -> %s)", array, initial, body), nil
+}
+
+// ArrayMerge uses concat, which is overloaded for arrays and takes any number of them.
+func (sparkSQLSpec) ArrayMerge(arrays []string) (string, error) {
+	return fmt.Sprintf("concat(%s)", strings.Join(arrays, ", ")), nil
+}
+
+// JSONPath uses get_json_object.
+func (sparkSQLSpec) JSONPath(column string, path []string, leafType string) (string, error) {
+	return jsonPathSparkSQL(column, path, leafType)
+}
+
+// ArrayAggregate folds with aggregate; elements are structs, so fields are
+// read with elem.field. The fold result must have the type of the initial
+// value, so it is widened to DOUBLE.
+func (s sparkSQLSpec) ArrayAggregate(array, initial, function string, _, quotedPath []string) (string, error) {
+	return fmt.Sprintf("aggregate(%s, %s, (acc, elem) -> %s)",
+		array, s.CastToNumber(initial), lambdaFold(function, elementRef(quotedPath))), nil
+}
@@ -0,0 +1,196 @@
+package operators
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// warehouseSpec is a registered dialect built on the PostgreSQL spec, as a
+// caller would write it: it has numeric booleans, its own string position
+// function and no array membership.
+type warehouseSpec struct {
+	dialect.Spec
+}
+
+func (warehouseSpec) Name() string {
+	return "Warehouse"
+}
+
+func (warehouseSpec) BoolLiteral(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+func (warehouseSpec) StringPosition(haystack, needle string) string {
+	return fmt.Sprintf("INDEX_OF(%s, %s)", haystack, needle)
+}
+
+func (warehouseSpec) ArrayContains(string, string) (string, error) {
+	return "", fmt.Errorf("array membership is not supported")
+}
+
+// warehouseDialect is registered once per test binary, since registration is global.
+var warehouseDialect = func() dialect.Dialect {
+	d, err := dialect.Register(warehouseSpec{SpecFor(dialect.DialectPostgreSQL)})
+	if err != nil {
+		panic(err)
+	}
+	return d
+}()
+
+func TestSpecFor(t *testing.T) {
+	for d := dialect.DialectBigQuery; d <= dialect.DialectSparkSQL; d++ {
+		spec := SpecFor(d)
+		if spec == nil {
+			t.Errorf("SpecFor(%s) = nil", d)
+			continue
+		}
+		if spec.Name() != d.String() {
+			t.Errorf("SpecFor(%s).Name() = %q", d, spec.Name())
+		}
+	}
+
+	if spec := SpecFor(warehouseDialect); spec == nil || spec.Name() != "Warehouse" {
+		t.Errorf("SpecFor(registered) = %v", spec)
+	}
+	if spec := SpecFor(dialect.DialectUnspecified); spec != nil {
+		t.Errorf("SpecFor(Unspecified) = %v, want nil", spec)
+	}
+	if spec := SpecFor(dialect.Dialect(999)); spec != nil {
+		t.Errorf("SpecFor(999) = %v, want nil", spec)
+	}
+}
+
+func TestBuiltinSpecs_Strings(t *testing.T) {
+	tests := []struct {
+		dialect   dialect.Dialect
+		concat    string
+		substring string
+	}{
+		{dialect.DialectBigQuery, "CONCAT(a, b)", "SUBSTR(s, 2)"},
+		{dialect.DialectPostgreSQL, "CONCAT(a, b)", "SUBSTR(s, 2)"},
+		{dialect.DialectClickHouse, "CONCAT(a, b)", "substring(s, 2)"},
+		{dialect.DialectMySQL, "CONCAT(a, b)", "SUBSTRING(s, 2)"},
+		{dialect.DialectSQLite, "(a || b)", "substr(s, 2)"},
+		{dialect.DialectSnowflake, "(a || b)", "SUBSTR(s, 2)"},
+		{dialect.DialectSQLServer, "CONCAT(a, b)", "SUBSTRING(s, 2, DATALENGTH(s))"},
+		{dialect.DialectOracle, "(a || b)", "SUBSTR(s, 2)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			spec := SpecFor(tt.dialect)
+			if got := spec.Concat([]string{"a", "b"}); got != tt.concat {
+				t.Errorf("Concat() = %q, want %q", got, tt.concat)
+			}
+			if got := spec.Substring("s", "2", ""); got != tt.substring {
+				t.Errorf("Substring() = %q, want %q", got, tt.substring)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_RegisteredDialect(t *testing.T) {
+	config := NewOperatorConfig(warehouseDialect, nil)
+
+	if err := config.ValidateDialect("map"); err != nil {
+		t.Errorf("ValidateDialect() unexpected error: %v", err)
+	}
+	if !config.HasNumericBooleans() {
+		t.Errorf("HasNumericBooleans() = false for a spec that renders true as 1")
+	}
+	if got := config.BoolPredicate(false); got != "1 = 0" {
+		t.Errorf("BoolPredicate(false) = %q, want %q", got, "1 = 0")
+	}
+	if got := config.CastToNumber("x"); got != "CAST(x AS NUMERIC)" {
+		t.Errorf("CastToNumber() = %q", got)
+	}
+	if got, err := config.IdentifierToSQL("user.order"); err != nil || got != `user."order"` {
+		t.Errorf("IdentifierToSQL() = %q, %v", got, err)
+	}
+
+	tests := []struct {
+		name     string
+		sql      func() (string, error)
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "inherited concat",
+			sql: func() (string, error) {
+				return NewStringOperator(config).ToSQL("cat", []interface{}{"a", map[string]interface{}{"var": "b"}})
+			},
+			expected: "CONCAT('a', b)",
+		},
+		{
+			name: "overridden string position",
+			sql: func() (string, error) {
+				return NewComparisonOperator(config).ToSQL("in", []interface{}{"x", map[string]interface{}{"var": "name"}})
+			},
+			expected: "INDEX_OF(name, 'x') > 0",
+		},
+		{
+			name: "overridden array membership error",
+			sql: func() (string, error) {
+				return NewComparisonOperator(config).ToSQL("in", []interface{}{map[string]interface{}{"var": "x"}, map[string]interface{}{"var": "tags"}})
+			},
+			wantErr: true,
+		},
+		{
+			name: "inherited array form",
+			sql: func() (string, error) {
+				return NewArrayOperator(config).ToSQL("some", []interface{}{
+					map[string]interface{}{"var": "scores"},
+					map[string]interface{}{">": []interface{}{map[string]interface{}{"var": ""}, 5}},
+				})
+			},
+			expected: "EXISTS (SELECT 1 FROM UNNEST(scores) AS elem WHERE elem > 5)",
+		},
+		{
+			name: "sum reduce uses the inherited aggregate",
+			sql: func() (string, error) {
+				return NewArrayOperator(config).ToSQL("reduce", []interface{}{
+					map[string]interface{}{"var": "scores"},
+					map[string]interface{}{"+": []interface{}{map[string]interface{}{"var": "accumulator"}, map[string]interface{}{"var": "current"}}},
+					0,
+				})
+			},
+			expected: "0 + COALESCE((SELECT SUM(elem) FROM UNNEST(scores) AS elem), 0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sql()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParamCollector_RegisteredDialect(t *testing.T) {
+	p := NewParamCollector(warehouseDialect)
+	got, args := p.Bind(fmt.Sprintf("x = %s AND y = %s", p.Add("a"), p.Add(1)))
+	if got != "x = ? AND y = ?" || !reflect.DeepEqual(args, []any{"a", 1}) {
+		t.Errorf("Bind() = %q, %v", got, args)
+	}
+	if strings.Contains(got, "\x00") {
+		t.Errorf("Bind() left a positional marker in %q", got)
+	}
+}
@@ -0,0 +1,372 @@
+package operators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// findJSONRoot returns the longest dotted prefix of varName that the schema
+// declares as a JSON column, along with the remaining path segments.
+func (d *DataOperator) findJSONRoot(varName string) (string, []string, bool) {
+	schema := d.schema()
+	if schema == nil {
+		return "", nil, false
+	}
+
+	segments := strings.Split(varName, ".")
+	for i := len(segments) - 1; i > 0; i-- {
+		root := strings.Join(segments[:i], ".")
+		if schema.IsJSONType(root) {
+			return root, segments[i:], true
+		}
+	}
+	return "", nil, false
+}
+
+// jsonPathToSQL converts a var under a JSON root column to the extraction
+// expression of the dialect's Spec. Scalar leaves are extracted as text and cast according
+// to the leaf field type declared in the schema; array and object leaves are
+// returned as JSON fragments.
+//
+//	BigQuery/Spanner: JSON_VALUE(attrs, '$.address.city')
+//	PostgreSQL:       attrs->'address'->>'city'
+//	DuckDB:           json_extract_string(attrs, '$.address.city')
+//	ClickHouse:       JSONExtractString(attrs, 'address', 'city')
+//	MySQL:            JSON_UNQUOTE(JSON_EXTRACT(attrs, '$.address.city'))
+//	SQLite:           json_extract(attrs, '$.address.city')
+//	Snowflake:        attrs:address.city::STRING
+//	SQLServer:        JSON_VALUE(attrs, '$.address.city')
+//	Trino:            json_extract_scalar(attrs, '$.address.city')
+//	Oracle:           JSON_VALUE(attrs, '$.address.city')
+//	SparkSQL:         get_json_object(attrs, '$.address.city')
+func (d *DataOperator) jsonPathToSQL(varName, root string, path []string) (string, error) {
+	for _, segment := range path {
+		if segment == "" {
+			return "", fmt.Errorf("invalid identifier %q: empty path segment", varName)
+		}
+	}
+
+	column, err := d.convertColumn(root)
+	if err != nil {
+		return "", err
+	}
+
+	if d.config.GetDialect() == dialect.DialectUnspecified {
+		return "", fmt.Errorf("JSON path extraction for '%s': dialect not specified", varName)
+	}
+	return d.config.Spec().JSONPath(column, path, d.schema().GetFieldType(varName))
+}
+
+// jsonPathGoogleSQL builds JSON_VALUE/JSON_QUERY extraction for BigQuery and Spanner.
+func jsonPathGoogleSQL(d dialect.Dialect, column string, path []string, leafType string) (string, error) {
+	jsonPath, err := d.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+
+	if isJSONFragmentType(leafType) {
+		return fmt.Sprintf("JSON_QUERY(%s, %s)", column, jsonPath), nil
+	}
+	value := fmt.Sprintf("JSON_VALUE(%s, %s)", column, jsonPath)
+	return castJSONScalar(value, leafType, "INT64", "FLOAT64", "BOOL"), nil
+}
+
+// jsonPathPostgreSQL builds -> / ->> operator chains for PostgreSQL JSON/JSONB columns.
+func jsonPathPostgreSQL(column string, path []string, leafType string) (string, error) {
+	var b strings.Builder
+	b.WriteString(column)
+	for i, segment := range path {
+		op := "->"
+		if i == len(path)-1 && !isJSONFragmentType(leafType) {
+			op = "->>"
+		}
+		key := segment
+		if !isJSONArrayIndex(segment) {
+			quoted, err := dialect.DialectPostgreSQL.QuoteString(segment)
+			if err != nil {
+				return "", err
+			}
+			key = quoted
+		}
+		b.WriteString(op)
+		b.WriteString(key)
+	}
+
+	if isJSONFragmentType(leafType) {
+		return b.String(), nil
+	}
+	return castJSONScalar(b.String(), leafType, "BIGINT", "DOUBLE PRECISION", "BOOLEAN"), nil
+}
+
+// jsonPathDuckDB builds json_extract_string/json_extract calls for DuckDB.
+func jsonPathDuckDB(column string, path []string, leafType string) (string, error) {
+	jsonPath, err := dialect.DialectDuckDB.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+
+	if isJSONFragmentType(leafType) {
+		return fmt.Sprintf("json_extract(%s, %s)", column, jsonPath), nil
+	}
+	value := fmt.Sprintf("json_extract_string(%s, %s)", column, jsonPath)
+	return castJSONScalar(value, leafType, "BIGINT", "DOUBLE", "BOOLEAN"), nil
+}
+
+// jsonPathClickHouse builds typed JSONExtract* calls for ClickHouse.
+// ClickHouse takes path keys as separate arguments and array indexes are 1-based.
+func jsonPathClickHouse(column string, path []string, leafType string) (string, error) {
+	args := []string{column}
+	for _, segment := range path {
+		if isJSONArrayIndex(segment) {
+			index, err := strconv.Atoi(segment)
+			if err != nil {
+				return "", fmt.Errorf("invalid JSON array index '%s': %w", segment, err)
+			}
+			args = append(args, strconv.Itoa(index+1))
+			continue
+		}
+		key, err := dialect.DialectClickHouse.QuoteString(segment)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, key)
+	}
+
+	function := "JSONExtractString"
+	switch {
+	case isJSONFragmentType(leafType):
+		function = "JSONExtractRaw"
+	case leafType == "integer":
+		function = "JSONExtractInt"
+	case leafType == "number":
+		function = "JSONExtractFloat"
+	case leafType == "boolean":
+		function = "JSONExtractBool"
+	}
+	return fmt.Sprintf("%s(%s)", function, strings.Join(args, ", ")), nil
+}
+
+// jsonPathMySQL builds JSON_EXTRACT calls for MySQL JSON columns.
+// MySQL has no BOOLEAN cast target, so boolean leaves are compared with the
+// unquoted JSON text instead.
+func jsonPathMySQL(column string, path []string, leafType string) (string, error) {
+	jsonPath, err := dialect.DialectMySQL.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+
+	extract := fmt.Sprintf("JSON_EXTRACT(%s, %s)", column, jsonPath)
+	if isJSONFragmentType(leafType) {
+		return extract, nil
+	}
+	value := fmt.Sprintf("JSON_UNQUOTE(%s)", extract)
+	if leafType == "boolean" {
+		return fmt.Sprintf("(%s = 'true')", value), nil
+	}
+	return castJSONScalar(value, leafType, "SIGNED", "DOUBLE", ""), nil
+}
+
+// jsonPathSQLite builds json_extract calls for SQLite JSON text columns.
+// json_extract already returns SQL values for scalars (booleans as 1 or 0), so
+// only numeric leaves are cast to pin their storage class.
+func jsonPathSQLite(column string, path []string, leafType string) (string, error) {
+	jsonPath, err := dialect.DialectSQLite.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+
+	value := fmt.Sprintf("json_extract(%s, %s)", column, jsonPath)
+	if isJSONFragmentType(leafType) || leafType == "boolean" {
+		return value, nil
+	}
+	return castJSONScalar(value, leafType, "INTEGER", "REAL", ""), nil
+}
+
+// jsonPathSnowflake builds VARIANT path access for Snowflake semi-structured columns.
+// The path yields a VARIANT, so scalar leaves are cast with :: to a SQL type.
+func jsonPathSnowflake(column string, path []string, leafType string) (string, error) {
+	var b strings.Builder
+	b.WriteString(column)
+	for i, segment := range path {
+		switch {
+		case isJSONArrayIndex(segment):
+			b.WriteString("[" + segment + "]")
+			continue
+		case i == 0:
+			b.WriteString(":")
+		default:
+			b.WriteString(".")
+		}
+		if dialect.IsSafeIdentifier(segment) {
+			b.WriteString(segment)
+		} else {
+			b.WriteString(`"` + strings.ReplaceAll(segment, `"`, `""`) + `"`)
+		}
+	}
+
+	switch {
+	case isJSONFragmentType(leafType):
+		return b.String(), nil
+	case leafType == "integer":
+		return b.String() + "::NUMBER", nil
+	case leafType == "number":
+		return b.String() + "::FLOAT", nil
+	case leafType == "boolean":
+		return b.String() + "::BOOLEAN", nil
+	default:
+		return b.String() + "::STRING", nil
+	}
+}
+
+// jsonPathSQLServer builds JSON_VALUE/JSON_QUERY extraction for SQL Server.
+// Boolean leaves are cast to BIT, which accepts the JSON text 'true' and 'false'.
+func jsonPathSQLServer(column string, path []string, leafType string) (string, error) {
+	jsonPath, err := dialect.DialectSQLServer.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+
+	if isJSONFragmentType(leafType) {
+		return fmt.Sprintf("JSON_QUERY(%s, %s)", column, jsonPath), nil
+	}
+	value := fmt.Sprintf("JSON_VALUE(%s, %s)", column, jsonPath)
+	return castJSONScalar(value, leafType, "BIGINT", "FLOAT", "BIT"), nil
+}
+
+// jsonPathTrino builds json_extract_scalar/json_extract extraction for Trino.
+// Trino's JSONPath has no quoted member form, so keys outside the safe
+// identifier grammar use the bracket form: $["first name"].
+func jsonPathTrino(column string, path []string, leafType string) (string, error) {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, segment := range path {
+		switch {
+		case isJSONArrayIndex(segment):
+			b.WriteString("[" + segment + "]")
+		case dialect.IsSafeIdentifier(segment):
+			b.WriteString("." + segment)
+		default:
+			escaped := strings.ReplaceAll(segment, `\`, `\\`)
+			escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+			b.WriteString(`["` + escaped + `"]`)
+		}
+	}
+	jsonPath, err := dialect.DialectTrino.QuoteString(b.String())
+	if err != nil {
+		return "", err
+	}
+
+	if isJSONFragmentType(leafType) {
+		return fmt.Sprintf("json_extract(%s, %s)", column, jsonPath), nil
+	}
+	value := fmt.Sprintf("json_extract_scalar(%s, %s)", column, jsonPath)
+	return castJSONScalar(value, leafType, "BIGINT", "DOUBLE", "BOOLEAN"), nil
+}
+
+// jsonPathSparkSQL builds get_json_object extraction for Spark SQL, which
+// returns both scalars and JSON fragments as text. Spark's JSONPath has no
+// escapes: keys with a quote use the dot form, which only ends at . or [.
+func jsonPathSparkSQL(column string, path []string, leafType string) (string, error) {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, segment := range path {
+		switch {
+		case isJSONArrayIndex(segment):
+			b.WriteString("[" + segment + "]")
+		case dialect.IsSafeIdentifier(segment):
+			b.WriteString("." + segment)
+		case !strings.Contains(segment, "'"):
+			b.WriteString("['" + segment + "']")
+		case !strings.ContainsAny(segment, ".["):
+			b.WriteString("." + segment)
+		default:
+			return "", fmt.Errorf("JSON key %q cannot be addressed in a Spark SQL JSON path", segment)
+		}
+	}
+	jsonPath, err := dialect.DialectSparkSQL.QuoteString(b.String())
+	if err != nil {
+		return "", err
+	}
+
+	value := fmt.Sprintf("get_json_object(%s, %s)", column, jsonPath)
+	if isJSONFragmentType(leafType) {
+		return value, nil
+	}
+	return castJSONScalar(value, leafType, "BIGINT", "DOUBLE", "BOOLEAN"), nil
+}
+
+// jsonPathOracle builds JSON_VALUE/JSON_QUERY extraction for Oracle.
+// Numbers are returned as NUMBER directly; booleans have no SQL type before
+// 23ai and are mapped to 1 and 0 like boolean columns.
+func jsonPathOracle(column string, path []string, leafType string) (string, error) {
+	jsonPath, err := dialect.DialectOracle.QuoteString(jsonPathExpression(path))
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case isJSONFragmentType(leafType):
+		return fmt.Sprintf("JSON_QUERY(%s, %s)", column, jsonPath), nil
+	case leafType == "integer" || leafType == "number":
+		return fmt.Sprintf("JSON_VALUE(%s, %s RETURNING NUMBER)", column, jsonPath), nil
+	case leafType == "boolean":
+		return fmt.Sprintf("CASE JSON_VALUE(%s, %s) WHEN 'true' THEN 1 WHEN 'false' THEN 0 END", column, jsonPath), nil
+	default:
+		return fmt.Sprintf("JSON_VALUE(%s, %s)", column, jsonPath), nil
+	}
+}
+
+// jsonPathExpression builds a JSONPath such as $.address.city or $.items[0].
+// Keys outside the safe identifier grammar are written in quoted form: $."first name".
+func jsonPathExpression(path []string) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, segment := range path {
+		switch {
+		case isJSONArrayIndex(segment):
+			b.WriteString("[" + segment + "]")
+		case dialect.IsSafeIdentifier(segment):
+			b.WriteString("." + segment)
+		default:
+			escaped := strings.ReplaceAll(segment, `\`, `\\`)
+			escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+			b.WriteString(`."` + escaped + `"`)
+		}
+	}
+	return b.String()
+}
+
+// castJSONScalar wraps an extracted text value in a CAST for numeric and boolean leaf types.
+func castJSONScalar(value, leafType, intType, floatType, boolType string) string {
+	switch leafType {
+	case "integer":
+		return fmt.Sprintf("CAST(%s AS %s)", value, intType)
+	case "number":
+		return fmt.Sprintf("CAST(%s AS %s)", value, floatType)
+	case "boolean":
+		return fmt.Sprintf("CAST(%s AS %s)", value, boolType)
+	default:
+		return value
+	}
+}
+
+// isJSONFragmentType returns true for leaf types that are extracted as JSON rather than text.
+func isJSONFragmentType(leafType string) bool {
+	return leafType == "array" || leafType == "object" || leafType == "json"
+}
+
+// isJSONArrayIndex returns true if a path segment is a non-negative array index.
+func isJSONArrayIndex(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for i := 0; i < len(segment); i++ {
+		if segment[i] < '0' || segment[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+func TestDataOperator_JSONPathExtraction(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"attrs":              "json",
+			"attrs.address.city": "string",
+			"attrs.age":          "integer",
+			"attrs.score":        "number",
+			"attrs.active":       "boolean",
+			"attrs.tags":         "array",
+			"attrs.meta.raw":     "json",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		varName  string
+		expected string
+	}{
+		{"BigQuery string", dialect.DialectBigQuery, "attrs.address.city", "JSON_VALUE(attrs, '$.address.city')"},
+		{"BigQuery integer", dialect.DialectBigQuery, "attrs.age", "CAST(JSON_VALUE(attrs, '$.age') AS INT64)"},
+		{"BigQuery array", dialect.DialectBigQuery, "attrs.tags", "JSON_QUERY(attrs, '$.tags')"},
+		{"BigQuery undeclared leaf", dialect.DialectBigQuery, "attrs.x.y", "JSON_VALUE(attrs, '$.x.y')"},
+		{"BigQuery array index", dialect.DialectBigQuery, "attrs.items.0.sku", "JSON_VALUE(attrs, '$.items[0].sku')"},
+		{"BigQuery quoted key", dialect.DialectBigQuery, "attrs.first name", `JSON_VALUE(attrs, '$."first name"')`},
+		{"Spanner number", dialect.DialectSpanner, "attrs.score", "CAST(JSON_VALUE(attrs, '$.score') AS FLOAT64)"},
+		{"Spanner boolean", dialect.DialectSpanner, "attrs.active", "CAST(JSON_VALUE(attrs, '$.active') AS BOOL)"},
+		{"PostgreSQL string", dialect.DialectPostgreSQL, "attrs.address.city", "attrs->'address'->>'city'"},
+		{"PostgreSQL integer", dialect.DialectPostgreSQL, "attrs.age", "CAST(attrs->>'age' AS BIGINT)"},
+		{"PostgreSQL array", dialect.DialectPostgreSQL, "attrs.tags", "attrs->'tags'"},
+		{"PostgreSQL array index", dialect.DialectPostgreSQL, "attrs.items.0", "attrs->'items'->>0"},
+		{"PostgreSQL key with quote", dialect.DialectPostgreSQL, "attrs.o'key", "attrs->>'o''key'"},
+		{"DuckDB string", dialect.DialectDuckDB, "attrs.address.city", "json_extract_string(attrs, '$.address.city')"},
+		{"DuckDB number", dialect.DialectDuckDB, "attrs.score", "CAST(json_extract_string(attrs, '$.score') AS DOUBLE)"},
+		{"DuckDB nested json", dialect.DialectDuckDB, "attrs.meta.raw", "json_extract(attrs, '$.meta.raw')"},
+		{"ClickHouse string", dialect.DialectClickHouse, "attrs.address.city", "JSONExtractString(attrs, 'address', 'city')"},
+		{"ClickHouse integer", dialect.DialectClickHouse, "attrs.age", "JSONExtractInt(attrs, 'age')"},
+		{"ClickHouse boolean", dialect.DialectClickHouse, "attrs.active", "JSONExtractBool(attrs, 'active')"},
+		{"ClickHouse array index", dialect.DialectClickHouse, "attrs.items.0", "JSONExtractString(attrs, 'items', 1)"},
+		{"ClickHouse array", dialect.DialectClickHouse, "attrs.tags", "JSONExtractRaw(attrs, 'tags')"},
+		{"MySQL string", dialect.DialectMySQL, "attrs.address.city", "JSON_UNQUOTE(JSON_EXTRACT(attrs, '$.address.city'))"},
+		{"MySQL integer", dialect.DialectMySQL, "attrs.age", "CAST(JSON_UNQUOTE(JSON_EXTRACT(attrs, '$.age')) AS SIGNED)"},
+		{"MySQL number", dialect.DialectMySQL, "attrs.score", "CAST(JSON_UNQUOTE(JSON_EXTRACT(attrs, '$.score')) AS DOUBLE)"},
+		{"MySQL boolean", dialect.DialectMySQL, "attrs.active", "(JSON_UNQUOTE(JSON_EXTRACT(attrs, '$.active')) = 'true')"},
+		{"MySQL array", dialect.DialectMySQL, "attrs.tags", "JSON_EXTRACT(attrs, '$.tags')"},
+		{"MySQL array index", dialect.DialectMySQL, "attrs.items.0.sku", "JSON_UNQUOTE(JSON_EXTRACT(attrs, '$.items[0].sku'))"},
+		{"SQLite string", dialect.DialectSQLite, "attrs.address.city", "json_extract(attrs, '$.address.city')"},
+		{"SQLite integer", dialect.DialectSQLite, "attrs.age", "CAST(json_extract(attrs, '$.age') AS INTEGER)"},
+		{"SQLite number", dialect.DialectSQLite, "attrs.score", "CAST(json_extract(attrs, '$.score') AS REAL)"},
+		{"SQLite boolean", dialect.DialectSQLite, "attrs.active", "json_extract(attrs, '$.active')"},
+		{"SQLite array", dialect.DialectSQLite, "attrs.tags", "json_extract(attrs, '$.tags')"},
+		{"Snowflake string", dialect.DialectSnowflake, "attrs.address.city", "attrs:address.city::STRING"},
+		{"Snowflake integer", dialect.DialectSnowflake, "attrs.age", "attrs:age::NUMBER"},
+		{"Snowflake number", dialect.DialectSnowflake, "attrs.score", "attrs:score::FLOAT"},
+		{"Snowflake boolean", dialect.DialectSnowflake, "attrs.active", "attrs:active::BOOLEAN"},
+		{"Snowflake array", dialect.DialectSnowflake, "attrs.tags", "attrs:tags"},
+		{"Snowflake array index", dialect.DialectSnowflake, "attrs.items.0.sku", "attrs:items[0].sku::STRING"},
+		{"Snowflake quoted key", dialect.DialectSnowflake, `attrs.first "name"`, `attrs:"first ""name"""::STRING`},
+		{"SQLServer string", dialect.DialectSQLServer, "attrs.address.city", "JSON_VALUE(attrs, '$.address.city')"},
+		{"SQLServer integer", dialect.DialectSQLServer, "attrs.age", "CAST(JSON_VALUE(attrs, '$.age') AS BIGINT)"},
+		{"SQLServer number", dialect.DialectSQLServer, "attrs.score", "CAST(JSON_VALUE(attrs, '$.score') AS FLOAT)"},
+		{"SQLServer boolean", dialect.DialectSQLServer, "attrs.active", "CAST(JSON_VALUE(attrs, '$.active') AS BIT)"},
+		{"SQLServer array", dialect.DialectSQLServer, "attrs.tags", "JSON_QUERY(attrs, '$.tags')"},
+		{"SQLServer array index", dialect.DialectSQLServer, "attrs.items.0.sku", "JSON_VALUE(attrs, '$.items[0].sku')"},
+		{"Trino string", dialect.DialectTrino, "attrs.address.city", "json_extract_scalar(attrs, '$.address.city')"},
+		{"Trino integer", dialect.DialectTrino, "attrs.age", "CAST(json_extract_scalar(attrs, '$.age') AS BIGINT)"},
+		{"Trino number", dialect.DialectTrino, "attrs.score", "CAST(json_extract_scalar(attrs, '$.score') AS DOUBLE)"},
+		{"Trino boolean", dialect.DialectTrino, "attrs.active", "CAST(json_extract_scalar(attrs, '$.active') AS BOOLEAN)"},
+		{"Trino array", dialect.DialectTrino, "attrs.tags", "json_extract(attrs, '$.tags')"},
+		{"Trino array index", dialect.DialectTrino, "attrs.items.0.sku", "json_extract_scalar(attrs, '$.items[0].sku')"},
+		{"Trino quoted key", dialect.DialectTrino, `attrs.first "name"`, `json_extract_scalar(attrs, '$["first \"name\""]')`},
+		{"Oracle string", dialect.DialectOracle, "attrs.address.city", "JSON_VALUE(attrs, '$.address.city')"},
+		{"Oracle integer", dialect.DialectOracle, "attrs.age", "JSON_VALUE(attrs, '$.age' RETURNING NUMBER)"},
+		{"Oracle number", dialect.DialectOracle, "attrs.score", "JSON_VALUE(attrs, '$.score' RETURNING NUMBER)"},
+		{"Oracle boolean", dialect.DialectOracle, "attrs.active", "CASE JSON_VALUE(attrs, '$.active') WHEN 'true' THEN 1 WHEN 'false' THEN 0 END"},
+		{"Oracle array", dialect.DialectOracle, "attrs.tags", "JSON_QUERY(attrs, '$.tags')"},
+		{"Oracle array index", dialect.DialectOracle, "attrs.items.0.sku", "JSON_VALUE(attrs, '$.items[0].sku')"},
+		{"SparkSQL string", dialect.DialectSparkSQL, "attrs.address.city", "get_json_object(attrs, '$.address.city')"},
+		{"SparkSQL integer", dialect.DialectSparkSQL, "attrs.age", "CAST(get_json_object(attrs, '$.age') AS BIGINT)"},
+		{"SparkSQL number", dialect.DialectSparkSQL, "attrs.score", "CAST(get_json_object(attrs, '$.score') AS DOUBLE)"},
+		{"SparkSQL boolean", dialect.DialectSparkSQL, "attrs.active", "CAST(get_json_object(attrs, '$.active') AS BOOLEAN)"},
+		{"SparkSQL array", dialect.DialectSparkSQL, "attrs.tags", "get_json_object(attrs, '$.tags')"},
+		{"SparkSQL array index", dialect.DialectSparkSQL, "attrs.items.0.sku", "get_json_object(attrs, '$.items[0].sku')"},
+		{"SparkSQL bracketed key", dialect.DialectSparkSQL, "attrs.first name", "get_json_object(attrs, '$[\\'first name\\']')"},
+		{"SparkSQL key with quote", dialect.DialectSparkSQL, "attrs.o'clock", "get_json_object(attrs, '$.o\\'clock')"},
+		{"root column itself", dialect.DialectPostgreSQL, "attrs", "attrs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := NewDataOperator(NewOperatorConfig(tt.dialect, schema))
+			result, err := op.ToSQL("var", []interface{}{tt.varName})
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDataOperator_JSONPathExtraction_Errors(t *testing.T) {
+	schema := &truthinessSchemaProvider{fields: map[string]string{"attrs": "json"}}
+
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		varName string
+	}{
+		{"empty path segment", dialect.DialectBigQuery, "attrs..city"},
+		{"trailing dot", dialect.DialectPostgreSQL, "attrs."},
+		{"unspecified dialect", dialect.DialectUnspecified, "attrs.city"},
+		{"SparkSQL key with quote and bracket", dialect.DialectSparkSQL, "attrs.a'[b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := NewDataOperator(NewOperatorConfig(tt.dialect, schema))
+			if result, err := op.ToSQL("var", []interface{}{tt.varName}); err == nil {
+				t.Errorf("ToSQL() expected error, got %s", result)
+			}
+		})
+	}
+}
@@ -47,7 +47,7 @@ func (l *LogicalOperator) handleAnd(args []interface{}) (string, error) {
 
 	var conditions []string
 	for i, arg := range args {
-		condition, err := l.expressionToSQL(arg)
+		condition, err := l.conditionToSQL(arg)
 		if err != nil {
 			return "", fmt.Errorf("invalid and argument %d: %w", i, err)
 		}
@@ -69,7 +69,7 @@ func (l *LogicalOperator) handleOr(args []interface{}) (string, error) {
 
 	var conditions []string
 	for i, arg := range args {
-		condition, err := l.expressionToSQL(arg)
+		condition, err := l.conditionToSQL(arg)
 		if err != nil {
 			return "", fmt.Errorf("invalid or argument %d: %w", i, err)
 		}
@@ -89,7 +89,7 @@ func (l *LogicalOperator) handleNot(args []interface{}) (string, error) {
 		return "", fmt.Errorf("! operator requires exactly 1 argument")
 	}
 
-	condition, err := l.expressionToSQL(args[0])
+	condition, err := l.conditionToSQL(args[0])
 	if err != nil {
 		return "", fmt.Errorf("invalid ! argument: %w", err)
 	}
@@ -108,20 +108,23 @@ func (l *LogicalOperator) handleDoubleNot(args []interface{}) (string, error) {
 		// For arrays, check if they are non-empty
 		// This would typically use CARDINALITY or ARRAY_LENGTH depending on the SQL dialect
 		// Using a generic approach that works with most databases
-		if len(arr) == 0 {
-			return "FALSE", nil
-		}
-		return "TRUE", nil
+		return l.config.BoolPredicate(len(arr) > 0), nil
 	}
 
 	// Try to extract field name for schema-aware type checking
-	fieldName := l.extractVarFieldName(args[0])
+	fieldName := varFieldName(args[0])
 
 	condition, err := l.expressionToSQL(args[0])
 	if err != nil {
 		return "", fmt.Errorf("invalid !! argument: %w", err)
 	}
 
+	// SQL Server and Oracle have no boolean values, so a predicate cannot be
+	// compared as an operand; it already is the condition.
+	if l.config.HasNumericBooleans() && isPredicateExpression(args[0]) {
+		return condition, nil
+	}
+
 	// If we have a schema and a field name, generate type-appropriate SQL
 	if fieldName != "" && l.config != nil && l.config.Schema != nil {
 		return l.generateTypeSafeTruthiness(condition, fieldName)
@@ -129,40 +132,28 @@ func (l *LogicalOperator) handleDoubleNot(args []interface{}) (string, error) {
 
 	// Fallback: generic truthiness check for non-null/truthy values
 	// This checks for non-null, non-false, non-zero, non-empty string
-	return fmt.Sprintf("(%s IS NOT NULL AND %s != FALSE AND %s != 0 AND %s != '')",
-		condition, condition, condition, condition), nil
+	return l.config.Truthiness(condition), nil
 }
 
-// extractVarFieldName extracts the field name from a var expression.
-// Returns empty string if the argument is not a simple var expression.
-func (l *LogicalOperator) extractVarFieldName(arg interface{}) string {
-	obj, ok := arg.(map[string]interface{})
-	if !ok {
-		return ""
-	}
-
-	if len(obj) != 1 {
-		return ""
-	}
-
-	varArgs, ok := obj["var"]
-	if !ok {
-		return ""
-	}
-
-	// Handle {"var": "fieldName"} format
-	if fieldName, ok := varArgs.(string); ok {
-		return fieldName
+// conditionToSQL converts an expression used as a condition to SQL.
+// SQL Server and Oracle only accept predicates there, so a var reference,
+// boolean literal or if expression is compared with 1.
+func (l *LogicalOperator) conditionToSQL(arg interface{}) (string, error) {
+	condition, err := l.expressionToSQL(arg)
+	if err != nil {
+		return "", err
 	}
+	return l.config.Condition(arg, condition), nil
+}
 
-	// Handle {"var": ["fieldName", defaultValue]} format
-	if arr, ok := varArgs.([]interface{}); ok && len(arr) > 0 {
-		if fieldName, ok := arr[0].(string); ok {
-			return fieldName
-		}
+// valueToSQL converts an expression used as a value, such as an if branch, to SQL.
+// SQL Server and Oracle have no boolean values, so a predicate becomes 1 or 0.
+func (l *LogicalOperator) valueToSQL(arg interface{}) (string, error) {
+	value, err := l.expressionToSQL(arg)
+	if err != nil {
+		return "", err
 	}
-
-	return ""
+	return l.config.PredicateValue(arg, value), nil
 }
 
 // generateTypeSafeTruthiness generates type-appropriate SQL for truthiness check.
@@ -174,11 +165,11 @@ func (l *LogicalOperator) generateTypeSafeTruthiness(condition, fieldName string
 	case schema.IsBooleanType(fieldName):
 		// For boolean fields: field IS TRUE
 		// This is the cleanest check for boolean truthiness
-		return fmt.Sprintf("%s IS TRUE", condition), nil
+		return l.config.Spec().IsTrue(condition), nil
 
 	case schema.IsStringType(fieldName):
 		// For string fields: field IS NOT NULL AND field != ''
-		return fmt.Sprintf("(%s IS NOT NULL AND %s != '')", condition, condition), nil
+		return l.config.Spec().StringNotEmpty(condition), nil
 
 	case schema.IsNumericType(fieldName):
 		// For numeric fields (integer/number): field IS NOT NULL AND field != 0
@@ -186,21 +177,20 @@ func (l *LogicalOperator) generateTypeSafeTruthiness(condition, fieldName string
 
 	case schema.IsArrayType(fieldName):
 		// For array fields: check non-null and non-empty
-		// Use CARDINALITY which is supported by BigQuery, Spanner, PostgreSQL, DuckDB
-		// For ClickHouse, use length()
-		if l.config != nil && l.config.GetDialect().String() == "ClickHouse" {
-			return fmt.Sprintf("(%s IS NOT NULL AND length(%s) > 0)", condition, condition), nil
+		notEmpty, err := l.config.Spec().ArrayNotEmpty(condition)
+		if err != nil {
+			return "", err
 		}
-		return fmt.Sprintf("(%s IS NOT NULL AND CARDINALITY(%s) > 0)", condition, condition), nil
+		return fmt.Sprintf("(%s IS NOT NULL AND %s)", condition, notEmpty), nil
 
 	default:
 		// Unknown type or field not in schema: use generic check
-		return fmt.Sprintf("(%s IS NOT NULL AND %s != FALSE AND %s != 0 AND %s != '')",
-			condition, condition, condition, condition), nil
+		return l.config.Truthiness(condition), nil
 	}
 }
 
 // handleIf converts if operator to SQL.
+// A single condition is rendered with the Spec's Conditional; chains always use CASE.
 func (l *LogicalOperator) handleIf(args []interface{}) (string, error) {
 	if len(args) < 2 {
 		return "", fmt.Errorf("if requires at least 2 arguments")
@@ -213,12 +203,12 @@ func (l *LogicalOperator) handleIf(args []interface{}) (string, error) {
 
 		// Process condition/value pairs
 		for i := 0; i < len(args)-1; i += 2 {
-			condition, err := l.expressionToSQL(args[i])
+			condition, err := l.conditionToSQL(args[i])
 			if err != nil {
 				return "", fmt.Errorf("invalid if condition %d: %w", i/2, err)
 			}
 
-			value, err := l.expressionToSQL(args[i+1])
+			value, err := l.valueToSQL(args[i+1])
 			if err != nil {
 				return "", fmt.Errorf("invalid if value %d: %w", i/2, err)
 			}
@@ -227,7 +217,7 @@ func (l *LogicalOperator) handleIf(args []interface{}) (string, error) {
 		}
 
 		// Handle final else value
-		elseValue, err := l.expressionToSQL(args[len(args)-1])
+		elseValue, err := l.valueToSQL(args[len(args)-1])
 		if err != nil {
 			return "", fmt.Errorf("invalid if else value: %w", err)
 		}
@@ -237,28 +227,28 @@ func (l *LogicalOperator) handleIf(args []interface{}) (string, error) {
 
 	// Handle simple IF (2-3 arguments)
 	// Convert condition
-	condition, err := l.expressionToSQL(args[0])
+	condition, err := l.conditionToSQL(args[0])
 	if err != nil {
 		return "", fmt.Errorf("invalid if condition: %w", err)
 	}
 
 	// Convert then value
-	thenValue, err := l.expressionToSQL(args[1])
+	thenValue, err := l.valueToSQL(args[1])
 	if err != nil {
 		return "", fmt.Errorf("invalid if then value: %w", err)
 	}
 
 	// Handle else value (optional)
 	if len(args) == 3 {
-		elseValue, err := l.expressionToSQL(args[2])
+		elseValue, err := l.valueToSQL(args[2])
 		if err != nil {
 			return "", fmt.Errorf("invalid if else value: %w", err)
 		}
-		return fmt.Sprintf("CASE WHEN %s THEN %s ELSE %s END", condition, thenValue, elseValue), nil
+		return l.config.Spec().Conditional(condition, thenValue, elseValue), nil
 	}
 
 	// No else value - use NULL
-	return fmt.Sprintf("CASE WHEN %s THEN %s ELSE NULL END", condition, thenValue), nil
+	return l.config.Spec().Conditional(condition, thenValue, "NULL"), nil
 }
 
 // expressionToSQL converts any expression to SQL.
@@ -366,7 +356,7 @@ func (l *LogicalOperator) processArgs(args []interface{}) ([]interface{}, error)
 				for operator := range exprMap {
 					if operator != "var" {
 						// It's a complex expression, convert it to SQL
-						sql, err := l.expressionToSQL(arg)
+						sql, err := l.valueToSQL(arg)
 						if err != nil {
 							return nil, fmt.Errorf("invalid argument %d: %w", i, err)
 						}
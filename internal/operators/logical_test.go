@@ -2,6 +2,8 @@ package operators
 
 import (
 	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
 )
 
 func TestLogicalOperator_ToSQL(t *testing.T) {
@@ -508,6 +510,10 @@ func (m *truthinessSchemaProvider) ValidateEnumValue(_, _ string) error {
 	return nil
 }
 
+func (m *truthinessSchemaProvider) GetColumnMapping(_ string) (ColumnMapping, bool) {
+	return ColumnMapping{}, false
+}
+
 func TestLogicalOperator_SchemaAwareTruthiness(t *testing.T) {
 	schema := &truthinessSchemaProvider{
 		fields: map[string]string{
@@ -666,62 +672,377 @@ func TestLogicalOperator_ClickHouseArrayTruthiness(t *testing.T) {
 	}
 }
 
-func TestLogicalOperator_extractVarFieldName(t *testing.T) {
-	op := NewLogicalOperator(nil)
+func TestLogicalOperator_MySQLArrayTruthiness(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags": "array",
+		},
+	}
+
+	config := NewOperatorConfig(dialect.DialectMySQL, schema)
+	op := NewLogicalOperator(config)
+
+	result, err := op.handleDoubleNot([]interface{}{map[string]interface{}{"var": "tags"}})
+	if err != nil {
+		t.Errorf("handleDoubleNot() unexpected error = %v", err)
+	}
+
+	expected := "(tags IS NOT NULL AND JSON_LENGTH(tags) > 0)"
+	if result != expected {
+		t.Errorf("handleDoubleNot() = %v, want %v", result, expected)
+	}
+}
+
+func TestLogicalOperator_SQLiteArrayTruthiness(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags": "array",
+		},
+	}
+
+	config := NewOperatorConfig(dialect.DialectSQLite, schema)
+	op := NewLogicalOperator(config)
+
+	result, err := op.handleDoubleNot([]interface{}{map[string]interface{}{"var": "tags"}})
+	if err != nil {
+		t.Errorf("handleDoubleNot() unexpected error = %v", err)
+	}
+
+	expected := "(tags IS NOT NULL AND json_array_length(tags) > 0)"
+	if result != expected {
+		t.Errorf("handleDoubleNot() = %v, want %v", result, expected)
+	}
+}
+
+func TestLogicalOperator_Snowflake(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags":   "array",
+			"active": "boolean",
+		},
+	}
+
+	config := NewOperatorConfig(dialect.DialectSnowflake, schema)
+	op := NewLogicalOperator(config)
 
 	tests := []struct {
 		name     string
-		arg      interface{}
+		operator string
+		args     []interface{}
 		expected string
 	}{
 		{
-			name:     "simple var string",
-			arg:      map[string]interface{}{"var": "fieldName"},
-			expected: "fieldName",
+			name:     "array truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "tags"}},
+			expected: "(tags IS NOT NULL AND ARRAY_SIZE(tags) > 0)",
 		},
 		{
-			name:     "var with array - field name and default",
-			arg:      map[string]interface{}{"var": []interface{}{"fieldName", "default"}},
-			expected: "fieldName",
+			name:     "boolean truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "active"}},
+			expected: "COALESCE(active, FALSE)",
 		},
 		{
-			name:     "nested field name",
-			arg:      map[string]interface{}{"var": "user.profile.name"},
-			expected: "user.profile.name",
+			name:     "if-then-else",
+			operator: "if",
+			args:     []interface{}{map[string]interface{}{"var": "active"}, "yes", "no"},
+			expected: "IFF(active, 'yes', 'no')",
 		},
 		{
-			name:     "not a var expression",
-			arg:      map[string]interface{}{">": []interface{}{1, 2}},
-			expected: "",
+			name:     "if-then",
+			operator: "if",
+			args:     []interface{}{map[string]interface{}{"var": "active"}, "yes"},
+			expected: "IFF(active, 'yes', NULL)",
 		},
 		{
-			name:     "multiple keys - not valid",
-			arg:      map[string]interface{}{"var": "field", "other": "value"},
-			expected: "",
+			name:     "if chain keeps CASE",
+			operator: "if",
+			args:     []interface{}{map[string]interface{}{"var": "a"}, 1, map[string]interface{}{"var": "b"}, 2, 3},
+			expected: "CASE WHEN a THEN 1 WHEN b THEN 2 ELSE 3 END",
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLogicalOperator_SQLServer(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags":   "array",
+			"active": "boolean",
+		},
+	}
+
+	config := NewOperatorConfig(dialect.DialectSQLServer, schema)
+	op := NewLogicalOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+	}{
 		{
-			name:     "primitive value",
-			arg:      42,
-			expected: "",
+			name:     "array truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "tags"}},
+			expected: "(tags IS NOT NULL AND (SELECT COUNT(*) FROM OPENJSON(tags)) > 0)",
 		},
 		{
-			name:     "empty array for var",
-			arg:      map[string]interface{}{"var": []interface{}{}},
-			expected: "",
+			name:     "boolean truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "active"}},
+			expected: "active = 1",
 		},
 		{
-			name:     "array with non-string first element",
-			arg:      map[string]interface{}{"var": []interface{}{123, "default"}},
-			expected: "",
+			name:     "generic truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "score"}},
+			expected: "(score IS NOT NULL AND score != 0 AND score != '')",
+		},
+		{
+			name:     "literal array truthiness",
+			operator: "!!",
+			args:     []interface{}{[]interface{}{1}},
+			expected: "1 = 1",
+		},
+		{
+			name:     "predicate truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{">": []interface{}{map[string]interface{}{"var": "a"}, 1}}},
+			expected: "a > 1",
+		},
+		{
+			name:     "not var",
+			operator: "!",
+			args:     []interface{}{map[string]interface{}{"var": "active"}},
+			expected: "NOT (active = 1)",
+		},
+		{
+			name:     "not predicate",
+			operator: "!",
+			args:     []interface{}{map[string]interface{}{">": []interface{}{map[string]interface{}{"var": "score"}, 1}}},
+			expected: "NOT (score > 1)",
+		},
+		{
+			name:     "if-then-else",
+			operator: "if",
+			args:     []interface{}{map[string]interface{}{"var": "active"}, "yes", "no"},
+			expected: "CASE WHEN active = 1 THEN 'yes' ELSE 'no' END",
+		},
+		{
+			name:     "if chain",
+			operator: "if",
+			args:     []interface{}{map[string]interface{}{"var": "a"}, true, map[string]interface{}{"var": "b"}, 2, 3},
+			expected: "CASE WHEN a = 1 THEN 1 WHEN b = 1 THEN 2 ELSE 3 END",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLogicalOperator_Trino(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags":   "array",
+			"active": "boolean",
+		},
+	}
+
+	config := NewOperatorConfig(dialect.DialectTrino, schema)
+	op := NewLogicalOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+	}{
+		{
+			name:     "array truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "tags"}},
+			expected: "(tags IS NOT NULL AND CARDINALITY(tags) > 0)",
+		},
+		{
+			name:     "boolean truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "active"}},
+			expected: "COALESCE(active, FALSE)",
+		},
+		{
+			name:     "if-then-else",
+			operator: "if",
+			args:     []interface{}{map[string]interface{}{"var": "active"}, "yes", "no"},
+			expected: "CASE WHEN active THEN 'yes' ELSE 'no' END",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := op.extractVarFieldName(tt.arg)
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
 			if result != tt.expected {
-				t.Errorf("extractVarFieldName() = %v, want %v", result, tt.expected)
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
 			}
 		})
 	}
 }
+
+func TestLogicalOperator_Oracle(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags":   "array",
+			"active": "boolean",
+			"name":   "string",
+		},
+	}
+
+	config := NewOperatorConfig(dialect.DialectOracle, schema)
+	op := NewLogicalOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+	}{
+		{
+			name:     "array truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "tags"}},
+			expected: "(tags IS NOT NULL AND JSON_EXISTS(tags, '$[0]'))",
+		},
+		{
+			name:     "boolean truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "active"}},
+			expected: "active = 1",
+		},
+		{
+			name:     "string truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "name"}},
+			expected: "name IS NOT NULL",
+		},
+		{
+			name:     "generic truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "score"}},
+			expected: "(score IS NOT NULL AND score != 0)",
+		},
+		{
+			name:     "predicate truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{">": []interface{}{map[string]interface{}{"var": "a"}, 1}}},
+			expected: "a > 1",
+		},
+		{
+			name:     "compound predicate truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"and": []interface{}{map[string]interface{}{"var": "active"}, map[string]interface{}{"<": []interface{}{map[string]interface{}{"var": "b"}, 2}}}}},
+			expected: "(active = 1 AND b < 2)",
+		},
+		{
+			name:     "literal array truthiness",
+			operator: "!!",
+			args:     []interface{}{[]interface{}{1}},
+			expected: "1 = 1",
+		},
+		{
+			name:     "if-then-else",
+			operator: "if",
+			args:     []interface{}{map[string]interface{}{"var": "active"}, "yes", "no"},
+			expected: "CASE WHEN active = 1 THEN 'yes' ELSE 'no' END",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLogicalOperator_SparkSQL(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"tags":   "array",
+			"active": "boolean",
+		},
+	}
+
+	config := NewOperatorConfig(dialect.DialectSparkSQL, schema)
+	op := NewLogicalOperator(config)
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+	}{
+		{
+			name:     "array truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "tags"}},
+			expected: "(tags IS NOT NULL AND CARDINALITY(tags) > 0)",
+		},
+		{
+			name:     "boolean truthiness",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "active"}},
+			expected: "active IS TRUE",
+		},
+		{
+			name:     "reserved field",
+			operator: "!!",
+			args:     []interface{}{map[string]interface{}{"var": "user"}},
+			expected: "(`user` IS NOT NULL AND `user` != FALSE AND `user` != 0 AND `user` != '')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := op.ToSQL(tt.operator, tt.args)
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSQL() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func (m *truthinessSchemaProvider) IsJSONType(fieldName string) bool {
+	return m.fields[fieldName] == "json"
+}
@@ -35,7 +35,7 @@ func (n *NumericOperator) validateNumericOperand(value interface{}) error {
 		return nil // No schema, no validation
 	}
 
-	fieldName := n.extractFieldNameFromValue(value)
+	fieldName := varFieldName(value)
 	if fieldName == "" {
 		return nil // Can't determine field name, skip validation
 	}
@@ -46,35 +46,12 @@ func (n *NumericOperator) validateNumericOperand(value interface{}) error {
 	}
 
 	if !n.schema().IsNumericType(fieldName) {
-		return fmt.Errorf("numeric operation on non-numeric field '%s' (type: %s)", fieldName, fieldType)
+		return fmt.Errorf("numeric operation on non-numeric field '%s' (type: %s)", fieldLabel(n.schema(), fieldName), fieldType)
 	}
 
 	return nil
 }
 
-// extractFieldNameFromValue extracts field name from a value that might be a var expression.
-func (n *NumericOperator) extractFieldNameFromValue(value interface{}) string {
-	if varExpr, ok := value.(map[string]interface{}); ok {
-		if varName, hasVar := varExpr[OpVar]; hasVar {
-			return n.extractFieldName(varName)
-		}
-	}
-	return ""
-}
-
-// extractFieldName extracts the field name from a var argument.
-func (n *NumericOperator) extractFieldName(varName interface{}) string {
-	if nameStr, ok := varName.(string); ok {
-		return nameStr
-	}
-	if nameArr, ok := varName.([]interface{}); ok && len(nameArr) > 0 {
-		if nameStr, ok := nameArr[0].(string); ok {
-			return nameStr
-		}
-	}
-	return ""
-}
-
 // ToSQL converts a numeric operation to SQL.
 func (n *NumericOperator) ToSQL(operator string, args []interface{}) (string, error) {
 	if len(args) == 0 {
@@ -120,7 +97,7 @@ func (n *NumericOperator) handleAddition(args []interface{}) (string, error) {
 		if err != nil {
 			return "", fmt.Errorf("invalid unary plus argument: %w", err)
 		}
-		return fmt.Sprintf("CAST(%s AS NUMERIC)", operand), nil
+		return n.config.CastToNumber(operand), nil
 	}
 
 	operands := make([]string, len(args))
@@ -242,7 +219,7 @@ func (n *NumericOperator) handleModulo(args []interface{}) (string, error) {
 		return "", fmt.Errorf("invalid modulo right argument: %w", err)
 	}
 
-	return fmt.Sprintf("(%s %% %s)", left, right), nil
+	return n.config.Modulo([]string{left, right}), nil
 }
 
 // handleMax converts max operator to SQL.
@@ -267,7 +244,7 @@ func (n *NumericOperator) handleMax(args []interface{}) (string, error) {
 		operands[i] = operand
 	}
 
-	return fmt.Sprintf("GREATEST(%s)", strings.Join(operands, ", ")), nil
+	return n.config.Greatest(operands), nil
 }
 
 // handleMin converts min operator to SQL.
@@ -292,7 +269,7 @@ func (n *NumericOperator) handleMin(args []interface{}) (string, error) {
 		operands[i] = operand
 	}
 
-	return fmt.Sprintf("LEAST(%s)", strings.Join(operands, ", ")), nil
+	return n.config.Least(operands), nil
 }
 
 // valueToSQL converts a value to SQL, handling var expressions and literals.
@@ -306,12 +283,6 @@ func (n *NumericOperator) valueToSQL(value interface{}) (string, error) {
 		return n.dataOp.valueToSQL(pv.Value)
 	}
 
-	// Handle pre-processed SQL strings from the parser
-	if sqlStr, ok := value.(string); ok {
-		// This is a pre-processed SQL string from the parser
-		return sqlStr, nil
-	}
-
 	// Handle var expressions and complex expressions
 	if expr, ok := value.(map[string]interface{}); ok {
 		if varExpr, hasVar := expr[OpVar]; hasVar {
@@ -391,7 +362,7 @@ func (n *NumericOperator) processComplexArgsForComparison(args []interface{}) ([
 		if err != nil {
 			return nil, err
 		}
-		processed[i] = sql
+		processed[i] = SQLResult(sql)
 	}
 
 	return processed, nil
@@ -428,17 +399,17 @@ func (n *NumericOperator) generateComplexSQL(operator string, args []string) (st
 		if len(args) < 2 {
 			return "", fmt.Errorf("modulo requires at least 2 arguments")
 		}
-		return fmt.Sprintf("(%s)", strings.Join(args, " % ")), nil
+		return n.config.Modulo(args), nil
 	case "max":
 		if len(args) < 2 {
 			return "", fmt.Errorf("max requires at least 2 arguments")
 		}
-		return fmt.Sprintf("GREATEST(%s)", strings.Join(args, ", ")), nil
+		return n.config.Greatest(args), nil
 	case "min":
 		if len(args) < 2 {
 			return "", fmt.Errorf("min requires at least 2 arguments")
 		}
-		return fmt.Sprintf("LEAST(%s)", strings.Join(args, ", ")), nil
+		return n.config.Least(args), nil
 	default:
 		// For other operators (array, logical, etc.), they should have been pre-processed
 		// If we see them here, it means they weren't processed correctly
@@ -0,0 +1,134 @@
+package operators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// ParamCollector accumulates bind arguments for parameterized SQL output.
+// When a collector is set on the OperatorConfig, literal values are replaced
+// by dialect-specific placeholders and recorded here instead of being inlined.
+type ParamCollector struct {
+	dialect dialect.Dialect
+	args    []any
+}
+
+// NewParamCollector creates a new empty collector for the given dialect.
+func NewParamCollector(d dialect.Dialect) *ParamCollector {
+	return &ParamCollector{dialect: d}
+}
+
+// Add records a bind argument and returns the placeholder that references it.
+// Placeholders are 1-based: the first argument is $1 / @p1 / {p1:Type} / ?1 / :1.
+// Positional dialects get an internal marker instead, which Bind turns into ?.
+func (p *ParamCollector) Add(value any) string {
+	p.args = append(p.args, value)
+	return p.placeholder(len(p.args), value)
+}
+
+// Args returns the collected bind arguments in placeholder order.
+func (p *ParamCollector) Args() []any {
+	args := make([]any, len(p.args))
+	copy(args, p.args)
+	return args
+}
+
+// Len returns the number of collected bind arguments.
+func (p *ParamCollector) Len() int {
+	return len(p.args)
+}
+
+// Bind finalizes sql generated with this collector and returns it with its
+// bind arguments in placeholder order.
+//
+// Numbered placeholders are returned unchanged. Positional ? placeholders are
+// bound by their order in the final SQL, which can differ from the order in
+// which operators rendered them, and a fragment repeated in the output (such as
+// the middle operand of a chained comparison) needs its argument repeated too.
+// Markers are therefore resolved here by scanning the finished SQL.
+func (p *ParamCollector) Bind(sql string) (string, []any) {
+	if !p.positional() {
+		return sql, p.Args()
+	}
+
+	var b strings.Builder
+	args := make([]any, 0, len(p.args))
+	for {
+		start := strings.IndexByte(sql, positionalMarker)
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(sql[start+1:], positionalMarker)
+		if end < 0 {
+			break
+		}
+		n, err := strconv.Atoi(sql[start+1 : start+1+end])
+		if err != nil || n < 1 || n > len(p.args) {
+			break
+		}
+		b.WriteString(sql[:start])
+		b.WriteByte('?')
+		args = append(args, p.args[n-1])
+		sql = sql[start+end+2:]
+	}
+	b.WriteString(sql)
+	return b.String(), args
+}
+
+// positionalMarker delimits the argument index of a positional placeholder
+// until Bind resolves it. NUL cannot appear in identifiers or in literals
+// when parameterized, so markers never collide with other SQL text.
+const positionalMarker = 0
+
+// positional reports whether the dialect uses anonymous ? placeholders.
+// Registered dialects use them too, as the most widely supported form.
+func (p *ParamCollector) positional() bool {
+	return p.dialect == dialect.DialectMySQL || p.dialect == dialect.DialectTrino || p.dialect == dialect.DialectSparkSQL ||
+		p.dialect.IsCustom()
+}
+
+// placeholder returns the placeholder syntax for the n-th argument.
+// BigQuery/Spanner/SQLServer: @p1
+// PostgreSQL/DuckDB: $1
+// ClickHouse: {p1:Type}
+// MySQL/Trino/SparkSQL/registered dialects: ? (as a marker resolved by Bind)
+// SQLite: ?1
+// Snowflake/Oracle: :1.
+func (p *ParamCollector) placeholder(n int, value any) string {
+	if p.positional() {
+		return fmt.Sprintf("\x00%d\x00", n)
+	}
+
+	//nolint:exhaustive // default handles PostgreSQL/DuckDB
+	switch p.dialect {
+	case dialect.DialectBigQuery, dialect.DialectSpanner, dialect.DialectSQLServer:
+		return fmt.Sprintf("@p%d", n)
+	case dialect.DialectClickHouse:
+		return fmt.Sprintf("{p%d:%s}", n, clickHouseParamType(value))
+	case dialect.DialectSQLite:
+		return fmt.Sprintf("?%d", n)
+	case dialect.DialectSnowflake, dialect.DialectOracle:
+		return fmt.Sprintf(":%d", n)
+	default:
+		return fmt.Sprintf("$%d", n)
+	}
+}
+
+// clickHouseParamType returns the ClickHouse type name used in typed placeholders.
+func clickHouseParamType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "Bool"
+	case int, int8, int16, int32, int64:
+		return "Int64"
+	case uint, uint8, uint16, uint32, uint64:
+		return "UInt64"
+	case float32, float64:
+		return "Float64"
+	default:
+		return "String"
+	}
+}
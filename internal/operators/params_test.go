@@ -0,0 +1,273 @@
+package operators
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+func TestParamCollector_Add(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		values   []any
+		expected []string
+	}{
+		{
+			name:     "PostgreSQL positional",
+			dialect:  dialect.DialectPostgreSQL,
+			values:   []any{"a", 1, true},
+			expected: []string{"$1", "$2", "$3"},
+		},
+		{
+			name:     "DuckDB positional",
+			dialect:  dialect.DialectDuckDB,
+			values:   []any{"a", 2.5},
+			expected: []string{"$1", "$2"},
+		},
+		{
+			name:     "BigQuery named",
+			dialect:  dialect.DialectBigQuery,
+			values:   []any{"a", 1},
+			expected: []string{"@p1", "@p2"},
+		},
+		{
+			name:     "Spanner named",
+			dialect:  dialect.DialectSpanner,
+			values:   []any{"a"},
+			expected: []string{"@p1"},
+		},
+		{
+			name:     "ClickHouse typed",
+			dialect:  dialect.DialectClickHouse,
+			values:   []any{"a", 1, int64(2), uint(3), 2.5, true},
+			expected: []string{"{p1:String}", "{p2:Int64}", "{p3:Int64}", "{p4:UInt64}", "{p5:Float64}", "{p6:Bool}"},
+		},
+		{
+			name:     "MySQL markers",
+			dialect:  dialect.DialectMySQL,
+			values:   []any{"a", 1},
+			expected: []string{"\x001\x00", "\x002\x00"},
+		},
+		{
+			name:     "SQLite numbered",
+			dialect:  dialect.DialectSQLite,
+			values:   []any{"a", 1},
+			expected: []string{"?1", "?2"},
+		},
+		{
+			name:     "Snowflake numbered",
+			dialect:  dialect.DialectSnowflake,
+			values:   []any{"a", 1},
+			expected: []string{":1", ":2"},
+		},
+		{
+			name:     "SQLServer named",
+			dialect:  dialect.DialectSQLServer,
+			values:   []any{"a", 1},
+			expected: []string{"@p1", "@p2"},
+		},
+		{
+			name:     "Trino markers",
+			dialect:  dialect.DialectTrino,
+			values:   []any{"a", 1},
+			expected: []string{"\x001\x00", "\x002\x00"},
+		},
+		{
+			name:     "Oracle numbered",
+			dialect:  dialect.DialectOracle,
+			values:   []any{"a", 1},
+			expected: []string{":1", ":2"},
+		},
+		{
+			name:     "SparkSQL markers",
+			dialect:  dialect.DialectSparkSQL,
+			values:   []any{"a", 1},
+			expected: []string{"\x001\x00", "\x002\x00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParamCollector(tt.dialect)
+			for i, v := range tt.values {
+				if got := p.Add(v); got != tt.expected[i] {
+					t.Errorf("Add(%v) = %q, want %q", v, got, tt.expected[i])
+				}
+			}
+			if p.Len() != len(tt.values) {
+				t.Errorf("Len() = %d, want %d", p.Len(), len(tt.values))
+			}
+			if !reflect.DeepEqual(p.Args(), tt.values) {
+				t.Errorf("Args() = %v, want %v", p.Args(), tt.values)
+			}
+		})
+	}
+}
+
+func TestParamCollector_ArgsIsCopy(t *testing.T) {
+	p := NewParamCollector(dialect.DialectPostgreSQL)
+	p.Add("a")
+	args := p.Args()
+	args[0] = "changed"
+	if p.Args()[0] != "a" {
+		t.Errorf("Args() should return a copy, got %v", p.Args())
+	}
+}
+
+func TestParameterizedOperators(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(config *OperatorConfig) (string, error)
+		expected string
+		args     []any
+	}{
+		{
+			name: "comparison binds literal",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewComparisonOperator(config).ToSQL("==", []interface{}{
+					map[string]interface{}{"var": "name"}, "O'Brien",
+				})
+			},
+			expected: "name = $1",
+			args:     []any{"O'Brien"},
+		},
+		{
+			name: "comparison keeps NULL inline",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewComparisonOperator(config).ToSQL("==", []interface{}{
+					map[string]interface{}{"var": "name"}, nil,
+				})
+			},
+			expected: "name IS NULL",
+			args:     nil,
+		},
+		{
+			name: "in list",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewComparisonOperator(config).ToSQL("in", []interface{}{
+					map[string]interface{}{"var": "status"}, []interface{}{"a", "b"},
+				})
+			},
+			expected: "status IN ($1, $2)",
+			args:     []any{"a", "b"},
+		},
+		{
+			name: "in string containment with bound needle",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewComparisonOperator(config).ToSQL("in", []interface{}{
+					"foo", map[string]interface{}{"var": "text"},
+				})
+			},
+			expected: "POSITION($1 IN text) > 0",
+			args:     []any{"foo"},
+		},
+		{
+			name: "var default",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewDataOperator(config).ToSQL("var", []interface{}{[]interface{}{"status", "pending"}})
+			},
+			expected: "COALESCE(status, $1)",
+			args:     []any{"pending"},
+		},
+		{
+			name: "cat",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewStringOperator(config).ToSQL("cat", []interface{}{
+					"Hello ", map[string]interface{}{"var": "name"},
+				})
+			},
+			expected: "CONCAT($1, name)",
+			args:     []any{"Hello "},
+		},
+		{
+			name: "substr converts start before binding",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewStringOperator(config).ToSQL("substr", []interface{}{
+					map[string]interface{}{"var": "name"}, float64(0), float64(5),
+				})
+			},
+			expected: "SUBSTR(name, $1, $2)",
+			args:     []any{1, 5},
+		},
+		{
+			name: "array operator condition",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewArrayOperator(config).ToSQL("some", []interface{}{
+					map[string]interface{}{"var": "scores"},
+					map[string]interface{}{">": []interface{}{map[string]interface{}{"var": ""}, float64(90)}},
+				})
+			},
+			expected: "EXISTS (SELECT 1 FROM UNNEST(scores) AS elem WHERE elem > $1)",
+			args:     []any{float64(90)},
+		},
+		{
+			name: "numeric",
+			run: func(config *OperatorConfig) (string, error) {
+				return NewNumericOperator(config).ToSQL("+", []interface{}{
+					map[string]interface{}{"var": "a"}, float64(2),
+				})
+			},
+			expected: "(a + $1)",
+			args:     []any{float64(2)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewOperatorConfig(dialect.DialectPostgreSQL, nil)
+			config.Params = NewParamCollector(dialect.DialectPostgreSQL)
+
+			result, err := tt.run(config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("SQL = %q, want %q", result, tt.expected)
+			}
+			if got := config.Params.Args(); len(got) != len(tt.args) || (len(got) > 0 && !reflect.DeepEqual(got, tt.args)) {
+				t.Errorf("Args() = %#v, want %#v", got, tt.args)
+			}
+		})
+	}
+}
+
+func TestParamCollector_Bind(t *testing.T) {
+	t.Run("numbered placeholders are unchanged", func(t *testing.T) {
+		p := NewParamCollector(dialect.DialectPostgreSQL)
+		sql := fmt.Sprintf("(%s < x AND x < %s)", p.Add(1), p.Add(2))
+		got, args := p.Bind(sql)
+		if got != "($1 < x AND x < $2)" || !reflect.DeepEqual(args, []any{1, 2}) {
+			t.Errorf("Bind() = %q, %v", got, args)
+		}
+	})
+
+	t.Run("positional placeholders follow output order", func(t *testing.T) {
+		p := NewParamCollector(dialect.DialectMySQL)
+		first, second := p.Add("a"), p.Add("b")
+		got, args := p.Bind(fmt.Sprintf("y = %s AND x = %s", second, first))
+		if got != "y = ? AND x = ?" || !reflect.DeepEqual(args, []any{"b", "a"}) {
+			t.Errorf("Bind() = %q, %v", got, args)
+		}
+	})
+
+	t.Run("repeated fragments repeat their argument", func(t *testing.T) {
+		p := NewParamCollector(dialect.DialectMySQL)
+		low, mid := p.Add(1), p.Add(5)
+		got, args := p.Bind(fmt.Sprintf("(%s < a AND a < %s AND %s < b)", low, mid, mid))
+		if got != "(? < a AND a < ? AND ? < b)" || !reflect.DeepEqual(args, []any{1, 5, 5}) {
+			t.Errorf("Bind() = %q, %v", got, args)
+		}
+	})
+
+	t.Run("dropped fragments drop their argument", func(t *testing.T) {
+		p := NewParamCollector(dialect.DialectMySQL)
+		p.Add("unused")
+		got, args := p.Bind(fmt.Sprintf("x = %s", p.Add("kept")))
+		if got != "x = ?" || !reflect.DeepEqual(args, []any{"kept"}) {
+			t.Errorf("Bind() = %q, %v", got, args)
+		}
+	})
+}
@@ -1,5 +1,10 @@
 package operators
 
+import (
+	"fmt"
+	"strings"
+)
+
 // SchemaProvider provides schema information for field validation and type checking.
 type SchemaProvider interface {
 	// HasField checks if a field exists in the schema
@@ -18,8 +23,134 @@ type SchemaProvider interface {
 	IsBooleanType(fieldName string) bool
 	// IsEnumType checks if a field is of enum type
 	IsEnumType(fieldName string) bool
+	// IsJSONType checks if a field is a JSON column whose sub-paths are extracted
+	IsJSONType(fieldName string) bool
 	// GetAllowedValues returns the allowed values for an enum field
 	GetAllowedValues(fieldName string) []string
 	// ValidateEnumValue checks if a value is valid for an enum field
 	ValidateEnumValue(fieldName, value string) error
+	// GetColumnMapping returns the physical column mapping for a field, if one is defined
+	GetColumnMapping(fieldName string) (ColumnMapping, bool)
+}
+
+// ColumnMapping describes where a schema field lives in the database.
+type ColumnMapping struct {
+	// Table is an optional table alias prefixed to the column.
+	Table string
+	// Column is the dotted column path; defaults to the field name when empty.
+	Column string
+	// SQL is a raw SQL expression emitted verbatim instead of a column reference.
+	SQL string
+}
+
+// elementSchema resolves the fields of array elements, such as item.price
+// inside {"some": [{"var": "items"}, ...]}, against the element schema that
+// the schema declares under the array name: items[].price. Other names, and
+// element fields the schema does not declare, are looked up unchanged.
+type elementSchema struct {
+	SchemaProvider
+	element string // Schema path of the elements, e.g. items[]
+}
+
+// elementsOf returns the schema seen inside an array operator over the array
+// field named name.
+func elementsOf(schema SchemaProvider, name string) SchemaProvider {
+	if scoped, ok := schema.(elementSchema); ok {
+		name = scoped.resolve(name)
+	}
+	return elementSchema{SchemaProvider: schema, element: name + "[]"}
+}
+
+// fieldLabel returns the name under which errors report a field. The element
+// itself, {"var": ""}, is reported by its schema path, such as tags[], since
+// the rule does not name it.
+func fieldLabel(schema SchemaProvider, name string) string {
+	if scoped, ok := schema.(elementSchema); ok && name == ElemVar && scoped.resolve(name) != name {
+		return scoped.element
+	}
+	return name
+}
+
+// resolve maps an element reference to its schema path when it is declared.
+func (e elementSchema) resolve(name string) string {
+	for _, v := range []string{ItemVar, CurrentVar, ElemVar} {
+		var path string
+		switch {
+		case name == v:
+			path = e.element
+		case strings.HasPrefix(name, v+"."):
+			path = e.element + name[len(v):]
+		default:
+			continue
+		}
+		if e.SchemaProvider.HasField(path) {
+			return path
+		}
+		return name
+	}
+	return name
+}
+
+func (e elementSchema) HasField(fieldName string) bool {
+	return e.SchemaProvider.HasField(e.resolve(fieldName))
+}
+
+func (e elementSchema) GetFieldType(fieldName string) string {
+	return e.SchemaProvider.GetFieldType(e.resolve(fieldName))
+}
+
+func (e elementSchema) ValidateField(fieldName string) error {
+	return e.SchemaProvider.ValidateField(e.resolve(fieldName))
+}
+
+func (e elementSchema) IsArrayType(fieldName string) bool {
+	return e.SchemaProvider.IsArrayType(e.resolve(fieldName))
+}
+
+func (e elementSchema) IsStringType(fieldName string) bool {
+	return e.SchemaProvider.IsStringType(e.resolve(fieldName))
+}
+
+func (e elementSchema) IsNumericType(fieldName string) bool {
+	return e.SchemaProvider.IsNumericType(e.resolve(fieldName))
+}
+
+func (e elementSchema) IsBooleanType(fieldName string) bool {
+	return e.SchemaProvider.IsBooleanType(e.resolve(fieldName))
+}
+
+func (e elementSchema) IsEnumType(fieldName string) bool {
+	return e.SchemaProvider.IsEnumType(e.resolve(fieldName))
+}
+
+func (e elementSchema) IsJSONType(fieldName string) bool {
+	return e.SchemaProvider.IsJSONType(e.resolve(fieldName))
+}
+
+func (e elementSchema) GetAllowedValues(fieldName string) []string {
+	return e.SchemaProvider.GetAllowedValues(e.resolve(fieldName))
+}
+
+// ValidateEnumValue reports invalid values of element fields under the name
+// used in the rule, such as item.status rather than items[].status, or under
+// the schema path for the element itself.
+func (e elementSchema) ValidateEnumValue(fieldName, value string) error {
+	resolved := e.resolve(fieldName)
+	if resolved == fieldName {
+		return e.SchemaProvider.ValidateEnumValue(fieldName, value)
+	}
+	if e.SchemaProvider.ValidateEnumValue(resolved, value) == nil {
+		return nil
+	}
+	return fmt.Errorf("invalid enum value '%s' for field '%s': allowed values are %v",
+		value, fieldLabel(e, fieldName), e.SchemaProvider.GetAllowedValues(resolved))
+}
+
+// GetColumnMapping never maps element fields, which are read from the
+// element rather than from a column.
+func (e elementSchema) GetColumnMapping(fieldName string) (ColumnMapping, bool) {
+	if resolved := e.resolve(fieldName); resolved != fieldName {
+		return ColumnMapping{}, false
+	}
+	return e.SchemaProvider.GetColumnMapping(fieldName)
 }
@@ -2,10 +2,9 @@ package operators
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
-
-	"github.com/h22rana/jsonlogic2sql/internal/dialect"
 )
 
 // StringOperator handles string operations like cat, substr.
@@ -38,7 +37,7 @@ func (s *StringOperator) validateStringOperand(value interface{}) error {
 		return nil // No schema, no validation
 	}
 
-	fieldName := s.extractFieldNameFromValue(value)
+	fieldName := varFieldName(value)
 	if fieldName == "" {
 		return nil // Can't determine field name, skip validation
 	}
@@ -55,35 +54,12 @@ func (s *StringOperator) validateStringOperand(value interface{}) error {
 
 	// Disallow array and object types
 	if s.schema().IsArrayType(fieldName) || fieldType == "object" {
-		return fmt.Errorf("string operation on incompatible field '%s' (type: %s)", fieldName, fieldType)
+		return fmt.Errorf("string operation on incompatible field '%s' (type: %s)", fieldLabel(s.schema(), fieldName), fieldType)
 	}
 
 	return nil
 }
 
-// extractFieldNameFromValue extracts field name from a value that might be a var expression.
-func (s *StringOperator) extractFieldNameFromValue(value interface{}) string {
-	if varExpr, ok := value.(map[string]interface{}); ok {
-		if varName, hasVar := varExpr[OpVar]; hasVar {
-			return s.extractFieldName(varName)
-		}
-	}
-	return ""
-}
-
-// extractFieldName extracts the field name from a var argument.
-func (s *StringOperator) extractFieldName(varName interface{}) string {
-	if nameStr, ok := varName.(string); ok {
-		return nameStr
-	}
-	if nameArr, ok := varName.([]interface{}); ok && len(nameArr) > 0 {
-		if nameStr, ok := nameArr[0].(string); ok {
-			return nameStr
-		}
-	}
-	return ""
-}
-
 // ToSQL converts a string operation to SQL.
 func (s *StringOperator) ToSQL(operator string, args []interface{}) (string, error) {
 	if len(args) == 0 {
@@ -122,8 +98,7 @@ func (s *StringOperator) handleConcatenation(args []interface{}) (string, error)
 		operands[i] = operand
 	}
 
-	// Use CONCAT function for SQL concatenation
-	return fmt.Sprintf("CONCAT(%s)", strings.Join(operands, ", ")), nil
+	return s.config.Spec().Concat(operands), nil
 }
 
 // handleSubstring converts substr operator to SQL.
@@ -144,40 +119,21 @@ func (s *StringOperator) handleSubstring(args []interface{}) (string, error) {
 	}
 
 	// Second argument: start position (convert from 0-based to 1-based)
-	start, err := s.valueToSQL(args[1])
+	startSQL, err := s.startIndexToSQL(args[1])
 	if err != nil {
 		return "", fmt.Errorf("invalid substring start argument: %w", err)
 	}
 
-	// Convert 0-based start to 1-based, handling numeric literals cleanly
-	startSQL := s.convertStartIndex(start)
-
-	// Get the function name based on dialect
-	d := dialect.DialectUnspecified
-	if s.config != nil {
-		d = s.config.GetDialect()
-	}
-
-	var substrFunc string
-	//nolint:exhaustive // default handles BigQuery/Spanner/PostgreSQL/DuckDB
-	switch d {
-	case dialect.DialectClickHouse:
-		substrFunc = "substring"
-	default:
-		substrFunc = "SUBSTR"
-	}
-
 	// Third argument: length (optional)
+	length := ""
 	if len(args) == 3 {
-		length, err := s.valueToSQL(args[2])
+		length, err = s.valueToSQL(s.normalizeIntegerLiteral(args[2]))
 		if err != nil {
 			return "", fmt.Errorf("invalid substring length argument: %w", err)
 		}
-		return fmt.Sprintf("%s(%s, %s, %s)", substrFunc, str, startSQL, length), nil
 	}
 
-	// If no length provided, use SUBSTR/substring without length parameter
-	return fmt.Sprintf("%s(%s, %s)", substrFunc, str, startSQL), nil
+	return s.config.Spec().Substring(str, startSQL, length), nil
 }
 
 // valueToSQL converts a value to SQL, handling var expressions, complex expressions, and literals.
@@ -262,6 +218,33 @@ func (s *StringOperator) valueToSQL(value interface{}) (string, error) {
 	return s.dataOp.valueToSQL(value)
 }
 
+// startIndexToSQL converts the 0-based substr start argument to a 1-based SQL expression.
+// Integer literals are converted before they are emitted so that the adjusted
+// value is what gets inlined or bound as a parameter.
+func (s *StringOperator) startIndexToSQL(value interface{}) (string, error) {
+	if num, ok := s.normalizeIntegerLiteral(value).(int); ok {
+		return s.dataOp.valueToSQL(num + 1)
+	}
+
+	start, err := s.valueToSQL(value)
+	if err != nil {
+		return "", err
+	}
+
+	// Convert 0-based start to 1-based, handling numeric literals cleanly
+	return s.convertStartIndex(start), nil
+}
+
+// normalizeIntegerLiteral converts integral numeric literals (e.g. JSON 5.0) to int.
+// Other values are returned unchanged.
+func (s *StringOperator) normalizeIntegerLiteral(value interface{}) interface{} {
+	num, err := s.dataOp.getNumber(value)
+	if err != nil || num != math.Trunc(num) {
+		return value
+	}
+	return int(num)
+}
+
 // convertStartIndex converts a 0-based start index to 1-based for SQL SUBSTR
 // Handles numeric literals cleanly (e.g., "0" becomes "1", "5" becomes "6")
 // For complex expressions, adds "+ 1" (e.g., "x" becomes "x + 1").
@@ -298,7 +281,7 @@ func (s *StringOperator) processArithmeticExpression(op string, args interface{}
 		if err != nil {
 			return "", fmt.Errorf("invalid unary plus argument: %w", err)
 		}
-		return fmt.Sprintf("CAST(%s AS NUMERIC)", operand), nil
+		return s.config.CastToNumber(operand), nil
 	}
 
 	if len(argsSlice) < 2 {
@@ -326,7 +309,7 @@ func (s *StringOperator) processArithmeticExpression(op string, args interface{}
 	case "/":
 		return fmt.Sprintf("(%s)", strings.Join(operands, " / ")), nil
 	case "%":
-		return fmt.Sprintf("(%s)", strings.Join(operands, " % ")), nil
+		return s.config.Modulo(operands), nil
 	default:
 		return "", fmt.Errorf("unsupported arithmetic operation: %s", op)
 	}
@@ -444,12 +427,10 @@ func (s *StringOperator) processMaxMinExpression(op string, args []interface{})
 		operands[i] = operand
 	}
 
-	funcName := "GREATEST"
 	if op == "min" {
-		funcName = "LEAST"
+		return s.config.Least(operands), nil
 	}
-
-	return fmt.Sprintf("%s(%s)", funcName, strings.Join(operands, ", ")), nil
+	return s.config.Greatest(operands), nil
 }
 
 // processLogicalExpression handles and/or operations within string operations.
@@ -515,5 +496,5 @@ func (s *StringOperator) processBooleanCoercion(args interface{}) (string, error
 	}
 
 	// Boolean coercion: check if value is truthy
-	return fmt.Sprintf("(%s IS NOT NULL AND %s != FALSE AND %s != 0 AND %s != '')", operand, operand, operand, operand), nil
+	return s.config.Truthiness(operand), nil
 }
@@ -0,0 +1,215 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+// Temporal schema field types, as returned by SchemaProvider.GetFieldType.
+const (
+	TypeDate      = "date"
+	TypeDatetime  = "datetime"
+	TypeTimestamp = "timestamp"
+	TypeTime      = "time"
+)
+
+// dateLayout is the layout of date values.
+const dateLayout = "2006-01-02"
+
+// Layouts accepted for datetime and time values. Go parses fractional seconds
+// after a seconds field even when the layout has none, and Z07:00 accepts Z or
+// an offset such as +05:30.
+var (
+	datetimeLayouts = []string{
+		"2006-01-02T15:04:05Z07:00",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05Z07:00",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04Z07:00",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04",
+		"2006-01-02",
+	}
+	timeLayouts = []string{"15:04:05", "15:04"}
+)
+
+// IsTemporalType returns true for the date, datetime, timestamp and time field types.
+func IsTemporalType(fieldType string) bool {
+	switch fieldType {
+	case TypeDate, TypeDatetime, TypeTimestamp, TypeTime:
+		return true
+	default:
+		return false
+	}
+}
+
+// temporalClass groups the temporal types that can be ordered against each
+// other: dates and datetimes are both points in time, a time of day is not.
+func temporalClass(fieldType string) string {
+	if fieldType == TypeTime {
+		return TypeTime
+	}
+	return TypeDatetime
+}
+
+// parseTemporal validates an ISO-8601 value for a temporal field type and
+// returns it in the canonical form used in literals: YYYY-MM-DD for dates,
+// YYYY-MM-DD HH:MM:SS[.fraction] in UTC for datetimes and timestamps, and
+// HH:MM:SS[.fraction] for times. A datetime without a time is midnight.
+// precision is the number of fractional second digits kept.
+func parseTemporal(fieldType, value string) (canonical string, precision int, err error) {
+	switch fieldType {
+	case TypeDate:
+		t, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid date %q: expected ISO-8601 YYYY-MM-DD", value)
+		}
+		return t.Format(dateLayout), 0, nil
+	case TypeTime:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return withPrecision(t.Format("15:04:05.999999999"))
+			}
+		}
+		return "", 0, fmt.Errorf("invalid time %q: expected ISO-8601 HH:MM[:SS[.fraction]]", value)
+	default:
+		for _, layout := range datetimeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return withPrecision(t.UTC().Format("2006-01-02 15:04:05.999999999"))
+			}
+		}
+		return "", 0, fmt.Errorf("invalid %s %q: expected ISO-8601 YYYY-MM-DD[THH:MM[:SS[.fraction]]][Z|±HH:MM]", fieldType, value)
+	}
+}
+
+// withPrecision returns a formatted value with its number of fractional digits.
+func withPrecision(value string) (string, int, error) {
+	if dot := strings.IndexByte(value, '.'); dot >= 0 {
+		return value, len(value) - dot - 1, nil
+	}
+	return value, 0, nil
+}
+
+// TemporalLiteral renders value, an ISO-8601 string, as a typed literal of the
+// temporal fieldType so that it is compared as a date or time rather than as
+// text: DATE '2024-01-01', TIMESTAMP '2024-01-01 10:00:00' and TIME '10:00:00'
+// in most dialects, CAST(... AS DATETIME2) in SQL Server, toDate(...) and
+// toDateTime(...) in ClickHouse, and a plain string in SQLite, whose date
+// functions work on ISO-8601 text. When parameterized, the canonical string
+// is bound and cast to the type instead.
+func (c *OperatorConfig) TemporalLiteral(fieldType string, value any) (string, error) {
+	text, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s value must be an ISO-8601 string, got %T", fieldType, value)
+	}
+	canonical, precision, err := parseTemporal(fieldType, text)
+	if err != nil {
+		return "", err
+	}
+
+	d := c.GetDialect()
+	if fieldType == TypeTime {
+		//nolint:exhaustive // default handles the dialects with a TIME type
+		switch d {
+		case dialect.DialectSpanner, dialect.DialectOracle, dialect.DialectSparkSQL, dialect.DialectClickHouse:
+			return "", fmt.Errorf("time values are not supported for dialect: %s", d)
+		}
+	}
+	if d == dialect.DialectSpanner && fieldType != TypeDate {
+		// Spanner reads a timestamp without an offset in America/Los_Angeles.
+		canonical += "+00"
+	}
+
+	operand, err := c.LiteralToSQL(canonical)
+	if err != nil {
+		return "", err
+	}
+	if d == dialect.DialectSQLite {
+		return operand, nil
+	}
+	if c.IsParameterized() {
+		return c.castTemporal(fieldType, operand, precision), nil
+	}
+
+	//nolint:exhaustive // default handles the dialects with ANSI typed literals
+	switch d {
+	case dialect.DialectSQLServer:
+		return fmt.Sprintf("CAST(%s AS %s)", operand, sqlServerTemporalType(fieldType)), nil
+	case dialect.DialectClickHouse:
+		return clickHouseTemporal(fieldType, operand, precision), nil
+	default:
+		return fmt.Sprintf("%s %s", c.temporalTypeName(fieldType), operand), nil
+	}
+}
+
+// castTemporal converts a bound temporal parameter to its SQL type.
+func (c *OperatorConfig) castTemporal(fieldType, param string, precision int) string {
+	//nolint:exhaustive // default handles the dialects with CAST to the literal type
+	switch c.GetDialect() {
+	case dialect.DialectSQLServer:
+		return fmt.Sprintf("CAST(%s AS %s)", param, sqlServerTemporalType(fieldType))
+	case dialect.DialectClickHouse:
+		return clickHouseTemporal(fieldType, param, precision)
+	case dialect.DialectMySQL:
+		if fieldType == TypeDatetime || fieldType == TypeTimestamp {
+			return fmt.Sprintf("CAST(%s AS DATETIME)", param)
+		}
+		return fmt.Sprintf("CAST(%s AS %s)", param, c.temporalTypeName(fieldType))
+	case dialect.DialectOracle:
+		if fieldType == TypeDate {
+			return fmt.Sprintf("TO_DATE(%s, 'YYYY-MM-DD')", param)
+		}
+		if precision > 0 {
+			return fmt.Sprintf("TO_TIMESTAMP(%s, 'YYYY-MM-DD HH24:MI:SS.FF')", param)
+		}
+		return fmt.Sprintf("TO_TIMESTAMP(%s, 'YYYY-MM-DD HH24:MI:SS')", param)
+	default:
+		return fmt.Sprintf("CAST(%s AS %s)", param, c.temporalTypeName(fieldType))
+	}
+}
+
+// temporalTypeName returns the ANSI type of a temporal field type. BigQuery
+// is the only dialect that tells a zoneless DATETIME from a TIMESTAMP.
+func (c *OperatorConfig) temporalTypeName(fieldType string) string {
+	switch fieldType {
+	case TypeDate:
+		return "DATE"
+	case TypeTime:
+		return "TIME"
+	case TypeDatetime:
+		if c.IsBigQuery() {
+			return "DATETIME"
+		}
+		return "TIMESTAMP"
+	default:
+		return "TIMESTAMP"
+	}
+}
+
+// sqlServerTemporalType returns the SQL Server type of a temporal field type.
+func sqlServerTemporalType(fieldType string) string {
+	switch fieldType {
+	case TypeDate:
+		return "DATE"
+	case TypeTime:
+		return "TIME"
+	default:
+		return "DATETIME2"
+	}
+}
+
+// clickHouseTemporal converts a ClickHouse string operand to a Date or a
+// DateTime in UTC, using DateTime64 when the value has fractional seconds.
+func clickHouseTemporal(fieldType, operand string, precision int) string {
+	switch {
+	case fieldType == TypeDate:
+		return fmt.Sprintf("toDate(%s)", operand)
+	case precision > 0:
+		return fmt.Sprintf("toDateTime64(%s, %d, 'UTC')", operand, precision)
+	default:
+		return fmt.Sprintf("toDateTime(%s, 'UTC')", operand)
+	}
+}
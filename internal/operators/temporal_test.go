@@ -0,0 +1,199 @@
+package operators
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+func TestParseTemporal(t *testing.T) {
+	tests := []struct {
+		fieldType string
+		value     string
+		expected  string
+		precision int
+		wantErr   bool
+	}{
+		{TypeDate, "2024-01-31", "2024-01-31", 0, false},
+		{TypeDate, "2024-02-30", "", 0, true},
+		{TypeDate, "2024-01-31T10:00:00", "", 0, true},
+		{TypeDate, "01/31/2024", "", 0, true},
+		{TypeTimestamp, "2024-01-31T10:15:30Z", "2024-01-31 10:15:30", 0, false},
+		{TypeTimestamp, "2024-01-31T10:15:30.250+02:00", "2024-01-31 08:15:30.25", 2, false},
+		{TypeTimestamp, "2024-01-31 10:15", "2024-01-31 10:15:00", 0, false},
+		{TypeDatetime, "2024-01-31", "2024-01-31 00:00:00", 0, false},
+		{TypeDatetime, "2024-01-31T25:00:00", "", 0, true},
+		{TypeTime, "09:30", "09:30:00", 0, false},
+		{TypeTime, "09:30:15.123456", "09:30:15.123456", 6, false},
+		{TypeTime, "9am", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fieldType+" "+tt.value, func(t *testing.T) {
+			got, precision, err := parseTemporal(tt.fieldType, tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseTemporal() expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseTemporal() unexpected error: %v", err)
+			}
+			if got != tt.expected || precision != tt.precision {
+				t.Errorf("parseTemporal() = %q, %d, want %q, %d", got, precision, tt.expected, tt.precision)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_TemporalLiteral(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   dialect.Dialect
+		fieldType string
+		value     any
+		expected  string
+		errMsg    string
+	}{
+		{"PostgreSQL date", dialect.DialectPostgreSQL, TypeDate, "2024-01-01", "DATE '2024-01-01'", ""},
+		{"PostgreSQL timestamp", dialect.DialectPostgreSQL, TypeTimestamp, "2024-01-01T10:00:00Z", "TIMESTAMP '2024-01-01 10:00:00'", ""},
+		{"PostgreSQL time", dialect.DialectPostgreSQL, TypeTime, "10:00", "TIME '10:00:00'", ""},
+		{"BigQuery datetime", dialect.DialectBigQuery, TypeDatetime, "2024-01-01T10:00:00", "DATETIME '2024-01-01 10:00:00'", ""},
+		{"BigQuery timestamp", dialect.DialectBigQuery, TypeTimestamp, "2024-01-01T10:00:00", "TIMESTAMP '2024-01-01 10:00:00'", ""},
+		{"Spanner timestamp", dialect.DialectSpanner, TypeTimestamp, "2024-01-01T10:00:00", "TIMESTAMP '2024-01-01 10:00:00+00'", ""},
+		{"SQL Server date", dialect.DialectSQLServer, TypeDate, "2024-01-01", "CAST('2024-01-01' AS DATE)", ""},
+		{"SQL Server datetime", dialect.DialectSQLServer, TypeDatetime, "2024-01-01T10:00:00", "CAST('2024-01-01 10:00:00' AS DATETIME2)", ""},
+		{"ClickHouse date", dialect.DialectClickHouse, TypeDate, "2024-01-01", "toDate('2024-01-01')", ""},
+		{"ClickHouse timestamp", dialect.DialectClickHouse, TypeTimestamp, "2024-01-01T10:00:00", "toDateTime('2024-01-01 10:00:00', 'UTC')", ""},
+		{"ClickHouse fractional timestamp", dialect.DialectClickHouse, TypeTimestamp, "2024-01-01T10:00:00.125", "toDateTime64('2024-01-01 10:00:00.125', 3, 'UTC')", ""},
+		{"SQLite date", dialect.DialectSQLite, TypeDate, "2024-01-01", "'2024-01-01'", ""},
+		{"Oracle timestamp", dialect.DialectOracle, TypeTimestamp, "2024-01-01T10:00:00", "TIMESTAMP '2024-01-01 10:00:00'", ""},
+		{"Oracle time", dialect.DialectOracle, TypeTime, "10:00", "", "not supported for dialect: Oracle"},
+		{"ClickHouse time", dialect.DialectClickHouse, TypeTime, "10:00", "", "not supported for dialect: ClickHouse"},
+		{"invalid date", dialect.DialectPostgreSQL, TypeDate, "2024-1-1", "", "expected ISO-8601"},
+		{"number", dialect.DialectPostgreSQL, TypeDate, float64(20240101), "", "must be an ISO-8601 string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewOperatorConfig(tt.dialect, nil)
+			got, err := config.TemporalLiteral(tt.fieldType, tt.value)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("TemporalLiteral() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TemporalLiteral() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("TemporalLiteral() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOperatorConfig_TemporalLiteralParameterized(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   dialect.Dialect
+		fieldType string
+		value     string
+		expected  string
+		arg       any
+	}{
+		{"PostgreSQL date", dialect.DialectPostgreSQL, TypeDate, "2024-01-01", "CAST($1 AS DATE)", "2024-01-01"},
+		{"BigQuery datetime", dialect.DialectBigQuery, TypeDatetime, "2024-01-01T10:00:00", "CAST(@p1 AS DATETIME)", "2024-01-01 10:00:00"},
+		{"MySQL timestamp", dialect.DialectMySQL, TypeTimestamp, "2024-01-01T10:00:00", "CAST(\x001\x00 AS DATETIME)", "2024-01-01 10:00:00"},
+		{"SQL Server timestamp", dialect.DialectSQLServer, TypeTimestamp, "2024-01-01T10:00:00", "CAST(@p1 AS DATETIME2)", "2024-01-01 10:00:00"},
+		{"ClickHouse date", dialect.DialectClickHouse, TypeDate, "2024-01-01", "toDate({p1:String})", "2024-01-01"},
+		{"Oracle date", dialect.DialectOracle, TypeDate, "2024-01-01", "TO_DATE(:1, 'YYYY-MM-DD')", "2024-01-01"},
+		{"Oracle fractional timestamp", dialect.DialectOracle, TypeTimestamp, "2024-01-01T10:00:00.5", "TO_TIMESTAMP(:1, 'YYYY-MM-DD HH24:MI:SS.FF')", "2024-01-01 10:00:00.5"},
+		{"SQLite time", dialect.DialectSQLite, TypeTime, "10:00", "?1", "10:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewOperatorConfig(tt.dialect, nil)
+			config.Params = NewParamCollector(tt.dialect)
+			got, err := config.TemporalLiteral(tt.fieldType, tt.value)
+			if err != nil {
+				t.Fatalf("TemporalLiteral() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("TemporalLiteral() = %q, want %q", got, tt.expected)
+			}
+			if args := config.Params.Args(); !reflect.DeepEqual(args, []any{tt.arg}) {
+				t.Errorf("TemporalLiteral() args = %v, want [%v]", args, tt.arg)
+			}
+		})
+	}
+}
+
+func TestComparisonOperator_Temporal(t *testing.T) {
+	schema := &truthinessSchemaProvider{
+		fields: map[string]string{
+			"signup_date": "date",
+			"created_at":  "timestamp",
+			"updated_at":  "datetime",
+			"opens_at":    "time",
+			"age":         "integer",
+			"name":        "string",
+		},
+	}
+	op := NewComparisonOperator(NewOperatorConfig(dialect.DialectPostgreSQL, schema))
+
+	tests := []struct {
+		name     string
+		operator string
+		args     []interface{}
+		expected string
+		errMsg   string
+	}{
+		{"date equality", "==", []interface{}{map[string]interface{}{"var": "signup_date"}, "2024-01-01"}, "signup_date = DATE '2024-01-01'", ""},
+		{"literal on the left", "!=", []interface{}{"2024-01-01", map[string]interface{}{"var": "signup_date"}}, "DATE '2024-01-01' != signup_date", ""},
+		{"null stays null", "==", []interface{}{map[string]interface{}{"var": "signup_date"}, nil}, "signup_date IS NULL", ""},
+		{"timestamp ordering", ">", []interface{}{map[string]interface{}{"var": "created_at"}, "2024-01-01T12:00:00+01:00"}, "created_at > TIMESTAMP '2024-01-01 11:00:00'", ""},
+		{"time ordering", "<", []interface{}{map[string]interface{}{"var": "opens_at"}, "09:00"}, "opens_at < TIME '09:00:00'", ""},
+		{
+			"chained range", "<=",
+			[]interface{}{"2024-01-01", map[string]interface{}{"var": "signup_date"}, "2024-12-31"},
+			"(DATE '2024-01-01' <= signup_date AND signup_date <= DATE '2024-12-31')", "",
+		},
+		{
+			"in list", "in",
+			[]interface{}{map[string]interface{}{"var": "signup_date"}, []interface{}{"2024-01-01", "2024-01-02"}},
+			"signup_date IN (DATE '2024-01-01', DATE '2024-01-02')", "",
+		},
+		{"date and timestamp fields", "<", []interface{}{map[string]interface{}{"var": "signup_date"}, map[string]interface{}{"var": "created_at"}}, "signup_date < created_at", ""},
+		{"datetime and timestamp fields", ">=", []interface{}{map[string]interface{}{"var": "updated_at"}, map[string]interface{}{"var": "created_at"}}, "updated_at >= created_at", ""},
+		{"invalid date", "==", []interface{}{map[string]interface{}{"var": "signup_date"}, "2024-02-30"}, "", `invalid date "2024-02-30"`},
+		{"invalid in element", "in", []interface{}{map[string]interface{}{"var": "signup_date"}, []interface{}{"2024-01-01", "soon"}}, "", `invalid date "soon"`},
+		{"number for date", ">", []interface{}{map[string]interface{}{"var": "signup_date"}, float64(20240101)}, "", "must be an ISO-8601 string"},
+		{"time and date fields", "<", []interface{}{map[string]interface{}{"var": "opens_at"}, map[string]interface{}{"var": "created_at"}}, "", "incompatible fields 'opens_at' (type: time) and 'created_at' (type: timestamp)"},
+		{"date and integer fields", ">", []interface{}{map[string]interface{}{"var": "signup_date"}, map[string]interface{}{"var": "age"}}, "", "incompatible fields 'signup_date' (type: date) and 'age' (type: integer)"},
+		{"string and date fields", "<=", []interface{}{map[string]interface{}{"var": "name"}, map[string]interface{}{"var": "signup_date"}}, "", "incompatible fields"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := op.ToSQL(tt.operator, tt.args)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("ToSQL() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToSQL() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToSQL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+// Package optimizer simplifies JSON Logic ASTs before SQL is generated.
+//
+// Every rewrite keeps the result of the generated SQL unchanged:
+//
+//   - Operators whose operands are all literals are evaluated and replaced by
+//     their result. Arithmetic is only folded on integers and comparisons only
+//     on numbers, booleans and null, since float rounding, integer division and
+//     string collation are decided by the database. A NULL result is never
+//     folded, as a literal null changes the meaning of a comparison.
+//   - true is dropped from and, false from or. false in and, or true in or,
+//     makes the whole operator constant.
+//   - Nested and, or, +, *, cat, max and min are flattened into their parent.
+//   - A ! of a ! of a condition is the condition itself, and !! of !! is a
+//     single !!.
+//   - if branches whose condition is a literal are resolved.
+//   - Equality tests that an or applies to one var are merged into an in list,
+//     and bounds that an and applies to one var are merged into the tightest
+//     ones, with a >= and <= pair becoming {"<=": [lo, x, hi]}. Bounds that an
+//     or applies in one direction are merged into the loosest one.
+//
+// The tree is treated as a whole rule, whose result only matters when it is
+// true. An and whose bounds on one var admit no value is replaced by false
+// where that holds: at the root and in and/or reached from it. Elsewhere, such
+// as under !, the and yields NULL for a NULL var and is kept.
+package optimizer
+
+import (
+	"math"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	"github.com/h22rana/jsonlogic2sql/internal/eval"
+)
+
+// maxExactInteger is the largest integer a float64 holds exactly.
+const maxExactInteger = 1 << 53
+
+// Optimize returns a simplified copy of the rule. The input tree is not modified.
+func Optimize(node ast.Node) ast.Node {
+	return pruneContradictions(ast.Rewrite(node, simplify))
+}
+
+// pruneContradictions replaces and operators whose bounds admit no value by
+// false, in the and/or operators that make up the condition of the rule.
+// There a NULL result rejects the row like false does.
+func pruneContradictions(node ast.Node) ast.Node {
+	op, ok := node.(*ast.OpNode)
+	if !ok || op.Scalar || (op.Operator != "and" && op.Operator != "or") {
+		return node
+	}
+
+	clone := *op
+	clone.Args = make([]ast.Node, len(op.Args))
+	for i, arg := range op.Args {
+		clone.Args[i] = pruneContradictions(arg)
+	}
+	if clone.Operator == "and" {
+		if _, contradiction := mergeRanges(clone.Args); contradiction {
+			return &ast.LiteralNode{Value: false, JSONPath: op.JSONPath}
+		}
+	}
+	return simplify(&clone)
+}
+
+// simplify applies the rewrites to a single node whose children are already
+// simplified.
+func simplify(node ast.Node) ast.Node {
+	op, ok := node.(*ast.OpNode)
+	if !ok {
+		return node
+	}
+
+	switch op.Operator {
+	case "and", "or":
+		node = simplifyAndOr(op)
+	case "!", "!!":
+		node = simplifyNot(op)
+	case "if":
+		node = simplifyIf(op)
+	case "+", "*", "cat", "max", "min":
+		node = flatten(op)
+	}
+	return fold(node)
+}
+
+// simplifyAndOr flattens nested and/or, removes their identity elements and
+// merges the predicates they apply to one var.
+func simplifyAndOr(op *ast.OpNode) ast.Node {
+	if op.Scalar || len(op.Args) == 0 {
+		return op
+	}
+
+	identity := op.Operator == "and"
+	args := make([]ast.Node, 0, len(op.Args))
+	for _, arg := range flatten(op).Args {
+		b, ok := boolLiteral(arg)
+		switch {
+		case !ok:
+			args = append(args, arg)
+		case b != identity:
+			return &ast.LiteralNode{Value: b, JSONPath: op.JSONPath}
+		}
+	}
+
+	if op.Operator == "and" {
+		args, _ = mergeRanges(args)
+	} else {
+		args = widenRanges(mergeEqualities(args))
+	}
+
+	switch len(args) {
+	case 0:
+		return &ast.LiteralNode{Value: identity, JSONPath: op.JSONPath}
+	case 1:
+		return args[0]
+	default:
+		op.Args = args
+		return op
+	}
+}
+
+// flatten merges arguments that apply the same associative operator into op.
+// Single-argument operands are kept, since a single-argument + is a cast.
+func flatten(op *ast.OpNode) *ast.OpNode {
+	if op.Scalar || len(op.Args) < 2 {
+		return op
+	}
+
+	args := make([]ast.Node, 0, len(op.Args))
+	for _, arg := range op.Args {
+		if inner, ok := arg.(*ast.OpNode); ok && inner.Operator == op.Operator && !inner.Scalar && len(inner.Args) >= 2 {
+			args = append(args, inner.Args...)
+			continue
+		}
+		args = append(args, arg)
+	}
+	op.Args = args
+	return op
+}
+
+// simplifyNot collapses chains of ! and !!. NOT (NOT x) is only x when x is
+// already a condition: for any other value the inner NOT converts it first.
+func simplifyNot(op *ast.OpNode) ast.Node {
+	if len(op.Args) != 1 {
+		return op
+	}
+	inner, ok := op.Args[0].(*ast.OpNode)
+	if !ok || inner.Operator != op.Operator || len(inner.Args) != 1 {
+		return op
+	}
+
+	if op.Operator == "!!" {
+		return inner
+	}
+	if isCondition(inner.Args[0]) {
+		return inner.Args[0]
+	}
+	return op
+}
+
+// simplifyIf drops branches whose condition is a literal false or null, and
+// turns a literal true condition into the else branch.
+func simplifyIf(op *ast.OpNode) ast.Node {
+	if op.Scalar || len(op.Args) < 2 {
+		return op
+	}
+
+	args := make([]ast.Node, 0, len(op.Args))
+	i := 0
+	for ; i+1 < len(op.Args); i += 2 {
+		cond, then := op.Args[i], op.Args[i+1]
+		taken, ok := conditionLiteral(cond)
+		switch {
+		case !ok:
+			args = append(args, cond, then)
+		case taken && len(args) == 0:
+			return then
+		case taken:
+			op.Args = append(args, then)
+			return op
+		}
+	}
+
+	if i < len(op.Args) {
+		if len(args) == 0 {
+			return op.Args[i]
+		}
+		args = append(args, op.Args[i])
+	} else if len(args) == 0 {
+		// No branch can be taken and there is no else: the result is NULL,
+		// which has no literal form that keeps its meaning in a comparison.
+		return op
+	}
+	op.Args = args
+	return op
+}
+
+// fold replaces an operator whose operands are all literals by its value.
+func fold(node ast.Node) ast.Node {
+	op, ok := node.(*ast.OpNode)
+	if !ok || !foldable(op) {
+		return node
+	}
+
+	value, err := eval.Evaluate(op, nil)
+	if err != nil {
+		return node
+	}
+	switch v := value.(type) {
+	case bool, string:
+	case float64:
+		if isArithmetic(op.Operator) && !isInteger(v) {
+			return node
+		}
+	default:
+		return node
+	}
+	return &ast.LiteralNode{Value: value, JSONPath: op.JSONPath}
+}
+
+// foldable reports whether op can be evaluated at compile time with the same
+// result in every dialect.
+func foldable(op *ast.OpNode) bool {
+	switch op.Operator {
+	case "+", "-", "*", "/", "%":
+		return allConstant(op.Args, isInteger)
+	case "==", "===", "!=", "!==", ">", ">=", "<", "<=", "in",
+		"and", "or", "!", "!!", "if", "max", "min":
+		return allConstant(op.Args, isNotString)
+	case "cat":
+		return allConstant(op.Args, isString)
+	case "substr":
+		// Dialects disagree on negative positions, so only non-negative
+		// start and length values are folded.
+		return len(op.Args) > 1 && constant(op.Args[0], isString) &&
+			allConstant(op.Args[1:], func(v any) bool { return isInteger(v) && v.(float64) >= 0 })
+	default:
+		return false
+	}
+}
+
+// isArithmetic reports whether operator is an arithmetic operator.
+func isArithmetic(operator string) bool {
+	switch operator {
+	case "+", "-", "*", "/", "%":
+		return true
+	default:
+		return false
+	}
+}
+
+// isCondition reports whether node always yields TRUE, FALSE or NULL.
+func isCondition(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.LiteralNode:
+		_, ok := boolLiteral(n)
+		return ok
+	case *ast.OpNode:
+		switch n.Operator {
+		case "==", "===", "!=", "!==", ">", ">=", "<", "<=", "in",
+			"!", "!!", "all", "some", "none":
+			return true
+		case "and", "or":
+			return len(n.Args) >= 2
+		}
+	}
+	return false
+}
+
+// allConstant reports whether every node is a literal, or an array of
+// literals, whose values satisfy leaf.
+func allConstant(nodes []ast.Node, leaf func(any) bool) bool {
+	for _, node := range nodes {
+		if !constant(node, leaf) {
+			return false
+		}
+	}
+	return true
+}
+
+// constant reports whether node is a literal, or an array of literals, whose
+// values satisfy leaf.
+func constant(node ast.Node, leaf func(any) bool) bool {
+	switch n := node.(type) {
+	case *ast.LiteralNode:
+		return leaf(literalValue(n))
+	case *ast.ArrayNode:
+		return allConstant(n.Elements, leaf)
+	default:
+		return false
+	}
+}
+
+// literalValue returns the value of a literal in the evaluator's value model,
+// where every number is a float64.
+func literalValue(n *ast.LiteralNode) any {
+	value, err := eval.Evaluate(n, nil)
+	if err != nil {
+		return n.Value
+	}
+	return value
+}
+
+// boolLiteral returns the value of a boolean literal.
+func boolLiteral(node ast.Node) (bool, bool) {
+	lit, ok := node.(*ast.LiteralNode)
+	if !ok {
+		return false, false
+	}
+	b, ok := lit.Value.(bool)
+	return b, ok
+}
+
+// conditionLiteral returns whether a literal condition is taken by CASE WHEN:
+// true is taken, false and null are not.
+func conditionLiteral(node ast.Node) (bool, bool) {
+	if lit, ok := node.(*ast.LiteralNode); ok && lit.Value == nil {
+		return false, true
+	}
+	return boolLiteral(node)
+}
+
+// isInteger reports whether v is a whole number that a float64 holds exactly.
+func isInteger(v any) bool {
+	f, ok := v.(float64)
+	return ok && f == math.Trunc(f) && math.Abs(f) <= maxExactInteger
+}
+
+// isString reports whether v is a string.
+func isString(v any) bool {
+	_, ok := v.(string)
+	return ok
+}
+
+// isNotString reports whether v is a number, a boolean or null.
+func isNotString(v any) bool {
+	switch v.(type) {
+	case nil, bool, float64:
+		return true
+	default:
+		return false
+	}
+}
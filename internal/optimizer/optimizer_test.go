@@ -0,0 +1,130 @@
+package optimizer
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+)
+
+// marshal encodes node as JSON Logic without escaping comparison operators.
+func marshal(t *testing.T, node ast.Node) string {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(node.JSONLogic()); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func TestOptimize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		// Constant folding
+		{"integer arithmetic", `{">": [{"var": "a"}, {"+": [1, 2]}]}`, `{">":[{"var":"a"},3]}`},
+		{"nested arithmetic", `{"==": [{"var": "a"}, {"*": [{"-": [10, 4]}, 2]}]}`, `{"==":[{"var":"a"},12]}`},
+		{"exact division", `{"==": [{"var": "a"}, {"/": [9, 3]}]}`, `{"==":[{"var":"a"},3]}`},
+		{"inexact division is kept", `{"==": [{"var": "a"}, {"/": [7, 2]}]}`, `{"==":[{"var":"a"},{"/":[7,2]}]}`},
+		{"float arithmetic is kept", `{"==": [{"var": "a"}, {"+": [0.1, 0.2]}]}`, `{"==":[{"var":"a"},{"+":[0.1,0.2]}]}`},
+		{"division by zero is kept", `{"==": [{"var": "a"}, {"/": [1, 0]}]}`, `{"==":[{"var":"a"},{"/":[1,0]}]}`},
+		{"null result is kept", `{"==": [{"var": "a"}, {"+": [1, null]}]}`, `{"==":[{"var":"a"},{"+":[1,null]}]}`},
+		{"numeric comparison", `{"and": [{"<": [1, 2]}, {"var": "a"}]}`, `{"var":"a"}`},
+		{"comparison with null", `{"or": [{"==": [null, 1]}, {"var": "a"}]}`, `{"var":"a"}`},
+		{"string comparison is kept", `{"==": ["a", "A"]}`, `{"==":["a","A"]}`},
+		{"literal membership", `{"and": [{"in": [2, [1, 2, 3]]}, {"var": "a"}]}`, `{"var":"a"}`},
+		{"max of literals", `{"<": [{"var": "a"}, {"max": [1, 5, 3]}]}`, `{"<":[{"var":"a"},5]}`},
+		{"cat of strings", `{"==": [{"var": "a"}, {"cat": ["ab", "cd"]}]}`, `{"==":[{"var":"a"},"abcd"]}`},
+		{"cat with a number is kept", `{"==": [{"var": "a"}, {"cat": ["v", 1]}]}`, `{"==":[{"var":"a"},{"cat":["v",1]}]}`},
+		{"substr", `{"==": [{"var": "a"}, {"substr": ["jsonlogic", 4, 5]}]}`, `{"==":[{"var":"a"},"logic"]}`},
+		{"negative substr is kept", `{"==": [{"var": "a"}, {"substr": ["jsonlogic", -5]}]}`, `{"==":[{"var":"a"},{"substr":["jsonlogic",-5]}]}`},
+
+		// and / or
+		{"and drops true", `{"and": [true, {"var": "a"}, {"var": "b"}]}`, `{"and":[{"var":"a"},{"var":"b"}]}`},
+		{"and with false", `{"and": [{"var": "a"}, false]}`, `false`},
+		{"or drops false", `{"or": [false, {"var": "a"}, {"var": "b"}]}`, `{"or":[{"var":"a"},{"var":"b"}]}`},
+		{"or with true", `{"or": [{"var": "a"}, true]}`, `true`},
+		{"and of true", `{"and": [true, true]}`, `true`},
+		{"single argument is unwrapped", `{"and": [{"var": "a"}]}`, `{"var":"a"}`},
+		{"null is kept", `{"and": [null, {"var": "a"}]}`, `{"and":[null,{"var":"a"}]}`},
+
+		// Flattening
+		{"nested and", `{"and": [{"var": "a"}, {"and": [{"var": "b"}, {"and": [{"var": "c"}, {"var": "d"}]}]}]}`, `{"and":[{"var":"a"},{"var":"b"},{"var":"c"},{"var":"d"}]}`},
+		{"or inside and is kept", `{"and": [{"var": "a"}, {"or": [{"var": "b"}, {"var": "c"}]}]}`, `{"and":[{"var":"a"},{"or":[{"var":"b"},{"var":"c"}]}]}`},
+		{"nested plus", `{"+": [{"var": "a"}, {"+": [{"var": "b"}, {"var": "c"}]}]}`, `{"+":[{"var":"a"},{"var":"b"},{"var":"c"}]}`},
+		{"unary plus is kept", `{"+": [{"var": "a"}, {"+": [{"var": "b"}]}]}`, `{"+":[{"var":"a"},{"+":[{"var":"b"}]}]}`},
+		{"nested minus is kept", `{"-": [{"var": "a"}, {"-": [{"var": "b"}, {"var": "c"}]}]}`, `{"-":[{"var":"a"},{"-":[{"var":"b"},{"var":"c"}]}]}`},
+		{"nested cat", `{"cat": [{"cat": [{"var": "a"}, " "]}, {"var": "b"}]}`, `{"cat":[{"var":"a"}," ",{"var":"b"}]}`},
+		{"nested max", `{"max": [{"var": "a"}, {"max": [{"var": "b"}, 3]}]}`, `{"max":[{"var":"a"},{"var":"b"},3]}`},
+
+		// ! and !!
+		{"not not condition", `{"!": {"!": {">": [{"var": "a"}, 1]}}}`, `{">":[{"var":"a"},1]}`},
+		{"triple not", `{"!": [{"!": [{"!": [{"var": "a"}]}]}]}`, `{"!":[{"var":"a"}]}`},
+		{"not not value is kept", `{"!": {"!": {"var": "a"}}}`, `{"!":{"!":{"var":"a"}}}`},
+		{"double truthiness", `{"!!": [{"!!": [{"var": "a"}]}]}`, `{"!!":[{"var":"a"}]}`},
+		{"not of literal", `{"or": [{"!": true}, {"var": "a"}]}`, `{"var":"a"}`},
+
+		// if
+		{"if true", `{"==": [{"if": [true, {"var": "a"}, {"var": "b"}]}, 1]}`, `{"==":[{"var":"a"},1]}`},
+		{"if false", `{"==": [{"if": [false, {"var": "a"}, {"var": "b"}]}, 1]}`, `{"==":[{"var":"b"},1]}`},
+		{"if null", `{"==": [{"if": [null, {"var": "a"}, {"var": "b"}]}, 1]}`, `{"==":[{"var":"b"},1]}`},
+		{"later branch true", `{"if": [{"var": "c"}, {"var": "a"}, true, {"var": "b"}, {"var": "d"}]}`, `{"if":[{"var":"c"},{"var":"a"},{"var":"b"}]}`},
+		{"false branch dropped", `{"if": [{"var": "c"}, 1, false, 2, 3]}`, `{"if":[{"var":"c"},1,3]}`},
+		{"folded condition", `{"if": [{">": [2, 1]}, {"var": "a"}, {"var": "b"}]}`, `{"var":"a"}`},
+		{"no branch and no else is kept", `{"==": [{"if": [false, 1]}, {"var": "a"}]}`, `{"==":[{"if":[false,1]},{"var":"a"}]}`},
+
+		// Untouched
+		{"custom operator", `{"custom": [{"+": [1, 2]}]}`, `{"custom":[3]}`},
+		{"lambda body", `{"some": [{"var": "xs"}, {">": [{"var": ""}, {"*": [2, 5]}]}]}`, `{"some":[{"var":"xs"},{">":[{"var":""},10]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logic any
+			if err := json.Unmarshal([]byte(tt.input), &logic); err != nil {
+				t.Fatalf("invalid test JSON: %v", err)
+			}
+			node, err := ast.Parse(logic)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got := marshal(t, Optimize(node)); got != tt.expected {
+				t.Errorf("Optimize() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOptimize_KeepsInput(t *testing.T) {
+	node, err := ast.Parse(map[string]any{"and": []any{true, map[string]any{"var": "a"}}})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	before := marshal(t, node)
+	Optimize(node)
+	if after := marshal(t, node); before != after {
+		t.Errorf("Optimize() modified its input: %s, was %s", after, before)
+	}
+}
+
+func TestOptimize_Paths(t *testing.T) {
+	node, err := ast.Parse(map[string]any{"and": []any{
+		map[string]any{"var": "a"},
+		map[string]any{">": []any{map[string]any{"var": "b"}, map[string]any{"+": []any{1.0, 2.0}}}},
+	}})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	folded := Optimize(node).(*ast.OpNode).Args[1].(*ast.OpNode).Args[1]
+	if got, want := folded.Path(), "$.and[1].>[1]"; got != want {
+		t.Errorf("folded literal Path() = %q, want %q", got, want)
+	}
+}
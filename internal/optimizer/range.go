@@ -0,0 +1,375 @@
+package optimizer
+
+import (
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+)
+
+// bound is a comparison of a var with a number literal, normalized so that
+// the var is on the left: x > 5, x <= 9 or x == 4.
+type bound struct {
+	operator string
+	value    float64
+	literal  ast.Node
+}
+
+// lower reports whether b is a lower bound (> or >=).
+func (b bound) lower() bool {
+	return b.operator == ">" || b.operator == ">="
+}
+
+// strict reports whether b excludes its own value.
+func (b bound) strict() bool {
+	return b.operator == ">" || b.operator == "<"
+}
+
+// tighter reports whether b admits fewer values than other, which must bound
+// in the same direction.
+func (b bound) tighter(other bound) bool {
+	if b.value == other.value {
+		return b.strict() && !other.strict()
+	}
+	return (b.value > other.value) == b.lower()
+}
+
+// admits reports whether value satisfies b.
+func (b bound) admits(value float64) bool {
+	switch b.operator {
+	case ">":
+		return value > b.value
+	case ">=":
+		return value >= b.value
+	case "<":
+		return value < b.value
+	case "<=":
+		return value <= b.value
+	default:
+		return value == b.value
+	}
+}
+
+// swapped maps a comparison operator to the one that holds with its operands swapped.
+var swapped = map[string]string{">": "<", ">=": "<=", "<": ">", "<=": ">=", "==": "==", "===": "=="}
+
+// mergeEqualities merges the equality tests that an or applies to one var
+// into a single in: x == "a" OR x == "b" OR x in ["c"] is x in ["a", "b", "c"].
+// Only literals of one type are merged, so each in list has a single type.
+func mergeEqualities(args []ast.Node) []ast.Node {
+	out, _ := rewriteGroups(args, func(node ast.Node) (string, bool) {
+		v, values, ok := equalityValues(node)
+		if !ok {
+			return "", false
+		}
+		return v.Name + "\x00" + literalKind(values[0]), true
+	}, func(members []ast.Node) ([]ast.Node, bool) {
+		v, _, _ := equalityValues(members[0])
+		var values []ast.Node
+		for _, member := range members {
+			_, memberValues, _ := equalityValues(member)
+			for _, value := range memberValues {
+				if !containsLiteral(values, value) {
+					values = append(values, value)
+				}
+			}
+		}
+		path := members[0].Path()
+		list := &ast.ArrayNode{Elements: values, JSONPath: path}
+		return []ast.Node{&ast.OpNode{Operator: "in", Args: []ast.Node{v, list}, JSONPath: path}}, true
+	})
+	return out
+}
+
+// mergeRanges merges the range predicates that an and applies to one var:
+// redundant bounds are dropped, an equality replaces the bounds it satisfies,
+// and a >= paired with a <= becomes {"<=": [lo, x, hi]}, which renders as
+// BETWEEN. It also reports whether the bounds of some var admit no value.
+// Such predicates are left as written: the and is NULL rather than false for
+// a NULL var, so only the caller can tell whether false may replace it.
+func mergeRanges(args []ast.Node) ([]ast.Node, bool) {
+	out, ok := rewriteGroups(args, func(node ast.Node) (string, bool) {
+		v, _, ok := rangeBounds(node, true)
+		if !ok {
+			return "", false
+		}
+		return v.Name, true
+	}, intersect)
+	return out, !ok
+}
+
+// widenRanges merges the bounds that an or applies to one var in the same
+// direction into the loosest one: x > 3 OR x >= 5 is x > 3.
+func widenRanges(args []ast.Node) []ast.Node {
+	out, _ := rewriteGroups(args, func(node ast.Node) (string, bool) {
+		v, bounds, ok := rangeBounds(node, false)
+		if !ok || len(bounds) != 1 || bounds[0].operator == "==" {
+			return "", false
+		}
+		if bounds[0].lower() {
+			return v.Name + "\x00>", true
+		}
+		return v.Name + "\x00<", true
+	}, func(members []ast.Node) ([]ast.Node, bool) {
+		loosest := 0
+		_, best, _ := rangeBounds(members[0], false)
+		for i, member := range members[1:] {
+			_, bounds, _ := rangeBounds(member, false)
+			if best[0].tighter(bounds[0]) {
+				loosest, best = i+1, bounds
+			}
+		}
+		return members[loosest : loosest+1], true
+	})
+	return out
+}
+
+// intersect merges the range predicates of one var joined by and. It returns
+// false if no value satisfies them all.
+func intersect(members []ast.Node) ([]ast.Node, bool) {
+	v, _, _ := rangeBounds(members[0], true)
+	var lo, hi, eq *bound
+	var all []bound
+	for _, member := range members {
+		_, bounds, _ := rangeBounds(member, true)
+		all = append(all, bounds...)
+	}
+	for i := range all {
+		b := &all[i]
+		switch {
+		case b.operator == "==":
+			if eq != nil && eq.value != b.value {
+				return nil, false
+			}
+			if eq == nil {
+				eq = b
+			}
+		case b.lower():
+			if lo == nil || b.tighter(*lo) {
+				lo = b
+			}
+		default:
+			if hi == nil || b.tighter(*hi) {
+				hi = b
+			}
+		}
+	}
+
+	path := members[0].Path()
+	if eq != nil {
+		if (lo != nil && !lo.admits(eq.value)) || (hi != nil && !hi.admits(eq.value)) {
+			return nil, false
+		}
+		return []ast.Node{eq.node(v, path)}, true
+	}
+	if lo == nil || hi == nil {
+		only := lo
+		if only == nil {
+			only = hi
+		}
+		return []ast.Node{only.node(v, path)}, true
+	}
+	if !lo.admits(hi.value) || !hi.admits(lo.value) {
+		return nil, false
+	}
+	switch {
+	case lo.value == hi.value:
+		return []ast.Node{bound{operator: "==", literal: lo.literal}.node(v, path)}, true
+	case !lo.strict() && !hi.strict():
+		return []ast.Node{&ast.OpNode{Operator: "<=", Args: []ast.Node{lo.literal, v, hi.literal}, JSONPath: path}}, true
+	default:
+		return []ast.Node{lo.node(v, path), hi.node(v, path)}, true
+	}
+}
+
+// node builds the comparison of v with the bound's literal.
+func (b bound) node(v *ast.VarNode, path string) ast.Node {
+	return &ast.OpNode{Operator: b.operator, Args: []ast.Node{v, b.literal}, JSONPath: path}
+}
+
+// rewriteGroups replaces every group of at least two arguments that share a
+// key by the nodes merge returns for them, placed where the first member was.
+// Arguments without a key are kept in place. If merge rejects a group, its
+// members are kept as written and rewriteGroups reports false.
+func rewriteGroups(args []ast.Node, key func(ast.Node) (string, bool), merge func([]ast.Node) ([]ast.Node, bool)) ([]ast.Node, bool) {
+	keys := make([]string, len(args))
+	keyed := make([]bool, len(args))
+	members := make(map[string][]ast.Node)
+	for i, arg := range args {
+		keys[i], keyed[i] = key(arg)
+		if keyed[i] {
+			members[keys[i]] = append(members[keys[i]], arg)
+		}
+	}
+
+	rejected := false
+	merged := make(map[string][]ast.Node)
+	for k, group := range members {
+		if len(group) < 2 {
+			continue
+		}
+		nodes, ok := merge(group)
+		if !ok {
+			rejected = true
+			continue
+		}
+		merged[k] = nodes
+	}
+
+	out := make([]ast.Node, 0, len(args))
+	done := make(map[string]bool)
+	for i, arg := range args {
+		nodes, ok := merged[keys[i]]
+		switch {
+		case !keyed[i] || !ok:
+			out = append(out, arg)
+		case !done[keys[i]]:
+			out = append(out, nodes...)
+			done[keys[i]] = true
+		}
+	}
+	return out, !rejected
+}
+
+// equalityValues recognizes x == literal, literal == x and x in [literals]
+// with string or number literals of a single type, and returns the var and
+// its literals.
+func equalityValues(node ast.Node) (*ast.VarNode, []ast.Node, bool) {
+	op, ok := node.(*ast.OpNode)
+	if !ok || op.Scalar || len(op.Args) != 2 {
+		return nil, nil, false
+	}
+
+	switch op.Operator {
+	case "==", "===":
+		v, lit, _, ok := varAndLiteral(op.Args)
+		if !ok || literalKind(lit) == "" {
+			return nil, nil, false
+		}
+		return v, []ast.Node{lit}, true
+	case "in":
+		v, ok := plainVar(op.Args[0])
+		list, isList := op.Args[1].(*ast.ArrayNode)
+		if !ok || !isList || len(list.Elements) == 0 {
+			return nil, nil, false
+		}
+		kind := literalKind(list.Elements[0])
+		for _, elem := range list.Elements {
+			if kind == "" || literalKind(elem) != kind {
+				return nil, nil, false
+			}
+		}
+		return v, list.Elements, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// rangeBounds recognizes a comparison of a var with number literals: x > 5,
+// 5 < x, x == 4 and, when chained is set, {"<=": [lo, x, hi]} and
+// {"<": [lo, x, hi]}. It returns the var and its bounds.
+func rangeBounds(node ast.Node, chained bool) (*ast.VarNode, []bound, bool) {
+	op, ok := node.(*ast.OpNode)
+	if !ok || op.Scalar {
+		return nil, nil, false
+	}
+	if _, ok := swapped[op.Operator]; !ok {
+		return nil, nil, false
+	}
+
+	switch len(op.Args) {
+	case 2:
+		v, lit, varFirst, ok := varAndLiteral(op.Args)
+		if !ok {
+			return nil, nil, false
+		}
+		value, ok := numberLiteral(lit)
+		if !ok {
+			return nil, nil, false
+		}
+		operator := op.Operator
+		if operator == "===" {
+			operator = "=="
+		}
+		if !varFirst {
+			operator = swapped[operator]
+		}
+		return v, []bound{{operator: operator, value: value, literal: lit}}, true
+	case 3:
+		if !chained || (op.Operator != "<" && op.Operator != "<=") {
+			return nil, nil, false
+		}
+		v, isVar := plainVar(op.Args[1])
+		lo, loOK := numberLiteral(op.Args[0])
+		hi, hiOK := numberLiteral(op.Args[2])
+		if !isVar || !loOK || !hiOK {
+			return nil, nil, false
+		}
+		return v, []bound{
+			{operator: swapped[op.Operator], value: lo, literal: op.Args[0]},
+			{operator: op.Operator, value: hi, literal: op.Args[2]},
+		}, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// varAndLiteral recognizes a pair of operands made of a var and a literal, in
+// either order. varFirst reports whether the var comes first.
+func varAndLiteral(args []ast.Node) (v *ast.VarNode, lit ast.Node, varFirst bool, ok bool) {
+	if v, ok := plainVar(args[0]); ok {
+		if _, isLiteral := args[1].(*ast.LiteralNode); isLiteral {
+			return v, args[1], true, true
+		}
+	}
+	if v, ok := plainVar(args[1]); ok {
+		if _, isLiteral := args[0].(*ast.LiteralNode); isLiteral {
+			return v, args[0], false, true
+		}
+	}
+	return nil, nil, false, false
+}
+
+// plainVar returns node as a var without a default value.
+func plainVar(node ast.Node) (*ast.VarNode, bool) {
+	v, ok := node.(*ast.VarNode)
+	if !ok || v.Default != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// numberLiteral returns the value of a number literal.
+func numberLiteral(node ast.Node) (float64, bool) {
+	lit, ok := node.(*ast.LiteralNode)
+	if !ok {
+		return 0, false
+	}
+	value, ok := literalValue(lit).(float64)
+	return value, ok
+}
+
+// literalKind returns "string" or "number" for string and number literals,
+// and "" for anything else.
+func literalKind(node ast.Node) string {
+	lit, ok := node.(*ast.LiteralNode)
+	if !ok {
+		return ""
+	}
+	switch literalValue(lit).(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	default:
+		return ""
+	}
+}
+
+// containsLiteral reports whether nodes holds a literal equal to lit.
+func containsLiteral(nodes []ast.Node, lit ast.Node) bool {
+	value := literalValue(lit.(*ast.LiteralNode))
+	for _, node := range nodes {
+		if literalValue(node.(*ast.LiteralNode)) == value {
+			return true
+		}
+	}
+	return false
+}
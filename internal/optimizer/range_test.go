@@ -0,0 +1,86 @@
+package optimizer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+)
+
+func TestOptimize_Ranges(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		// Equalities in or
+		{"equalities become in", `{"or": [{"==": [{"var": "s"}, "a"]}, {"==": [{"var": "s"}, "b"]}, {"==": ["c", {"var": "s"}]}]}`,
+			`{"in":[{"var":"s"},["a","b","c"]]}`},
+		{"existing in list is merged", `{"or": [{"in": [{"var": "s"}, ["a", "b"]]}, {"===": [{"var": "s"}, "b"]}, {"==": [{"var": "s"}, "c"]}]}`,
+			`{"in":[{"var":"s"},["a","b","c"]]}`},
+		{"other arguments keep their place", `{"or": [{"var": "f"}, {"==": [{"var": "n"}, 1]}, {"==": [{"var": "s"}, "a"]}, {"==": [{"var": "n"}, 2]}]}`,
+			`{"or":[{"var":"f"},{"in":[{"var":"n"},[1,2]]},{"==":[{"var":"s"},"a"]}]}`},
+		{"mixed types are not merged", `{"or": [{"==": [{"var": "s"}, "1"]}, {"==": [{"var": "s"}, 2]}]}`,
+			`{"or":[{"==":[{"var":"s"},"1"]},{"==":[{"var":"s"},2]}]}`},
+		{"null is not merged", `{"or": [{"==": [{"var": "s"}, null]}, {"==": [{"var": "s"}, "a"]}]}`,
+			`{"or":[{"==":[{"var":"s"},null]},{"==":[{"var":"s"},"a"]}]}`},
+		{"var with default is not merged", `{"or": [{"==": [{"var": ["s", "x"]}, "a"]}, {"==": [{"var": ["s", "x"]}, "b"]}]}`,
+			`{"or":[{"==":[{"var":["s","x"]},"a"]},{"==":[{"var":["s","x"]},"b"]}]}`},
+		{"equalities in and are kept", `{"and": [{"==": [{"var": "s"}, "a"]}, {"==": [{"var": "t"}, "b"]}]}`,
+			`{"and":[{"==":[{"var":"s"},"a"]},{"==":[{"var":"t"},"b"]}]}`},
+
+		// Ranges in and
+		{"pair becomes between", `{"and": [{">=": [{"var": "x"}, 1]}, {"<=": [{"var": "x"}, 9]}]}`,
+			`{"<=":[1,{"var":"x"},9]}`},
+		{"reversed operands", `{"and": [{"<=": [1, {"var": "x"}]}, {">=": [9, {"var": "x"}]}, {"var": "f"}]}`,
+			`{"and":[{"<=":[1,{"var":"x"},9]},{"var":"f"}]}`},
+		{"strict bounds stay apart", `{"and": [{">": [{"var": "x"}, 1]}, {"<=": [{"var": "x"}, 9]}]}`,
+			`{"and":[{">":[{"var":"x"},1]},{"<=":[{"var":"x"},9]}]}`},
+		{"redundant lower bound", `{"and": [{">": [{"var": "x"}, 5]}, {">": [{"var": "x"}, 3]}, {">=": [{"var": "x"}, 5]}]}`,
+			`{">":[{"var":"x"},5]}`},
+		{"existing between is tightened", `{"and": [{"<=": [1, {"var": "x"}, 9]}, {"<=": [{"var": "x"}, 5]}]}`,
+			`{"<=":[1,{"var":"x"},5]}`},
+		{"equality implies bounds", `{"and": [{"==": [{"var": "x"}, 4]}, {">": [{"var": "x"}, 3]}, {"<": [{"var": "x"}, 10]}]}`,
+			`{"==":[{"var":"x"},4]}`},
+		{"touching bounds", `{"and": [{">=": [{"var": "x"}, 4]}, {"<=": [{"var": "x"}, 4]}]}`,
+			`{"==":[{"var":"x"},4]}`},
+		{"different vars are kept", `{"and": [{">=": [{"var": "x"}, 1]}, {"<=": [{"var": "y"}, 9]}]}`,
+			`{"and":[{">=":[{"var":"x"},1]},{"<=":[{"var":"y"},9]}]}`},
+		{"string bounds are kept", `{"and": [{">=": [{"var": "x"}, "a"]}, {"<=": [{"var": "x"}, "m"]}]}`,
+			`{"and":[{">=":[{"var":"x"},"a"]},{"<=":[{"var":"x"},"m"]}]}`},
+
+		// Contradictions
+		{"empty range", `{"and": [{">": [{"var": "x"}, 5]}, {"<": [{"var": "x"}, 3]}]}`, `false`},
+		{"open range at one point", `{"and": [{">": [{"var": "x"}, 5]}, {"<=": [{"var": "x"}, 5]}]}`, `false`},
+		{"two equalities", `{"and": [{"==": [{"var": "x"}, 1]}, {"==": [{"var": "x"}, 2]}]}`, `false`},
+		{"equality outside bounds", `{"and": [{"==": [{"var": "x"}, 1]}, {">": [{"var": "x"}, 2]}, {"var": "f"}]}`, `false`},
+		{"contradiction in or is dropped", `{"or": [{"var": "f"}, {"and": [{">": [{"var": "x"}, 5]}, {"<": [{"var": "x"}, 3]}]}]}`,
+			`{"var":"f"}`},
+		{"contradiction under not is kept", `{"!": {"and": [{">": [{"var": "x"}, 5]}, {"<": [{"var": "x"}, 3]}]}}`,
+			`{"!":{"and":[{">":[{"var":"x"},5]},{"<":[{"var":"x"},3]}]}}`},
+		{"contradiction in if is kept", `{"==": [{"if": [{"and": [{">": [{"var": "x"}, 5]}, {"<": [{"var": "x"}, 3]}]}, 1, 2]}, {"var": "y"}]}`,
+			`{"==":[{"if":[{"and":[{">":[{"var":"x"},5]},{"<":[{"var":"x"},3]}]},1,2]},{"var":"y"}]}`},
+
+		// Ranges in or
+		{"loosest lower bound", `{"or": [{">": [{"var": "x"}, 3]}, {">=": [{"var": "x"}, 5]}]}`, `{">":[{"var":"x"},3]}`},
+		{"loosest bounds on both sides", `{"or": [{"<": [{"var": "x"}, 0]}, {">": [{"var": "x"}, 3]}, {"<=": [{"var": "x"}, 0]}]}`,
+			`{"or":[{"<=":[{"var":"x"},0]},{">":[{"var":"x"},3]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logic any
+			if err := json.Unmarshal([]byte(tt.input), &logic); err != nil {
+				t.Fatalf("invalid test JSON: %v", err)
+			}
+			node, err := ast.Parse(logic)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got := marshal(t, Optimize(node)); got != tt.expected {
+				t.Errorf("Optimize() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
@@ -3,10 +3,13 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
 	"github.com/h22rana/jsonlogic2sql/internal/dialect"
 	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
 	"github.com/h22rana/jsonlogic2sql/internal/operators"
+	"github.com/h22rana/jsonlogic2sql/internal/optimizer"
 	"github.com/h22rana/jsonlogic2sql/internal/validator"
 )
 
@@ -29,7 +32,9 @@ type Parser struct {
 	numericOp      *operators.NumericOperator
 	stringOp       *operators.StringOperator
 	arrayOp        *operators.ArrayOperator
+	dateOp         *operators.DateOperator
 	customOpLookup CustomOperatorLookup
+	optimize       bool
 }
 
 // NewParser creates a new parser instance with config.
@@ -48,15 +53,27 @@ func NewParser(config *operators.OperatorConfig) *Parser {
 		numericOp:    operators.NewNumericOperator(config),
 		stringOp:     operators.NewStringOperator(config),
 		arrayOp:      operators.NewArrayOperator(config),
+		dateOp:       operators.NewDateOperator(config),
 	}
 
 	// Set the expression parser callback so operators can delegate
 	// nested expression parsing back to the parser (enabling custom operators)
 	config.SetExpressionParser(p.parseExpression)
+	config.ScopedParser = p.scopedParser
 
 	return p
 }
 
+// scopedParser returns the expression parser for config, a copy of the
+// parser's config scoped to part of the rule such as the elements of an array.
+// It recognizes the same custom operators as p.
+func (p *Parser) scopedParser(config *operators.OperatorConfig) operators.ExpressionParser {
+	scoped := NewParser(config)
+	scoped.validator = p.validator
+	scoped.customOpLookup = p.customOpLookup
+	return scoped.parseExpression
+}
+
 // SetCustomOperatorLookup sets the function used to look up custom operators.
 // This also sets up the validator to recognize custom operators.
 func (p *Parser) SetCustomOperatorLookup(lookup CustomOperatorLookup) {
@@ -77,17 +94,17 @@ func (p *Parser) SetSchema(schema operators.SchemaProvider) {
 	// All operators share the same config, so they automatically see the new schema
 }
 
+// SetOptimize enables or disables the optimizer pass that simplifies
+// expressions after validation and before SQL is generated.
+func (p *Parser) SetOptimize(optimize bool) {
+	p.optimize = optimize
+}
+
 // Parse converts a JSON Logic expression to SQL WHERE clause.
 func (p *Parser) Parse(logic interface{}) (string, error) {
-	// First validate the expression
-	if err := p.validator.Validate(logic); err != nil {
-		return "", tperrors.NewValidationError(err)
-	}
-
-	// Parse the expression with root path
-	sql, err := p.parseExpression(logic, "$")
+	sql, err := p.ParseCondition(logic)
 	if err != nil {
-		return "", err // TranspileError already contains full context
+		return "", err
 	}
 
 	// Wrap in WHERE clause
@@ -102,14 +119,68 @@ func (p *Parser) ParseCondition(logic interface{}) (string, error) {
 		return "", tperrors.NewValidationError(err)
 	}
 
+	if p.optimize {
+		// Generate SQL for the rule as written first, so that a branch the
+		// optimizer removes still reports its errors.
+		if err := p.check(logic); err != nil {
+			return "", err
+		}
+		optimized, constant, ok := p.optimizeLogic(logic)
+		if ok {
+			return constant, nil
+		}
+		logic = optimized
+	}
+
 	// Parse the expression with root path
 	sql, err := p.parseExpression(logic, "$")
 	if err != nil {
 		return "", err // TranspileError already contains full context
 	}
 
-	// Return condition without WHERE prefix
-	return sql, nil
+	// Return condition without WHERE prefix, compared with 1 where the
+	// dialect has no boolean values
+	return p.config.Condition(logic, sql), nil
+}
+
+// ParseColumn renders a field name as a column reference, with the schema
+// validation and column mapping that {"var": name} gets.
+func (p *Parser) ParseColumn(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("column name cannot be empty")
+	}
+	return p.dataOp.ToSQL("var", []interface{}{name})
+}
+
+// check generates SQL for logic only to report its errors. Literals are not
+// bound as parameters, so the caller's parameter list is left untouched.
+func (p *Parser) check(logic interface{}) error {
+	if params := p.config.Params; params != nil {
+		p.config.Params = nil
+		defer func() { p.config.Params = params }()
+	}
+	_, err := p.parseExpression(logic, "$")
+	return err
+}
+
+// optimizeLogic runs the optimizer over logic. When the whole rule folds to a
+// boolean, the matching constant condition is returned with ok set. A rule that
+// folds to any other literal is returned unchanged, since a bare literal is not
+// a valid rule.
+func (p *Parser) optimizeLogic(logic interface{}) (optimized interface{}, constant string, ok bool) {
+	node, err := ast.Parse(logic)
+	if err != nil {
+		return logic, "", false
+	}
+
+	node = optimizer.Optimize(node)
+	if lit, isLiteral := node.(*ast.LiteralNode); isLiteral {
+		if b, isBool := lit.Value.(bool); isBool {
+			return nil, p.config.BoolPredicate(b), true
+		}
+		return logic, "", false
+	}
+	return node.JSONLogic(), "", false
 }
 
 // parseExpression recursively parses JSON Logic expressions.
@@ -266,14 +337,40 @@ func (p *Parser) parseOperator(operator string, args interface{}, path string) (
 		}
 		return "", tperrors.NewOperatorRequiresArray(operator, path)
 
+	// Date operators
+	case "now", "date_add", "date_sub", "date_diff", "date_trunc", "days_ago", "within_last":
+		arr, ok := args.([]interface{})
+		if !ok {
+			// Allows the {"days_ago": 30} shorthand
+			arr = []interface{}{args}
+		}
+		sql, err := p.dateOp.ToSQL(operator, arr)
+		return sql, p.wrapOperatorError(operator, path, err)
+
 	// All operators are now supported
 	default:
 		return "", tperrors.NewUnsupportedOperator(operator, path)
 	}
 }
 
-// isBuiltInOperator checks if an operator is a built-in operator.
+// isArrayOperator reports whether operator is a built-in array operator.
+func (p *Parser) isArrayOperator(operator string) bool {
+	switch operator {
+	case "map", "filter", "reduce", "all", "some", "none", "merge":
+		return p.isBuiltInOperator(operator)
+	default:
+		return false
+	}
+}
+
+// isBuiltInOperator checks if an operator is a built-in operator that no
+// custom operator overrides. Only the date operators can be overridden.
 func (p *Parser) isBuiltInOperator(operator string) bool {
+	if p.customOpLookup != nil {
+		if _, ok := p.customOpLookup(operator); ok {
+			return false
+		}
+	}
 	builtInOps := map[string]bool{
 		// Data access
 		"var": true, "missing": true, "missing_some": true,
@@ -290,6 +387,9 @@ func (p *Parser) isBuiltInOperator(operator string) bool {
 		// Array
 		"map": true, "filter": true, "reduce": true,
 		"all": true, "some": true, "none": true, "merge": true,
+		// Date
+		"now": true, "date_add": true, "date_sub": true, "date_diff": true,
+		"date_trunc": true, "days_ago": true, "within_last": true,
 	}
 	return builtInOps[operator]
 }
@@ -335,6 +435,12 @@ func (p *Parser) processArg(arg interface{}, path string, index int) (interface{
 					return operators.SQLResult(sql), nil
 				}
 
+				// Array operators render their bodies over the elements and
+				// hand nested custom operators back to the parser themselves
+				if p.isArrayOperator(operator) {
+					return arg, nil
+				}
+
 				// It's a built-in operator - recursively process its arguments
 				// to handle any nested custom operators
 				processedOpArgs, err := p.processOpArgs(opArgs, operatorPath)
@@ -412,26 +518,29 @@ func (p *Parser) processArgToSQL(arg interface{}, path string) (interface{}, err
 		}
 	}
 
+	// Handle array literals element by element so that every element is escaped
+	if arr, ok := arg.([]interface{}); ok {
+		elements := make([]string, len(arr))
+		for i, elem := range arr {
+			elemPath := tperrors.BuildArrayPath(path, i)
+			sql, err := p.processArgToSQL(elem, elemPath)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = fmt.Sprintf("%v", sql)
+		}
+		return fmt.Sprintf("[%s]", strings.Join(elements, " ")), nil
+	}
+
 	// Handle primitive values - convert to SQL representation
-	return p.primitiveToSQL(arg), nil
+	return p.primitiveToSQL(arg)
 }
 
 // primitiveToSQL converts a primitive value to its SQL representation.
-func (p *Parser) primitiveToSQL(value interface{}) interface{} {
-	switch v := value.(type) {
-	case string:
-		return fmt.Sprintf("'%s'", v)
-	case bool:
-		if v {
-			return "TRUE"
-		}
-		return "FALSE"
-	case nil:
-		return "NULL"
-	default:
-		// Numbers and other types
-		return fmt.Sprintf("%v", v)
-	}
+// Strings are quoted for the configured dialect, and bound as parameters
+// when the config is parameterized.
+func (p *Parser) primitiveToSQL(value interface{}) (interface{}, error) {
+	return p.config.LiteralToSQL(value)
 }
 
 // isPrimitive checks if a value is a primitive type.
@@ -2,6 +2,9 @@ package parser
 
 import (
 	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+	"github.com/h22rana/jsonlogic2sql/internal/operators"
 )
 
 func TestNewParser(t *testing.T) {
@@ -350,6 +353,20 @@ func TestParser_parseOperator(t *testing.T) {
 			expected: "amount > 1000",
 			hasError: false,
 		},
+		{
+			name:     "date operator shorthand",
+			operator: "days_ago",
+			args:     float64(7),
+			expected: "TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL 7 DAY)",
+			hasError: false,
+		},
+		{
+			name:     "date operator with invalid unit",
+			operator: "date_trunc",
+			args:     []interface{}{map[string]interface{}{"var": "created_at"}, "quarter"},
+			expected: "",
+			hasError: true,
+		},
 		{
 			name:     "unsupported operator",
 			operator: "unsupported",
@@ -420,3 +437,66 @@ func TestParser_isPrimitive(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_SetOptimize(t *testing.T) {
+	logic := map[string]interface{}{
+		"and": []interface{}{
+			true,
+			map[string]interface{}{">": []interface{}{map[string]interface{}{"var": "a"}, map[string]interface{}{"+": []interface{}{1, 2}}}},
+		},
+	}
+
+	p := NewParser(nil)
+	got, err := p.Parse(logic)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := "WHERE (TRUE AND a > (1 + 2))"; got != want {
+		t.Errorf("Parse() = %q, want %q", got, want)
+	}
+
+	p.SetOptimize(true)
+	got, err = p.Parse(logic)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := "WHERE a > 3"; got != want {
+		t.Errorf("Parse() with optimizer = %q, want %q", got, want)
+	}
+
+	got, err = p.ParseCondition(map[string]interface{}{"or": []interface{}{map[string]interface{}{"var": "a"}, true}})
+	if err != nil {
+		t.Fatalf("ParseCondition() error = %v", err)
+	}
+	if want := "TRUE"; got != want {
+		t.Errorf("ParseCondition() with optimizer = %q, want %q", got, want)
+	}
+}
+
+func TestParser_ParseColumn(t *testing.T) {
+	p := NewParser(operators.NewOperatorConfig(dialect.DialectPostgreSQL, nil))
+
+	tests := []struct {
+		name     string
+		column   string
+		expected string
+		wantErr  bool
+	}{
+		{"plain", "amount", "amount", false},
+		{"nested", "user.name", "user.name", false},
+		{"reserved", "order", `"order"`, false},
+		{"empty", "", "", true},
+		{"empty segment", "user..name", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.ParseColumn(tt.column)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseColumn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseColumn() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
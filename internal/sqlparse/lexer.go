@@ -0,0 +1,363 @@
+package sqlparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// tokenKind classifies a lexical token.
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokQuotedIdent
+	tokString
+	tokNumber
+	tokSymbol
+	tokPlaceholder
+)
+
+// token is a lexical token with its byte offset in the input.
+type token struct {
+	kind tokenKind
+	text string // identifier name, unescaped string value, number text or symbol
+	pos  int
+}
+
+// is reports whether t is the keyword kw (case-insensitive).
+func (t token) is(kw string) bool {
+	return t.kind == tokIdent && strings.EqualFold(t.text, kw)
+}
+
+// isSymbol reports whether t is the symbol sym.
+func (t token) isSymbol(sym string) bool {
+	return t.kind == tokSymbol && t.text == sym
+}
+
+// symbols lists multi- and single-character symbols, longest first.
+var symbols = []string{
+	"->>", "<=>", "::", "<>", "!=", "<=", ">=", "||", "->",
+	"=", "<", ">", "+", "-", "*", "/", "%", "(", ")", ",", ".", "[", "]",
+}
+
+// lexer splits SQL text into tokens.
+type lexer struct {
+	input   string
+	dialect dialect.Dialect
+	pos     int
+}
+
+// tokenize returns all tokens of input, ending with tokEOF.
+func tokenize(input string, d dialect.Dialect) ([]token, error) {
+	l := &lexer{input: input, dialect: d}
+	var tokens []token
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, tok)
+		if tok.kind == tokEOF {
+			return tokens, nil
+		}
+	}
+}
+
+// next scans the next token.
+func (l *lexer) next() (token, error) {
+	l.skipSpaceAndComments()
+	if l.pos >= len(l.input) {
+		return token{kind: tokEOF, pos: l.pos}, nil
+	}
+
+	start := l.pos
+	c := l.input[l.pos]
+	switch {
+	case c == '\'':
+		return l.scanString(start)
+	case c == '"' && l.doubleQuotedStrings():
+		return l.scanString(start)
+	case c == 'N' && l.dialect == dialect.DialectSQLServer && l.pos+1 < len(l.input) && l.input[l.pos+1] == '\'':
+		// SQL Server Unicode string literal: N'...'
+		l.pos++
+		return l.scanString(start)
+	case c == '"' || c == '`':
+		return l.scanQuotedIdent(start, c)
+	case c == '[' && l.dialect == dialect.DialectSQLServer:
+		return l.scanQuotedIdent(start, ']')
+	case c >= '0' && c <= '9', c == '.' && l.peekDigit(1):
+		return l.scanNumber(start), nil
+	case c == '$' && l.peekDigit(1), c == '?':
+		return l.scanPlaceholder(start), nil
+	case c == ':' && (l.dialect == dialect.DialectSnowflake || l.dialect == dialect.DialectOracle) && l.peekDigit(1):
+		return l.scanPlaceholder(start), nil
+	case c == '@' || c == '{':
+		return l.scanPlaceholder(start), nil
+	case c == '_' || isLetter(l.input[l.pos:]):
+		return l.scanIdent(start), nil
+	}
+
+	for _, sym := range symbols {
+		if strings.HasPrefix(l.input[l.pos:], sym) {
+			l.pos += len(sym)
+			return token{kind: tokSymbol, text: sym, pos: start}, nil
+		}
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.input[l.pos:])
+	return token{}, errorAt(l.input, start, tperrors.ErrInvalidSQL, string(r),
+		fmt.Sprintf("unexpected character %q", r))
+}
+
+// doubleQuotedStrings reports whether "..." is a string literal rather than an
+// identifier, as in GoogleSQL, MySQL without ANSI_QUOTES and Spark SQL.
+func (l *lexer) doubleQuotedStrings() bool {
+	return l.dialect == dialect.DialectBigQuery || l.dialect == dialect.DialectSpanner || l.dialect == dialect.DialectMySQL ||
+		l.dialect == dialect.DialectSparkSQL
+}
+
+// backslashEscapes reports whether string literals use backslash escapes.
+func (l *lexer) backslashEscapes() bool {
+	//nolint:exhaustive // default handles PostgreSQL/DuckDB
+	switch l.dialect {
+	case dialect.DialectBigQuery, dialect.DialectSpanner, dialect.DialectClickHouse, dialect.DialectMySQL,
+		dialect.DialectSnowflake, dialect.DialectSparkSQL:
+		return true
+	default:
+		return false
+	}
+}
+
+// skipSpaceAndComments skips whitespace, -- line comments and /* */ block comments.
+func (l *lexer) skipSpaceAndComments() {
+	for l.pos < len(l.input) {
+		rest := l.input[l.pos:]
+		switch {
+		case rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\n' || rest[0] == '\r':
+			l.pos++
+		case strings.HasPrefix(rest, "--"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				l.pos = len(l.input)
+			} else {
+				l.pos += end + 1
+			}
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				l.pos = len(l.input)
+			} else {
+				l.pos += end + 4
+			}
+		default:
+			return
+		}
+	}
+}
+
+// scanString scans a quoted string literal at l.pos and unescapes it.
+func (l *lexer) scanString(start int) (token, error) {
+	quote := l.input[l.pos]
+	var b strings.Builder
+	l.pos++
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == quote:
+			if l.pos+1 < len(l.input) && l.input[l.pos+1] == quote {
+				b.WriteByte(quote)
+				l.pos += 2
+				continue
+			}
+			l.pos++
+			return token{kind: tokString, text: b.String(), pos: start}, nil
+		case c == '\\' && l.backslashEscapes():
+			if err := l.scanEscape(&b); err != nil {
+				return token{}, err
+			}
+		default:
+			b.WriteByte(c)
+			l.pos++
+		}
+	}
+	return token{}, errorAt(l.input, start, tperrors.ErrInvalidSQL, "", "unterminated string literal")
+}
+
+// scanEscape decodes a backslash escape sequence at l.pos.
+func (l *lexer) scanEscape(b *strings.Builder) error {
+	start := l.pos
+	if l.pos+1 >= len(l.input) {
+		return errorAt(l.input, start, tperrors.ErrInvalidSQL, "", "unterminated escape sequence")
+	}
+	c := l.input[l.pos+1]
+	l.pos += 2
+	switch c {
+	case 'n':
+		b.WriteByte('\n')
+	case 'r':
+		b.WriteByte('\r')
+	case 't':
+		b.WriteByte('\t')
+	case '0':
+		b.WriteByte(0)
+	case 'Z':
+		if l.dialect != dialect.DialectMySQL {
+			b.WriteByte(c)
+			break
+		}
+		b.WriteByte(0x1a)
+	case 'u':
+		if l.dialect != dialect.DialectSparkSQL {
+			b.WriteByte(c)
+			break
+		}
+		if l.pos+4 > len(l.input) {
+			return errorAt(l.input, start, tperrors.ErrInvalidSQL, "", "invalid \\u escape sequence")
+		}
+		v, err := strconv.ParseUint(l.input[l.pos:l.pos+4], 16, 16)
+		if err != nil {
+			return errorAt(l.input, start, tperrors.ErrInvalidSQL, "", "invalid \\u escape sequence")
+		}
+		b.WriteRune(rune(v))
+		l.pos += 4
+	case 'x', 'X':
+		if l.dialect == dialect.DialectMySQL {
+			b.WriteByte(c)
+			break
+		}
+		if l.pos+2 > len(l.input) {
+			return errorAt(l.input, start, tperrors.ErrInvalidSQL, "", "invalid \\x escape sequence")
+		}
+		v, err := strconv.ParseUint(l.input[l.pos:l.pos+2], 16, 8)
+		if err != nil {
+			return errorAt(l.input, start, tperrors.ErrInvalidSQL, "", "invalid \\x escape sequence")
+		}
+		b.WriteByte(byte(v))
+		l.pos += 2
+	default:
+		b.WriteByte(c)
+	}
+	return nil
+}
+
+// scanQuotedIdent scans a "quoted", `quoted` or [quoted] identifier ending in
+// quote. The closing quote is escaped by doubling it, or with a backslash for
+// backtick identifiers outside MySQL and Spark SQL.
+func (l *lexer) scanQuotedIdent(start int, quote byte) (token, error) {
+	var b strings.Builder
+	l.pos++
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == quote:
+			if l.pos+1 < len(l.input) && l.input[l.pos+1] == quote {
+				b.WriteByte(quote)
+				l.pos += 2
+				continue
+			}
+			l.pos++
+			return token{kind: tokQuotedIdent, text: b.String(), pos: start}, nil
+		case c == '\\' && quote == '`' && l.dialect != dialect.DialectMySQL && l.dialect != dialect.DialectSparkSQL &&
+			l.pos+1 < len(l.input):
+			b.WriteByte(l.input[l.pos+1])
+			l.pos += 2
+		default:
+			b.WriteByte(c)
+			l.pos++
+		}
+	}
+	return token{}, errorAt(l.input, start, tperrors.ErrInvalidSQL, "", "unterminated quoted identifier")
+}
+
+// scanNumber scans an integer or decimal literal with an optional exponent.
+func (l *lexer) scanNumber(start int) token {
+	for l.pos < len(l.input) && (isDigit(l.input[l.pos]) || l.input[l.pos] == '.') {
+		l.pos++
+	}
+	if l.pos < len(l.input) && (l.input[l.pos] == 'e' || l.input[l.pos] == 'E') {
+		next := l.pos + 1
+		if next < len(l.input) && (l.input[next] == '+' || l.input[next] == '-') {
+			next++
+		}
+		if next < len(l.input) && isDigit(l.input[next]) {
+			l.pos = next
+			for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+				l.pos++
+			}
+		}
+	}
+	return token{kind: tokNumber, text: l.input[start:l.pos], pos: start}
+}
+
+// scanPlaceholder scans a bind placeholder: ?, $1, :1, @p1 or {p1:Type}.
+func (l *lexer) scanPlaceholder(start int) token {
+	if l.input[l.pos] == '{' {
+		end := strings.IndexByte(l.input[l.pos:], '}')
+		if end < 0 {
+			l.pos = len(l.input)
+		} else {
+			l.pos += end + 1
+		}
+		return token{kind: tokPlaceholder, text: l.input[start:l.pos], pos: start}
+	}
+	l.pos++
+	for l.pos < len(l.input) && (isDigit(l.input[l.pos]) || isIdentByte(l.input[l.pos])) {
+		l.pos++
+	}
+	return token{kind: tokPlaceholder, text: l.input[start:l.pos], pos: start}
+}
+
+// scanIdent scans a bare identifier or keyword.
+func (l *lexer) scanIdent(start int) token {
+	for l.pos < len(l.input) {
+		if isIdentByte(l.input[l.pos]) || isDigit(l.input[l.pos]) {
+			l.pos++
+			continue
+		}
+		if l.input[l.pos] >= utf8.RuneSelf && isLetter(l.input[l.pos:]) {
+			_, size := utf8.DecodeRuneInString(l.input[l.pos:])
+			l.pos += size
+			continue
+		}
+		break
+	}
+	return token{kind: tokIdent, text: l.input[start:l.pos], pos: start}
+}
+
+// peekDigit reports whether the byte at l.pos+offset is a digit.
+func (l *lexer) peekDigit(offset int) bool {
+	return l.pos+offset < len(l.input) && isDigit(l.input[l.pos+offset])
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isLetter reports whether s starts with a Unicode letter.
+func isLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+// errorAt builds a TranspileError located at byte offset pos of input.
+func errorAt(input string, pos int, code tperrors.ErrorCode, construct, message string) *tperrors.TranspileError {
+	line, column := 1, 1
+	for _, r := range input[:min(pos, len(input))] {
+		if r == '\n' {
+			line++
+			column = 1
+			continue
+		}
+		column++
+	}
+	return tperrors.NewSQLError(code, construct, line, column, message)
+}
@@ -0,0 +1,151 @@
+package sqlparse
+
+import (
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+)
+
+func TestTokenize_Strings(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		input   string
+		want    string
+	}{
+		{"doubled quote", dialect.DialectPostgreSQL, `'O''Brien'`, "O'Brien"},
+		{"postgres backslash is literal", dialect.DialectPostgreSQL, `'C:\temp'`, `C:\temp`},
+		{"bigquery escaped quote", dialect.DialectBigQuery, `'O\'Brien'`, "O'Brien"},
+		{"bigquery escapes", dialect.DialectBigQuery, `'a\nb\tc\\d'`, "a\nb\tc\\d"},
+		{"bigquery hex escape", dialect.DialectBigQuery, `'\x41'`, "A"},
+		{"bigquery double-quoted string", dialect.DialectBigQuery, `"it's"`, "it's"},
+		{"clickhouse escapes", dialect.DialectClickHouse, `'O\'Brien'`, "O'Brien"},
+		{"mysql escapes", dialect.DialectMySQL, `'a\0b\Zc\xd'`, "a\x00b\x1acxd"},
+		{"mysql double-quoted string", dialect.DialectMySQL, `"it's"`, "it's"},
+		{"snowflake escapes", dialect.DialectSnowflake, `'a\tb\'c'`, "a\tb'c"},
+		{"sqlserver unicode prefix", dialect.DialectSQLServer, `N'O''Brien'`, "O'Brien"},
+		{"sqlserver backslash is literal", dialect.DialectSQLServer, `'C:\temp'`, `C:\temp`},
+		{"sparksql unicode escape", dialect.DialectSparkSQL, `'a\u000012'`, "a\x0012"},
+		{"sparksql double-quoted string", dialect.DialectSparkSQL, `"it\'s"`, "it's"},
+		{"unicode", dialect.DialectDuckDB, `'héllo'`, "héllo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := tokenize(tt.input, tt.dialect)
+			if err != nil {
+				t.Fatalf("tokenize() error = %v", err)
+			}
+			if tokens[0].kind != tokString || tokens[0].text != tt.want {
+				t.Errorf("token = %+v, want string %q", tokens[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenize_QuotedIdentifiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		input   string
+		want    string
+	}{
+		{"double quotes", dialect.DialectPostgreSQL, `"order"`, "order"},
+		{"doubled double quote", dialect.DialectDuckDB, `"a""b"`, `a"b`},
+		{"backticks", dialect.DialectBigQuery, "`select`", "select"},
+		{"escaped backtick", dialect.DialectClickHouse, "`a\\`b`", "a`b"},
+		{"clickhouse double quotes", dialect.DialectClickHouse, `"col"`, "col"},
+		{"mysql backslash is literal", dialect.DialectMySQL, "`a\\b`", `a\b`},
+		{"sqlserver brackets", dialect.DialectSQLServer, "[first name]", "first name"},
+		{"sqlserver doubled bracket", dialect.DialectSQLServer, "[a]]b]", "a]b"},
+		{"sparksql doubled backtick", dialect.DialectSparkSQL, "`a``b\\c`", "a`b\\c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := tokenize(tt.input, tt.dialect)
+			if err != nil {
+				t.Fatalf("tokenize() error = %v", err)
+			}
+			if tokens[0].kind != tokQuotedIdent || tokens[0].text != tt.want {
+				t.Errorf("token = %+v, want quoted identifier %q", tokens[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenize_Sequence(t *testing.T) {
+	input := "a.b >= -1.5e3 -- comment\n/* block */ AND $1 <> @p2 || {p3:String}"
+	tokens, err := tokenize(input, dialect.DialectPostgreSQL)
+	if err != nil {
+		t.Fatalf("tokenize() error = %v", err)
+	}
+
+	want := []struct {
+		kind tokenKind
+		text string
+	}{
+		{tokIdent, "a"}, {tokSymbol, "."}, {tokIdent, "b"}, {tokSymbol, ">="},
+		{tokSymbol, "-"}, {tokNumber, "1.5e3"}, {tokIdent, "AND"},
+		{tokPlaceholder, "$1"}, {tokSymbol, "<>"}, {tokPlaceholder, "@p2"},
+		{tokSymbol, "||"}, {tokPlaceholder, "{p3:String}"}, {tokEOF, ""},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("got %d tokens, want %d: %+v", len(tokens), len(want), tokens)
+	}
+	for i, w := range want {
+		if tokens[i].kind != w.kind || tokens[i].text != w.text {
+			t.Errorf("token %d = %+v, want kind %d %q", i, tokens[i], w.kind, w.text)
+		}
+	}
+}
+
+func TestTokenize_SnowflakePlaceholder(t *testing.T) {
+	tokens, err := tokenize("a = :1 AND b::NUMBER > :12", dialect.DialectSnowflake)
+	if err != nil {
+		t.Fatalf("tokenize() error = %v", err)
+	}
+
+	want := []struct {
+		kind tokenKind
+		text string
+	}{
+		{tokIdent, "a"}, {tokSymbol, "="}, {tokPlaceholder, ":1"}, {tokIdent, "AND"},
+		{tokIdent, "b"}, {tokSymbol, "::"}, {tokIdent, "NUMBER"}, {tokSymbol, ">"},
+		{tokPlaceholder, ":12"}, {tokEOF, ""},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("got %d tokens, want %d: %+v", len(tokens), len(want), tokens)
+	}
+	for i, w := range want {
+		if tokens[i].kind != w.kind || tokens[i].text != w.text {
+			t.Errorf("token %d = %+v, want kind %d %q", i, tokens[i], w.kind, w.text)
+		}
+	}
+}
+
+func TestTokenize_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"unterminated string", "a = 'abc", 1, 5},
+		{"unterminated identifier", "a = 1 AND\n\"b = 2", 2, 1},
+		{"unexpected character", "a = 1 ; b", 1, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tokenize(tt.input, dialect.DialectPostgreSQL)
+			if err == nil {
+				t.Fatal("tokenize() expected error")
+			}
+			tpErr := asTranspileError(t, err)
+			if tpErr.Line != tt.line || tpErr.Column != tt.column {
+				t.Errorf("position = %d:%d, want %d:%d", tpErr.Line, tpErr.Column, tt.line, tt.column)
+			}
+		})
+	}
+}
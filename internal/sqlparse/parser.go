@@ -0,0 +1,1077 @@
+// Package sqlparse translates SQL WHERE clauses back to JSON Logic.
+//
+// It accepts the subset of SQL the transpiler emits (comparisons, AND/OR/NOT,
+// IN lists, BETWEEN, IS NULL, CASE, arithmetic, CONCAT/SUBSTR and
+// POSITION/STRPOS/INSTR/CHARINDEX containment checks) and reports anything else as an
+// unsupported construct with its line and column.
+package sqlparse
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// comparisonOps maps SQL comparison symbols to JSON Logic operators.
+// <> is what the transpiler emits for !==.
+var comparisonOps = map[string]string{
+	"=":  "==",
+	"!=": "!=",
+	"<>": "!==",
+	"<":  "<",
+	"<=": "<=",
+	">":  ">",
+	">=": ">=",
+}
+
+// numericCastTypes lists CAST target types that correspond to the unary "+" operator.
+var numericCastTypes = map[string]bool{
+	"NUMERIC": true, "DECIMAL": true, "BIGNUMERIC": true, "INT64": true, "FLOAT64": true,
+	"INTEGER": true, "INT": true, "BIGINT": true, "SMALLINT": true, "DOUBLE": true,
+	"REAL": true, "FLOAT": true, "FLOAT32": true, "INT32": true, "NUMBER": true,
+}
+
+// positionCall is an intermediate result for POSITION/STRPOS. It only has a
+// JSON Logic equivalent when compared with zero.
+type positionCall struct {
+	needle   any
+	haystack any
+	tok      token
+}
+
+// dataLength is an intermediate result for SQL Server DATALENGTH. It only has
+// a JSON Logic equivalent as the length bound of a two-argument SUBSTRING.
+type dataLength struct {
+	arg any
+	tok token
+}
+
+// parser is a recursive-descent parser over the token stream.
+type parser struct {
+	input   string
+	dialect dialect.Dialect
+	tokens  []token
+	i       int
+}
+
+// ToJSONLogic parses a SQL WHERE clause (with or without the WHERE keyword)
+// and returns the equivalent JSON Logic value.
+func ToJSONLogic(d dialect.Dialect, sql string) (any, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	tokens, err := tokenize(sql, d)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{input: sql, dialect: d, tokens: tokens}
+	if p.peek().is("WHERE") {
+		p.advance()
+	}
+	if p.peek().kind == tokEOF {
+		return nil, p.syntaxError(p.peek(), "empty expression")
+	}
+
+	result, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, p.unexpected(tok)
+	}
+	if err := p.checkResolved(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// peek returns the current token.
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+// peekAt returns the token offset positions ahead.
+func (p *parser) peekAt(offset int) token {
+	if p.i+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.i+offset]
+}
+
+// advance consumes and returns the current token.
+func (p *parser) advance() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokEOF {
+		p.i++
+	}
+	return tok
+}
+
+// expectSymbol consumes the symbol sym or returns a syntax error.
+func (p *parser) expectSymbol(sym string) error {
+	tok := p.peek()
+	if !tok.isSymbol(sym) {
+		return p.syntaxError(tok, fmt.Sprintf("expected %q, found %s", sym, describe(tok)))
+	}
+	p.advance()
+	return nil
+}
+
+// expectKeyword consumes the keyword kw or returns a syntax error.
+func (p *parser) expectKeyword(kw string) error {
+	tok := p.peek()
+	if !tok.is(kw) {
+		return p.syntaxError(tok, fmt.Sprintf("expected %s, found %s", kw, describe(tok)))
+	}
+	p.advance()
+	return nil
+}
+
+// parseOr parses a sequence of OR-ed conditions.
+func (p *parser) parseOr() (any, error) {
+	return p.parseJunction("OR", "or", p.parseAnd)
+}
+
+// parseAnd parses a sequence of AND-ed conditions.
+func (p *parser) parseAnd() (any, error) {
+	return p.parseJunction("AND", "and", p.parseNot)
+}
+
+// parseJunction parses operands separated by keyword into a single JSON Logic
+// operator. Parenthesized groups are kept nested.
+func (p *parser) parseJunction(keyword, operator string, next func() (any, error)) (any, error) {
+	first, err := next()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peek().is(keyword) {
+		return first, nil
+	}
+
+	args := []any{first}
+	for p.peek().is(keyword) {
+		p.advance()
+		operand, err := next()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, operand)
+	}
+	return map[string]any{operator: args}, nil
+}
+
+// parseNot parses NOT prefixes.
+func (p *parser) parseNot() (any, error) {
+	if p.peek().is("NOT") {
+		p.advance()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"!": operand}, nil
+	}
+	return p.parsePredicate()
+}
+
+// parsePredicate parses comparisons, IS, IN, BETWEEN and LIKE.
+func (p *parser) parsePredicate() (any, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	if tok.kind == tokSymbol {
+		if op, ok := comparisonOps[tok.text]; ok {
+			p.advance()
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			return p.comparison(op, left, right)
+		}
+		if tok.text == "<=>" {
+			return nil, p.unsupported(tok, tok.text, "null-safe equality has no JSON Logic equivalent")
+		}
+	}
+
+	if tok.is("IS") {
+		return p.parseIs(left)
+	}
+
+	negate := false
+	if tok.is("NOT") && (p.peekAt(1).is("IN") || p.peekAt(1).is("BETWEEN") || p.peekAt(1).is("LIKE") || p.peekAt(1).is("ILIKE")) {
+		negate = true
+		p.advance()
+		tok = p.peek()
+	}
+
+	var result any
+	switch {
+	case tok.is("IN"):
+		result, err = p.parseIn(left)
+	case tok.is("BETWEEN"):
+		result, err = p.parseBetween(left)
+	case tok.is("LIKE"):
+		result, err = p.parseLike(left)
+	case tok.is("ILIKE"), tok.is("SIMILAR"), tok.is("REGEXP"), tok.is("RLIKE"):
+		return nil, p.unsupported(tok, strings.ToUpper(tok.text), "pattern matching has no JSON Logic equivalent")
+	default:
+		return left, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if negate {
+		return map[string]any{"!": result}, nil
+	}
+	return result, nil
+}
+
+// comparison builds a comparison, turning POSITION(...) > 0 and equivalent
+// forms into "in".
+func (p *parser) comparison(op string, left, right any) (any, error) {
+	if pos, ok := left.(*positionCall); ok {
+		return p.positionComparison(pos, op, right, false)
+	}
+	if pos, ok := right.(*positionCall); ok {
+		return p.positionComparison(pos, op, left, true)
+	}
+	return map[string]any{op: []any{left, right}}, nil
+}
+
+// positionComparison converts POSITION(needle IN haystack) compared with a
+// constant into "in" or its negation. reversed is set when the constant is on
+// the left (0 < POSITION(...)).
+func (p *parser) positionComparison(pos *positionCall, op string, other any, reversed bool) (any, error) {
+	n, ok := other.(float64)
+	if ok && reversed {
+		switch op {
+		case "<":
+			op = ">"
+		case "<=":
+			op = ">="
+		case ">":
+			op = "<"
+		case ">=":
+			op = "<="
+		}
+	}
+
+	in := map[string]any{"in": []any{pos.needle, pos.haystack}}
+	switch {
+	case ok && n == 0 && (op == ">" || op == "!=" || op == "!=="):
+		return in, nil
+	case ok && n == 1 && op == ">=":
+		return in, nil
+	case ok && n == 0 && (op == "==" || op == "<="):
+		return map[string]any{"!": in}, nil
+	default:
+		return nil, p.unsupported(pos.tok, strings.ToUpper(pos.tok.text),
+			"string position is only supported in a containment check such as POSITION(a IN b) > 0")
+	}
+}
+
+// parseIs parses IS [NOT] NULL, IS [NOT] TRUE and IS [NOT] FALSE.
+func (p *parser) parseIs(left any) (any, error) {
+	p.advance()
+	negate := false
+	if p.peek().is("NOT") {
+		p.advance()
+		negate = true
+	}
+
+	tok := p.advance()
+	var value any
+	switch {
+	case tok.is("NULL"):
+		value = nil
+	case tok.is("TRUE"):
+		value = true
+	case tok.is("FALSE"):
+		value = false
+	case tok.is("DISTINCT"):
+		return nil, p.unsupported(tok, "IS DISTINCT FROM", "IS DISTINCT FROM has no JSON Logic equivalent")
+	default:
+		return nil, p.syntaxError(tok, fmt.Sprintf("expected NULL, TRUE or FALSE after IS, found %s", describe(tok)))
+	}
+
+	switch {
+	case value == nil && negate:
+		return map[string]any{"!=": []any{left, nil}}, nil
+	case value == nil:
+		return map[string]any{"==": []any{left, nil}}, nil
+	case negate:
+		// x IS NOT TRUE is also true when x is NULL.
+		return map[string]any{"or": []any{
+			map[string]any{"==": []any{left, nil}},
+			map[string]any{"==": []any{left, !value.(bool)}},
+		}}, nil
+	default:
+		return map[string]any{"==": []any{left, value}}, nil
+	}
+}
+
+// parseIn parses IN (list), IN column, IN UNNEST(column) and the SQLite and
+// SQL Server membership subqueries IN (SELECT value FROM json_each(column))
+// and IN (SELECT value FROM OPENJSON(column)).
+func (p *parser) parseIn(left any) (any, error) {
+	p.advance()
+	tok := p.peek()
+
+	if tok.isSymbol("(") {
+		if p.isJSONEachSubquery() {
+			for i := 0; i < 6; i++ {
+				p.advance()
+			}
+			array, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectSymbol(")"); err != nil {
+				return nil, err
+			}
+			if err := p.expectSymbol(")"); err != nil {
+				return nil, err
+			}
+			return map[string]any{"in": []any{left, array}}, nil
+		}
+		if p.peekAt(1).is("SELECT") {
+			return nil, p.unsupported(p.peekAt(1), "SELECT", "subqueries have no JSON Logic equivalent")
+		}
+		p.advance()
+		items, err := p.parseList(")")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"in": []any{left, items}}, nil
+	}
+
+	if tok.is("UNNEST") && p.peekAt(1).isSymbol("(") {
+		p.advance()
+		p.advance()
+		array, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+		return map[string]any{"in": []any{left, array}}, nil
+	}
+
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if _, isVar := asVar(right); !isVar {
+		return nil, p.unsupported(tok, "IN", "IN requires a parenthesized list or an array column")
+	}
+	return map[string]any{"in": []any{left, right}}, nil
+}
+
+// isJSONEachSubquery reports whether the next tokens open the subquery
+// (SELECT value FROM json_each( or (SELECT value FROM OPENJSON( that SQLite
+// and SQL Server use for array membership.
+func (p *parser) isJSONEachSubquery() bool {
+	return p.peek().isSymbol("(") && p.peekAt(1).is("SELECT") && p.peekAt(2).is("value") &&
+		p.peekAt(3).is("FROM") && (p.peekAt(4).is("json_each") || p.peekAt(4).is("OPENJSON")) &&
+		p.peekAt(5).isSymbol("(")
+}
+
+// parseBetween parses BETWEEN low AND high into a chained <=.
+func (p *parser) parseBetween(left any) (any, error) {
+	p.advance()
+	low, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("AND"); err != nil {
+		return nil, err
+	}
+	high, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"<=": []any{low, left, high}}, nil
+}
+
+// parseLike parses LIKE '%text%', the only LIKE pattern with a JSON Logic
+// equivalent (substring containment).
+func (p *parser) parseLike(left any) (any, error) {
+	likeTok := p.advance()
+	tok := p.peek()
+	if tok.kind == tokString && len(tok.text) >= 2 &&
+		strings.HasPrefix(tok.text, "%") && strings.HasSuffix(tok.text, "%") {
+		inner := tok.text[1 : len(tok.text)-1]
+		if !strings.ContainsAny(inner, "%_\\") {
+			p.advance()
+			return map[string]any{"in": []any{inner, left}}, nil
+		}
+	}
+	return nil, p.unsupported(likeTok, "LIKE", "only LIKE '%text%' containment patterns are supported")
+}
+
+// parseAdditive parses +, - and || chains.
+func (p *parser) parseAdditive() (any, error) {
+	return p.parseBinary(map[string]string{"+": "+", "-": "-", "||": "cat"}, p.parseMultiplicative)
+}
+
+// parseMultiplicative parses *, / and % chains.
+func (p *parser) parseMultiplicative() (any, error) {
+	return p.parseBinary(map[string]string{"*": "*", "/": "/", "%": "%"}, p.parseUnary)
+}
+
+// parseBinary parses left-associative binary operators. Runs of the
+// associative operators +, * and || are flattened into one argument list.
+func (p *parser) parseBinary(ops map[string]string, next func() (any, error)) (any, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		current string
+		args    []any
+	)
+	for {
+		tok := p.peek()
+		op, ok := ops[tok.text]
+		if tok.kind != tokSymbol || !ok {
+			break
+		}
+		p.advance()
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+
+		if current == op && (op == "+" || op == "*" || op == "cat") {
+			args = append(args, right)
+			continue
+		}
+		if current != "" {
+			left = map[string]any{current: args}
+		}
+		current = op
+		args = []any{left, right}
+	}
+	if current == "" {
+		return left, nil
+	}
+	return map[string]any{current: args}, nil
+}
+
+// parseUnary parses unary minus and plus.
+func (p *parser) parseUnary() (any, error) {
+	tok := p.peek()
+	if tok.isSymbol("-") || tok.isSymbol("+") {
+		p.advance()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if tok.text == "+" {
+			return operand, nil
+		}
+		if n, ok := operand.(float64); ok {
+			return -n, nil
+		}
+		return map[string]any{"-": []any{operand}}, nil
+	}
+	return p.parsePostfix()
+}
+
+// parsePostfix parses a primary expression followed by :: casts.
+func (p *parser) parsePostfix() (any, error) {
+	value, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		switch {
+		case tok.isSymbol("::"):
+			p.advance()
+			typeTok := p.peek()
+			typeName, err := p.parseTypeName()
+			if err != nil {
+				return nil, err
+			}
+			if value, err = p.cast(typeTok, value, typeName); err != nil {
+				return nil, err
+			}
+		case tok.isSymbol("["):
+			return nil, p.unsupported(tok, "[]", "array subscripts have no JSON Logic equivalent")
+		case tok.isSymbol("->"), tok.isSymbol("->>"):
+			return nil, p.unsupported(tok, tok.text, "JSON operators have no JSON Logic equivalent")
+		default:
+			return value, nil
+		}
+	}
+}
+
+// parsePrimary parses literals, column references, parentheses, CASE and
+// function calls.
+func (p *parser) parsePrimary() (any, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokNumber:
+		p.advance()
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.syntaxError(tok, fmt.Sprintf("invalid number %q", tok.text))
+		}
+		return n, nil
+	case tokString:
+		p.advance()
+		return tok.text, nil
+	case tokPlaceholder:
+		return nil, p.unsupported(tok, tok.text, "bind placeholders have no value to translate; pass the inlined SQL")
+	case tokQuotedIdent:
+		return p.parseColumn()
+	case tokSymbol:
+		if tok.isSymbol("(") {
+			return p.parseParenthesized()
+		}
+		return nil, p.unexpected(tok)
+	case tokEOF:
+		return nil, p.syntaxError(tok, "unexpected end of input")
+	}
+
+	switch {
+	case tok.is("TRUE"):
+		p.advance()
+		return true, nil
+	case tok.is("FALSE"):
+		p.advance()
+		return false, nil
+	case tok.is("NULL"):
+		p.advance()
+		return nil, nil
+	case tok.is("CASE"):
+		return p.parseCase()
+	case tok.is("CAST") && p.peekAt(1).isSymbol("("):
+		return p.parseCast()
+	case tok.is("EXISTS"), tok.is("SELECT"):
+		return nil, p.unsupported(tok, strings.ToUpper(tok.text), "subqueries have no JSON Logic equivalent")
+	case tok.is("INTERVAL"), tok.is("DATE"), tok.is("TIMESTAMP"), tok.is("ARRAY"):
+		if !p.peekAt(1).isSymbol("(") {
+			return nil, p.unsupported(tok, strings.ToUpper(tok.text), "typed literals have no JSON Logic equivalent")
+		}
+	}
+
+	if p.peekAt(1).isSymbol("(") {
+		return p.parseFunction()
+	}
+	if dialect.IsReservedKeyword(tok.text) {
+		return nil, p.unexpected(tok)
+	}
+	return p.parseColumn()
+}
+
+// parseParenthesized parses ( expr ), rejecting subqueries.
+func (p *parser) parseParenthesized() (any, error) {
+	p.advance()
+	if tok := p.peek(); tok.is("SELECT") {
+		return nil, p.unsupported(tok, "SELECT", "subqueries have no JSON Logic equivalent")
+	}
+	value, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// parseColumn parses a possibly qualified column reference into a var.
+func (p *parser) parseColumn() (any, error) {
+	var segments []string
+	for {
+		tok := p.peek()
+		if tok.kind != tokIdent && tok.kind != tokQuotedIdent {
+			return nil, p.syntaxError(tok, fmt.Sprintf("expected column name, found %s", describe(tok)))
+		}
+		p.advance()
+		segments = append(segments, tok.text)
+		if !p.peek().isSymbol(".") {
+			break
+		}
+		p.advance()
+	}
+	return map[string]any{"var": strings.Join(segments, ".")}, nil
+}
+
+// parseList parses comma-separated expressions up to the closing symbol.
+func (p *parser) parseList(closing string) ([]any, error) {
+	items := []any{}
+	if p.peek().isSymbol(closing) {
+		p.advance()
+		return items, nil
+	}
+	for {
+		item, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if p.peek().isSymbol(",") {
+			p.advance()
+			continue
+		}
+		if err := p.expectSymbol(closing); err != nil {
+			return nil, err
+		}
+		return items, nil
+	}
+}
+
+// parseCase parses searched and simple CASE expressions into "if".
+func (p *parser) parseCase() (any, error) {
+	p.advance()
+	var operand any
+	hasOperand := false
+	if !p.peek().is("WHEN") {
+		value, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		operand, hasOperand = value, true
+	}
+
+	var args []any
+	for p.peek().is("WHEN") {
+		p.advance()
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if hasOperand {
+			cond = map[string]any{"==": []any{operand, cond}}
+		}
+		if err := p.expectKeyword("THEN"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, cond, value)
+	}
+	if len(args) == 0 {
+		return nil, p.syntaxError(p.peek(), "CASE requires at least one WHEN clause")
+	}
+
+	if p.peek().is("ELSE") {
+		p.advance()
+		value, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, value)
+	}
+	if err := p.expectKeyword("END"); err != nil {
+		return nil, err
+	}
+	return map[string]any{"if": args}, nil
+}
+
+// parseCast parses CAST(expr AS type).
+func (p *parser) parseCast() (any, error) {
+	castTok := p.advance()
+	p.advance()
+	value, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("AS"); err != nil {
+		return nil, err
+	}
+	typeName, err := p.parseTypeName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+	return p.cast(castTok, value, typeName)
+}
+
+// parseTypeName parses a type name with optional parameters, e.g. NUMERIC(10, 2).
+func (p *parser) parseTypeName() (string, error) {
+	tok := p.peek()
+	if tok.kind != tokIdent {
+		return "", p.syntaxError(tok, fmt.Sprintf("expected type name, found %s", describe(tok)))
+	}
+	p.advance()
+	name := strings.ToUpper(tok.text)
+	if name == "DOUBLE" && p.peek().is("PRECISION") {
+		p.advance()
+	}
+	if p.peek().isSymbol("(") {
+		for tok := p.advance(); !tok.isSymbol(")"); tok = p.advance() {
+			if tok.kind == tokEOF {
+				return "", p.syntaxError(tok, "unterminated type parameters")
+			}
+		}
+	}
+	return name, nil
+}
+
+// cast converts a numeric CAST into the unary "+" operator. Snowflake VARIANT
+// casts only wrap values for ARRAY_CONTAINS and are dropped.
+func (p *parser) cast(tok token, value any, typeName string) (any, error) {
+	if typeName == "VARIANT" {
+		return value, nil
+	}
+	if !numericCastTypes[typeName] {
+		return nil, p.unsupported(tok, "CAST", fmt.Sprintf("cast to %s has no JSON Logic equivalent", typeName))
+	}
+	return map[string]any{"+": []any{value}}, nil
+}
+
+// parseFunction parses a function call.
+func (p *parser) parseFunction() (any, error) {
+	nameTok := p.advance()
+	name := strings.ToUpper(nameTok.text)
+	p.advance()
+
+	switch name {
+	case "POSITION":
+		return p.parsePosition(nameTok)
+	case "JSON_EXISTS":
+		return p.parseJSONExists(nameTok)
+	case "SUBSTRING":
+		if value, ok, err := p.parseSubstringFrom(); ok || err != nil {
+			return value, err
+		}
+	}
+
+	args, err := p.parseList(")")
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "CONCAT":
+		if err := p.requireArgs(nameTok, args, 1, -1); err != nil {
+			return nil, err
+		}
+		return map[string]any{"cat": args}, nil
+	case "SUBSTR", "SUBSTRING":
+		if err := p.requireArgs(nameTok, args, 2, 3); err != nil {
+			return nil, err
+		}
+		// SQL Server spells the two-argument form SUBSTRING(s, start, DATALENGTH(s)).
+		if len(args) == 3 {
+			if length, ok := args[2].(*dataLength); ok && reflect.DeepEqual(length.arg, args[0]) {
+				args = args[:2]
+			}
+		}
+		return substr(args[0], args[1], args[2:]), nil
+	case "DATALENGTH":
+		if err := p.requireArgs(nameTok, args, 1, 1); err != nil {
+			return nil, err
+		}
+		return &dataLength{arg: args[0], tok: nameTok}, nil
+	case "STRPOS", "INSTR":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		return &positionCall{needle: args[1], haystack: args[0], tok: nameTok}, nil
+	case "LOCATE":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		return &positionCall{needle: args[0], haystack: args[1], tok: nameTok}, nil
+	case "CHARINDEX":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		return &positionCall{needle: args[0], haystack: args[1], tok: nameTok}, nil
+	case "JSON_ARRAY":
+		return args, nil
+	case "JSON_CONTAINS":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		if _, ok := asVar(args[0]); ok {
+			if candidate, ok := args[1].([]any); ok && len(candidate) == 1 {
+				return map[string]any{"in": []any{candidate[0], args[0]}}, nil
+			}
+		}
+		return nil, p.unsupported(nameTok, name, "JSON_CONTAINS is only supported as a column containing JSON_ARRAY(value)")
+	case "ARRAY_CONTAINS":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		// Spark SQL takes the array first, Snowflake takes the value first.
+		if p.dialect == dialect.DialectSparkSQL {
+			args[0], args[1] = args[1], args[0]
+		}
+		if _, ok := asVar(args[1]); ok {
+			return map[string]any{"in": []any{args[0], args[1]}}, nil
+		}
+		return nil, p.unsupported(nameTok, name, "ARRAY_CONTAINS is only supported with a column as the array")
+	case "CONTAINS":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		if _, ok := asVar(args[0]); ok {
+			return map[string]any{"in": []any{args[1], args[0]}}, nil
+		}
+		return nil, p.unsupported(nameTok, name, "CONTAINS is only supported with a column as the array")
+	case "GREATEST", "LEAST":
+		if err := p.requireArgs(nameTok, args, 1, -1); err != nil {
+			return nil, err
+		}
+		op := "max"
+		if name == "LEAST" {
+			op = "min"
+		}
+		return map[string]any{op: args}, nil
+	case "MAX", "MIN":
+		// Only the multi-argument scalar form (SQLite) maps to JSON Logic;
+		// single-argument MAX/MIN are aggregates.
+		if err := p.requireArgs(nameTok, args, 2, -1); err != nil {
+			return nil, err
+		}
+		return map[string]any{strings.ToLower(name): args}, nil
+	case "MOD":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		return map[string]any{"%": args}, nil
+	case "IF", "IFF":
+		if err := p.requireArgs(nameTok, args, 3, 3); err != nil {
+			return nil, err
+		}
+		return map[string]any{"if": args}, nil
+	case "COALESCE", "IFNULL":
+		if err := p.requireArgs(nameTok, args, 2, 2); err != nil {
+			return nil, err
+		}
+		if varName, ok := asVar(args[0]); ok && isLiteral(args[1]) {
+			return map[string]any{"var": []any{varName, args[1]}}, nil
+		}
+		return nil, p.unsupported(nameTok, name, "COALESCE is only supported as a column with a literal default")
+	default:
+		return nil, p.unsupported(nameTok, name, fmt.Sprintf("function %s has no JSON Logic equivalent", name))
+	}
+}
+
+// parsePosition parses POSITION(needle IN haystack) and ClickHouse
+// position(haystack, needle).
+func (p *parser) parsePosition(nameTok token) (any, error) {
+	first, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().is("IN") {
+		p.advance()
+		haystack, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+		return &positionCall{needle: first, haystack: haystack, tok: nameTok}, nil
+	}
+
+	if err := p.expectSymbol(","); err != nil {
+		return nil, err
+	}
+	needle, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+	return &positionCall{needle: needle, haystack: first, tok: nameTok}, nil
+}
+
+// oracleMembershipPath is the JSON_EXISTS filter the transpiler emits for
+// Oracle array membership, with the value passed as $v.
+const oracleMembershipPath = "$[*]?(@ == $v)"
+
+// parseJSONExists parses Oracle's JSON_EXISTS(arr, '$[*]?(@ == $v)' PASSING
+// value AS "v"), which is array membership. Other paths have no JSON Logic
+// equivalent.
+func (p *parser) parseJSONExists(nameTok token) (any, error) {
+	array, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectSymbol(","); err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokString || tok.text != oracleMembershipPath {
+		return nil, p.unsupported(nameTok, "JSON_EXISTS", "JSON_EXISTS is only supported as array membership")
+	}
+	p.advance()
+	if err := p.expectKeyword("PASSING"); err != nil {
+		return nil, err
+	}
+	value, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("AS"); err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokQuotedIdent || tok.text != "v" {
+		return nil, p.syntaxError(tok, fmt.Sprintf(`expected "v", found %s`, describe(tok)))
+	}
+	p.advance()
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+	if _, ok := asVar(array); !ok {
+		return nil, p.unsupported(nameTok, "JSON_EXISTS", "JSON_EXISTS is only supported with a column as the array")
+	}
+	return map[string]any{"in": []any{value, array}}, nil
+}
+
+// parseSubstringFrom parses SUBSTRING(s FROM start [FOR length]). It returns
+// ok=false without consuming input when the call uses commas.
+func (p *parser) parseSubstringFrom() (any, bool, error) {
+	mark := p.i
+	value, err := p.parseAdditive()
+	if err != nil || !p.peek().is("FROM") {
+		p.i = mark
+		return nil, false, nil
+	}
+	p.advance()
+	start, err := p.parseAdditive()
+	if err != nil {
+		return nil, true, err
+	}
+	var length []any
+	if p.peek().is("FOR") {
+		p.advance()
+		l, err := p.parseAdditive()
+		if err != nil {
+			return nil, true, err
+		}
+		length = []any{l}
+	}
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, true, err
+	}
+	return substr(value, start, length), true, nil
+}
+
+// requireArgs checks the argument count of a function call.
+func (p *parser) requireArgs(tok token, args []any, minArgs, maxArgs int) error {
+	if len(args) < minArgs || (maxArgs >= 0 && len(args) > maxArgs) {
+		return p.syntaxError(tok, fmt.Sprintf("wrong number of arguments to %s: %d", strings.ToUpper(tok.text), len(args)))
+	}
+	return nil
+}
+
+// substr builds "substr", converting the 1-based SQL start position to the
+// 0-based JSON Logic start.
+func substr(value, start any, length []any) any {
+	args := []any{value, zeroBasedStart(start)}
+	args = append(args, length...)
+	return map[string]any{"substr": args}
+}
+
+// zeroBasedStart undoes the start + 1 conversion the transpiler applies.
+func zeroBasedStart(start any) any {
+	if n, ok := start.(float64); ok {
+		return n - 1
+	}
+	if m, ok := start.(map[string]any); ok {
+		if args, ok := m["+"].([]any); ok && len(args) == 2 && args[1] == float64(1) {
+			return args[0]
+		}
+	}
+	return map[string]any{"-": []any{start, float64(1)}}
+}
+
+// asVar returns the variable name if value is a plain {"var": name}.
+func asVar(value any) (string, bool) {
+	m, ok := value.(map[string]any)
+	if !ok || len(m) != 1 {
+		return "", false
+	}
+	name, ok := m["var"].(string)
+	return name, ok
+}
+
+// isLiteral reports whether value is a JSON Logic primitive.
+func isLiteral(value any) bool {
+	switch value.(type) {
+	case nil, bool, float64, string:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkResolved reports POSITION/STRPOS calls that were not part of a
+// containment check and DATALENGTH calls outside SUBSTRING.
+func (p *parser) checkResolved(value any) error {
+	switch v := value.(type) {
+	case *positionCall:
+		return p.unsupported(v.tok, strings.ToUpper(v.tok.text),
+			"string position is only supported in a containment check such as POSITION(a IN b) > 0")
+	case *dataLength:
+		return p.unsupported(v.tok, "DATALENGTH", "DATALENGTH is only supported as the length of SUBSTRING(s, start, DATALENGTH(s))")
+	case map[string]any:
+		for _, arg := range v {
+			if err := p.checkResolved(arg); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, arg := range v {
+			if err := p.checkResolved(arg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unexpected reports an unexpected token.
+func (p *parser) unexpected(tok token) error {
+	return p.syntaxError(tok, fmt.Sprintf("unexpected %s", describe(tok)))
+}
+
+// syntaxError reports invalid SQL at tok.
+func (p *parser) syntaxError(tok token, message string) error {
+	return errorAt(p.input, tok.pos, tperrors.ErrInvalidSQL, "", message)
+}
+
+// unsupported reports a valid SQL construct with no JSON Logic equivalent.
+func (p *parser) unsupported(tok token, construct, message string) error {
+	return errorAt(p.input, tok.pos, tperrors.ErrUnsupportedSQL, construct, message)
+}
+
+// describe formats a token for error messages.
+func describe(tok token) string {
+	switch tok.kind {
+	case tokEOF:
+		return "end of input"
+	case tokString:
+		return fmt.Sprintf("string '%s'", tok.text)
+	case tokIdent:
+		if dialect.IsReservedKeyword(tok.text) {
+			return fmt.Sprintf("keyword %s", strings.ToUpper(tok.text))
+		}
+		return fmt.Sprintf("identifier %s", tok.text)
+	default:
+		return fmt.Sprintf("%q", tok.text)
+	}
+}
@@ -0,0 +1,198 @@
+package sqlparse
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/h22rana/jsonlogic2sql/internal/dialect"
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+)
+
+// asTranspileError extracts a TranspileError or fails the test.
+func asTranspileError(t *testing.T, err error) *tperrors.TranspileError {
+	t.Helper()
+	var tpErr *tperrors.TranspileError
+	if !errors.As(err, &tpErr) {
+		t.Fatalf("error = %v, want TranspileError", err)
+	}
+	return tpErr
+}
+
+func TestToJSONLogic(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		sql     string
+		want    string
+	}{
+		{"comparison", dialect.DialectBigQuery, "WHERE amount > 1000", `{">": [{"var": "amount"}, 1000]}`},
+		{"without WHERE", dialect.DialectBigQuery, "amount <= 10.5", `{"<=": [{"var": "amount"}, 10.5]}`},
+		{"equality", dialect.DialectPostgreSQL, "status = 'active'", `{"==": [{"var": "status"}, "active"]}`},
+		{"not equal", dialect.DialectPostgreSQL, "status != 'x'", `{"!=": [{"var": "status"}, "x"]}`},
+		{"strict not equal", dialect.DialectPostgreSQL, "status <> 'x'", `{"!==": [{"var": "status"}, "x"]}`},
+		{"qualified column", dialect.DialectPostgreSQL, "t.user.age >= 18", `{">=": [{"var": "t.user.age"}, 18]}`},
+		{"quoted column", dialect.DialectPostgreSQL, `"order" = 1`, `{"==": [{"var": "order"}, 1]}`},
+		{"and or", dialect.DialectBigQuery, "a = 1 AND b = 2 OR c = 3",
+			`{"or": [{"and": [{"==": [{"var": "a"}, 1]}, {"==": [{"var": "b"}, 2]}]}, {"==": [{"var": "c"}, 3]}]}`},
+		{"parenthesized group", dialect.DialectBigQuery, "(a = 1 AND (b = 2 AND c = 3))",
+			`{"and": [{"==": [{"var": "a"}, 1]}, {"and": [{"==": [{"var": "b"}, 2]}, {"==": [{"var": "c"}, 3]}]}]}`},
+		{"not", dialect.DialectBigQuery, "NOT (a = 1)", `{"!": {"==": [{"var": "a"}, 1]}}`},
+		{"in list", dialect.DialectBigQuery, "status IN ('a', 'b')", `{"in": [{"var": "status"}, ["a", "b"]]}`},
+		{"not in list", dialect.DialectBigQuery, "status NOT IN (1, 2)", `{"!": {"in": [{"var": "status"}, [1, 2]]}}`},
+		{"in array column", dialect.DialectPostgreSQL, "'vip' IN tags", `{"in": ["vip", {"var": "tags"}]}`},
+		{"in unnest", dialect.DialectBigQuery, "'vip' IN UNNEST(tags)", `{"in": ["vip", {"var": "tags"}]}`},
+		{"between", dialect.DialectDuckDB, "age BETWEEN 18 AND 65", `{"<=": [18, {"var": "age"}, 65]}`},
+		{"not between", dialect.DialectDuckDB, "age NOT BETWEEN 18 AND 65", `{"!": {"<=": [18, {"var": "age"}, 65]}}`},
+		{"is null", dialect.DialectBigQuery, "deleted_at IS NULL", `{"==": [{"var": "deleted_at"}, null]}`},
+		{"is not null", dialect.DialectBigQuery, "deleted_at IS NOT NULL", `{"!=": [{"var": "deleted_at"}, null]}`},
+		{"is true", dialect.DialectBigQuery, "active IS TRUE", `{"==": [{"var": "active"}, true]}`},
+		{"is not false", dialect.DialectBigQuery, "active IS NOT FALSE",
+			`{"or": [{"==": [{"var": "active"}, null]}, {"==": [{"var": "active"}, true]}]}`},
+		{"case", dialect.DialectBigQuery, "CASE WHEN score > 90 THEN 'A' WHEN score > 80 THEN 'B' ELSE 'C' END = 'A'",
+			`{"==": [{"if": [{">": [{"var": "score"}, 90]}, "A", {">": [{"var": "score"}, 80]}, "B", "C"]}, "A"]}`},
+		{"simple case", dialect.DialectBigQuery, "CASE tier WHEN 'gold' THEN 1 END = 1",
+			`{"==": [{"if": [{"==": [{"var": "tier"}, "gold"]}, 1]}, 1]}`},
+		{"arithmetic precedence", dialect.DialectBigQuery, "a + b * 2 > 10",
+			`{">": [{"+": [{"var": "a"}, {"*": [{"var": "b"}, 2]}]}, 10]}`},
+		{"flattened addition", dialect.DialectBigQuery, "(a + b + c) > 0", `{">": [{"+": [{"var": "a"}, {"var": "b"}, {"var": "c"}]}, 0]}`},
+		{"left-associative subtraction", dialect.DialectBigQuery, "a - b - c > 0",
+			`{">": [{"-": [{"-": [{"var": "a"}, {"var": "b"}]}, {"var": "c"}]}, 0]}`},
+		{"modulo", dialect.DialectBigQuery, "MOD(a, 2) = 0", `{"==": [{"%": [{"var": "a"}, 2]}, 0]}`},
+		{"negation", dialect.DialectBigQuery, "(-a) < -5", `{"<": [{"-": [{"var": "a"}]}, -5]}`},
+		{"numeric cast", dialect.DialectBigQuery, "CAST(a AS NUMERIC) > 1", `{">": [{"+": [{"var": "a"}]}, 1]}`},
+		{"postgres cast", dialect.DialectPostgreSQL, "a::numeric(10, 2) > 1", `{">": [{"+": [{"var": "a"}]}, 1]}`},
+		{"greatest least", dialect.DialectBigQuery, "GREATEST(a, b) > LEAST(c, 1)",
+			`{">": [{"max": [{"var": "a"}, {"var": "b"}]}, {"min": [{"var": "c"}, 1]}]}`},
+		{"concat", dialect.DialectBigQuery, "CONCAT(first, ' ', last) = 'A B'",
+			`{"==": [{"cat": [{"var": "first"}, " ", {"var": "last"}]}, "A B"]}`},
+		{"concat operator", dialect.DialectPostgreSQL, "first || last = 'AB'",
+			`{"==": [{"cat": [{"var": "first"}, {"var": "last"}]}, "AB"]}`},
+		{"substr literal start", dialect.DialectBigQuery, "SUBSTR(name, 1, 3) = 'abc'",
+			`{"==": [{"substr": [{"var": "name"}, 0, 3]}, "abc"]}`},
+		{"substr expression start", dialect.DialectBigQuery, "SUBSTR(name, (i + 1)) = 'z'",
+			`{"==": [{"substr": [{"var": "name"}, {"var": "i"}]}, "z"]}`},
+		{"substr other start", dialect.DialectBigQuery, "SUBSTR(name, i) = 'z'",
+			`{"==": [{"substr": [{"var": "name"}, {"-": [{"var": "i"}, 1]}]}, "z"]}`},
+		{"substring from for", dialect.DialectPostgreSQL, "SUBSTRING(name FROM 2 FOR 3) = 'abc'",
+			`{"==": [{"substr": [{"var": "name"}, 1, 3]}, "abc"]}`},
+		{"clickhouse substring", dialect.DialectClickHouse, "substring(name, 2) = 'x'",
+			`{"==": [{"substr": [{"var": "name"}, 1]}, "x"]}`},
+		{"position", dialect.DialectPostgreSQL, "POSITION('ab' IN name) > 0", `{"in": ["ab", {"var": "name"}]}`},
+		{"strpos", dialect.DialectBigQuery, "STRPOS(name, 'ab') > 0", `{"in": ["ab", {"var": "name"}]}`},
+		{"clickhouse position", dialect.DialectClickHouse, "position(name, 'ab') > 0", `{"in": ["ab", {"var": "name"}]}`},
+		{"mysql locate", dialect.DialectMySQL, "LOCATE('ab', name) > 0", `{"in": ["ab", {"var": "name"}]}`},
+		{"mysql json membership", dialect.DialectMySQL, "JSON_CONTAINS(tags, JSON_ARRAY('a'))", `{"in": ["a", {"var": "tags"}]}`},
+		{"mysql identifiers and strings", dialect.DialectMySQL, "`order` = \"it's\" AND `a``b` = 'x\\Zy'",
+			`{"and": [{"==": [{"var": "order"}, "it's"]}, {"==": [{"var": "a` + "`" + `b"}, "x\u001ay"]}]}`},
+		{"sqlite instr", dialect.DialectSQLite, "instr(name, 'ab') > 0", `{"in": ["ab", {"var": "name"}]}`},
+		{"sqlite json membership", dialect.DialectSQLite, "'a' IN (SELECT value FROM json_each(tags))", `{"in": ["a", {"var": "tags"}]}`},
+		{"sqlite max min", dialect.DialectSQLite, "max(a, b) > min(c, 1)",
+			`{">": [{"max": [{"var": "a"}, {"var": "b"}]}, {"min": [{"var": "c"}, 1]}]}`},
+		{"snowflake array membership", dialect.DialectSnowflake, "ARRAY_CONTAINS('a'::VARIANT, tags)", `{"in": ["a", {"var": "tags"}]}`},
+		{"snowflake iff", dialect.DialectSnowflake, "IFF(a > 1, 'x', 'y') = 'x'",
+			`{"==": [{"if": [{">": [{"var": "a"}, 1]}, "x", "y"]}, "x"]}`},
+		{"snowflake escapes", dialect.DialectSnowflake, `name = 'O\'Brien'`, `{"==": [{"var": "name"}, "O'Brien"]}`},
+		{"sqlserver charindex", dialect.DialectSQLServer, "CHARINDEX('ab', name) > 0", `{"in": ["ab", {"var": "name"}]}`},
+		{"sqlserver json membership", dialect.DialectSQLServer, "'a' IN (SELECT value FROM OPENJSON(tags))", `{"in": ["a", {"var": "tags"}]}`},
+		{"sqlserver open-ended substring", dialect.DialectSQLServer, "SUBSTRING(code, 3, DATALENGTH(code)) = 'Z'",
+			`{"==": [{"substr": [{"var": "code"}, 2]}, "Z"]}`},
+		{"sqlserver identifiers and strings", dialect.DialectSQLServer, "[order] = N'日本' AND [a]]b] = 'x'",
+			`{"and": [{"==": [{"var": "order"}, "日本"]}, {"==": [{"var": "a]b"}, "x"]}]}`},
+		{"trino array membership", dialect.DialectTrino, "contains(tags, 'a')", `{"in": ["a", {"var": "tags"}]}`},
+		{"trino strpos", dialect.DialectTrino, "strpos(name, 'ab') > 0 AND \"values\" = 1",
+			`{"and": [{"in": ["ab", {"var": "name"}]}, {"==": [{"var": "values"}, 1]}]}`},
+		{"oracle array membership", dialect.DialectOracle, `JSON_EXISTS(tags, '$[*]?(@ == $v)' PASSING 'a' AS "v")`,
+			`{"in": ["a", {"var": "tags"}]}`},
+		{"oracle instr and mod", dialect.DialectOracle, "INSTR(name, 'ab') > 0 AND MOD(n, 2) = 0",
+			`{"and": [{"in": ["ab", {"var": "name"}]}, {"==": [{"%": [{"var": "n"}, 2]}, 0]}]}`},
+		{"sparksql array membership", dialect.DialectSparkSQL, "array_contains(tags, 'a')", `{"in": ["a", {"var": "tags"}]}`},
+		{"sparksql instr and identifiers", dialect.DialectSparkSQL, "instr(name, \"ab\") > 0 AND `filter` = 'x\\u0007'",
+			`{"and": [{"in": ["ab", {"var": "name"}]}, {"==": [{"var": "filter"}, "x\u0007"]}]}`},
+		{"position reversed", dialect.DialectBigQuery, "0 < STRPOS(name, 'ab')", `{"in": ["ab", {"var": "name"}]}`},
+		{"position not found", dialect.DialectBigQuery, "STRPOS(name, 'ab') = 0", `{"!": {"in": ["ab", {"var": "name"}]}}`},
+		{"like contains", dialect.DialectBigQuery, "name LIKE '%ab%'", `{"in": ["ab", {"var": "name"}]}`},
+		{"coalesce default", dialect.DialectBigQuery, "COALESCE(status, 'new') = 'new'",
+			`{"==": [{"var": ["status", "new"]}, "new"]}`},
+		{"literals", dialect.DialectBigQuery, "flag = TRUE AND other = FALSE",
+			`{"and": [{"==": [{"var": "flag"}, true]}, {"==": [{"var": "other"}, false]}]}`},
+		{"lowercase keywords", dialect.DialectBigQuery, "where a = 1 and not b is null",
+			`{"and": [{"==": [{"var": "a"}, 1]}, {"!": {"==": [{"var": "b"}, null]}}]}`},
+		{"comments and newlines", dialect.DialectPostgreSQL, "a = 1 -- first\n/* second */ OR b = 2",
+			`{"or": [{"==": [{"var": "a"}, 1]}, {"==": [{"var": "b"}, 2]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToJSONLogic(tt.dialect, tt.sql)
+			if err != nil {
+				t.Fatalf("ToJSONLogic(%q) error = %v", tt.sql, err)
+			}
+			var want any
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatalf("invalid test JSON: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				gotJSON, _ := json.Marshal(got)
+				t.Errorf("ToJSONLogic(%q) = %s, want %s", tt.sql, gotJSON, tt.want)
+			}
+		})
+	}
+}
+
+func TestToJSONLogic_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		sql       string
+		code      tperrors.ErrorCode
+		construct string
+		line      int
+		column    int
+	}{
+		{"empty", "WHERE ", tperrors.ErrInvalidSQL, "", 1, 7},
+		{"trailing operator", "a >", tperrors.ErrInvalidSQL, "", 1, 4},
+		{"trailing tokens", "a = 1 b", tperrors.ErrInvalidSQL, "", 1, 7},
+		{"missing paren", "(a = 1", tperrors.ErrInvalidSQL, "", 1, 7},
+		{"bare keyword", "a = SELECT", tperrors.ErrUnsupportedSQL, "SELECT", 1, 5},
+		{"unknown function", "a > 1 AND\n  ABS(b) > 2", tperrors.ErrUnsupportedSQL, "ABS", 2, 3},
+		{"like prefix", "name LIKE 'ab%'", tperrors.ErrUnsupportedSQL, "LIKE", 1, 6},
+		{"ilike", "name ILIKE '%ab%'", tperrors.ErrUnsupportedSQL, "ILIKE", 1, 6},
+		{"placeholder", "a = @p1", tperrors.ErrUnsupportedSQL, "@p1", 1, 5},
+		{"subquery", "a IN (SELECT id FROM t)", tperrors.ErrUnsupportedSQL, "SELECT", 1, 7},
+		{"exists", "EXISTS (SELECT 1)", tperrors.ErrUnsupportedSQL, "EXISTS", 1, 1},
+		{"bare position", "STRPOS(name, 'a') = 3", tperrors.ErrUnsupportedSQL, "STRPOS", 1, 1},
+		{"position in arithmetic", "STRPOS(name, 'a') + 1 > 0", tperrors.ErrUnsupportedSQL, "STRPOS", 1, 1},
+		{"non-numeric cast", "CAST(a AS STRING) = 'x'", tperrors.ErrUnsupportedSQL, "CAST", 1, 1},
+		{"coalesce expression", "COALESCE(a + 1, 0) > 1", tperrors.ErrUnsupportedSQL, "COALESCE", 1, 1},
+		{"is distinct", "a IS DISTINCT FROM b", tperrors.ErrUnsupportedSQL, "IS DISTINCT FROM", 1, 6},
+		{"wrong arity", "SUBSTR(a) = 'x'", tperrors.ErrInvalidSQL, "", 1, 1},
+		{"typed literal", "d > DATE '2024-01-01'", tperrors.ErrUnsupportedSQL, "DATE", 1, 5},
+		{"json contains document", "JSON_CONTAINS(tags, '[1]')", tperrors.ErrUnsupportedSQL, "JSON_CONTAINS", 1, 1},
+		{"bare datalength", "DATALENGTH(a) > 1", tperrors.ErrUnsupportedSQL, "DATALENGTH", 1, 1},
+		{"contains literal array", "contains('ab', 'a')", tperrors.ErrUnsupportedSQL, "CONTAINS", 1, 1},
+		{"json exists path", "JSON_EXISTS(tags, '$[0]')", tperrors.ErrUnsupportedSQL, "JSON_EXISTS", 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ToJSONLogic(dialect.DialectBigQuery, tt.sql)
+			if err == nil {
+				t.Fatalf("ToJSONLogic(%q) expected error", tt.sql)
+			}
+			tpErr := asTranspileError(t, err)
+			if tpErr.Code != tt.code || tpErr.Operator != tt.construct {
+				t.Errorf("code/construct = %s/%q, want %s/%q (%v)", tpErr.Code, tpErr.Operator, tt.code, tt.construct, err)
+			}
+			if tpErr.Line != tt.line || tpErr.Column != tt.column {
+				t.Errorf("position = %d:%d, want %d:%d", tpErr.Line, tpErr.Column, tt.line, tt.column)
+			}
+		})
+	}
+}
+
+func TestToJSONLogic_InvalidDialect(t *testing.T) {
+	if _, err := ToJSONLogic(dialect.DialectUnspecified, "a = 1"); err == nil {
+		t.Error("ToJSONLogic() with unspecified dialect expected error")
+	}
+}
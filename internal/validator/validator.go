@@ -123,14 +123,16 @@ func (v *Validator) validateObject(obj map[string]interface{}, path string) erro
 	for operator, args := range obj {
 		operatorPath := fmt.Sprintf("%s.%s", path, operator)
 
+		// Custom operators take precedence, which only matters for the date
+		// operators: they were added after custom operators could use their names.
+		if v.customOperatorChecker != nil && v.customOperatorChecker(operator) {
+			// Custom operator - skip detailed validation, just validate args recursively
+			return v.validateCustomOperatorArgs(args, operatorPath)
+		}
+
 		// Check if operator is supported
 		spec, exists := v.supportedOperators[operator]
 		if !exists {
-			// Check if it's a custom operator
-			if v.customOperatorChecker != nil && v.customOperatorChecker(operator) {
-				// Custom operator - skip detailed validation, just validate args recursively
-				return v.validateCustomOperatorArgs(args, operatorPath)
-			}
 			return ValidationError{
 				Operator: operator,
 				Message:  fmt.Sprintf("unsupported operator: %s", operator),
@@ -310,6 +312,11 @@ func (v *Validator) validateStandardOperator(operator string, args interface{},
 		return v.validateRecursive(args, path)
 	}
 
+	// days_ago accepts a bare amount: {"days_ago": 30}
+	if _, ok := args.([]interface{}); !ok && operator == "days_ago" {
+		return v.validateRecursive(args, path)
+	}
+
 	arr, ok := args.([]interface{})
 	if !ok {
 		return ValidationError{
@@ -652,6 +659,50 @@ func getSupportedOperators() map[string]OperatorSpec {
 			MaxArgs:     3,
 			Description: "Substring operation",
 		},
+
+		// Date operations
+		"now": {
+			Name:        "now",
+			MinArgs:     0,
+			MaxArgs:     0,
+			Description: "Current timestamp",
+		},
+		"date_add": {
+			Name:        "date_add",
+			MinArgs:     3,
+			MaxArgs:     3,
+			Description: "Add an interval to a date",
+		},
+		"date_sub": {
+			Name:        "date_sub",
+			MinArgs:     3,
+			MaxArgs:     3,
+			Description: "Subtract an interval from a date",
+		},
+		"date_diff": {
+			Name:        "date_diff",
+			MinArgs:     3,
+			MaxArgs:     3,
+			Description: "Number of units between two dates",
+		},
+		"date_trunc": {
+			Name:        "date_trunc",
+			MinArgs:     2,
+			MaxArgs:     2,
+			Description: "Truncate a date to a unit",
+		},
+		"days_ago": {
+			Name:        "days_ago",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Description: "Timestamp a number of days ago",
+		},
+		"within_last": {
+			Name:        "within_last",
+			MinArgs:     2,
+			MaxArgs:     3,
+			Description: "Date within the last interval",
+		},
 	}
 }
 
@@ -355,6 +355,35 @@ func TestValidateLogicalOperators(t *testing.T) {
 	}
 }
 
+func TestValidateDateOperators(t *testing.T) {
+	v := NewValidator()
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"now", map[string]interface{}{"now": []interface{}{}}, false},
+		{"now with an argument", map[string]interface{}{"now": []interface{}{1}}, true},
+		{"date_add", map[string]interface{}{"date_add": []interface{}{map[string]interface{}{"var": "d"}, 1, "day"}}, false},
+		{"date_sub without unit", map[string]interface{}{"date_sub": []interface{}{map[string]interface{}{"var": "d"}, 1}}, true},
+		{"date_trunc", map[string]interface{}{"date_trunc": []interface{}{map[string]interface{}{"var": "d"}, "month"}}, false},
+		{"days_ago shorthand", map[string]interface{}{"days_ago": 30}, false},
+		{"days_ago array", map[string]interface{}{"days_ago": []interface{}{30}}, false},
+		{"within_last default unit", map[string]interface{}{"within_last": []interface{}{map[string]interface{}{"var": "d"}, 30}}, false},
+		{"within_last too many", map[string]interface{}{"within_last": []interface{}{map[string]interface{}{"var": "d"}, 30, "day", 1}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidateComplexExpressions(t *testing.T) {
 	v := NewValidator()
 
@@ -408,13 +437,13 @@ func TestGetSupportedOperators(t *testing.T) {
 	v := NewValidator()
 	operators := v.GetSupportedOperators()
 
-	expectedCount := 33 // Standard JSON Logic operators (including ===, !==, !!, cat, substr)
+	expectedCount := 40 // Standard JSON Logic operators (including ===, !==, !!, cat, substr) and 7 date operators
 	if len(operators) != expectedCount {
 		t.Errorf("Expected %d operators, got %d", expectedCount, len(operators))
 	}
 
 	// Check for some key operators (standard JSON Logic)
-	expectedOps := []string{"var", "==", "===", ">", "and", "or", "in", "if", "cat", "substr", "!!"}
+	expectedOps := []string{"var", "==", "===", ">", "and", "or", "in", "if", "cat", "substr", "!!", "now", "within_last"}
 	for _, op := range expectedOps {
 		found := false
 		for _, supported := range operators {
@@ -0,0 +1,240 @@
+package jsonlogic2sql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonSchema is the subset of a JSON Schema (draft 2020-12) document that maps
+// onto FieldSchema entries. Other keywords are ignored.
+type jsonSchema struct {
+	Type        jsonSchemaType         `json:"type"`
+	Format      string                 `json:"format"`
+	Enum        []any                  `json:"enum"`
+	Properties  map[string]*jsonSchema `json:"properties"`
+	Items       *jsonSchema            `json:"items"`
+	Required    []string               `json:"required"`
+	Ref         string                 `json:"$ref"`
+	Defs        map[string]*jsonSchema `json:"$defs"`
+	Definitions map[string]*jsonSchema `json:"definitions"` // Pre-2019 name for $defs
+}
+
+// UnmarshalJSON accepts the boolean schemas true and false, which place no
+// constraints on the fields they describe.
+func (s *jsonSchema) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); bytes.Equal(trimmed, []byte("true")) || bytes.Equal(trimmed, []byte("false")) {
+		*s = jsonSchema{}
+		return nil
+	}
+	type plain jsonSchema
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+// jsonSchemaType is the type keyword, either a single type or a list of types.
+type jsonSchemaType []string
+
+// UnmarshalJSON accepts both "string" and ["string", "null"].
+func (t *jsonSchemaType) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = jsonSchemaType{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("type must be a string or an array of strings")
+	}
+	*t = list
+	return nil
+}
+
+// name returns the type of a value, ignoring "null" since every column is
+// nullable. It returns an empty string when there is no single such type.
+func (t jsonSchemaType) name() string {
+	var name string
+	for _, typ := range t {
+		if typ == "null" {
+			continue
+		}
+		if name != "" {
+			return ""
+		}
+		name = typ
+	}
+	return name
+}
+
+// jsonSchemaFormats maps string formats to temporal field types.
+var jsonSchemaFormats = map[string]FieldType{
+	"date-time": FieldTypeTimestamp,
+	"date":      FieldTypeDate,
+	"time":      FieldTypeTime,
+}
+
+// jsonSchemaTypes maps the scalar JSON Schema types to field types.
+var jsonSchemaTypes = map[string]FieldType{
+	"string":  FieldTypeString,
+	"integer": FieldTypeInteger,
+	"number":  FieldTypeNumber,
+	"boolean": FieldTypeBoolean,
+}
+
+// NewSchemaFromJSONSchema creates a new schema from a JSON Schema (draft
+// 2020-12) document describing an object. Each property becomes a field:
+//   - type maps to the field type; "null" in a list of types is ignored
+//   - enum on a string or untyped property maps to an enum field with the
+//     values as AllowedValues; on other types the scalar type is kept
+//   - format date-time, date and time map to timestamp, date and time fields
+//   - nested properties map to Fields, so user.address.zip is declared
+//   - items maps to the element Fields or Items type of an array
+//   - required marks the listed properties as Required, which is metadata
+//     only: it is reported by IsRequired but does not change validation or
+//     the generated SQL
+//
+// Local references to $defs and definitions are followed.
+func NewSchemaFromJSONSchema(data []byte) (*Schema, error) {
+	var root jsonSchema
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+
+	c := &jsonSchemaConverter{root: &root, resolving: make(map[string]bool)}
+	node, err := c.resolve(&root)
+	if err != nil {
+		return nil, err
+	}
+	if typ := node.Type.name(); typ != "object" && (typ != "" || node.Properties == nil) {
+		return nil, fmt.Errorf("invalid JSON Schema: root must be an object with properties")
+	}
+
+	fields, err := c.properties("", node)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchema(fields), nil
+}
+
+// jsonSchemaConverter converts the properties of a JSON Schema document.
+type jsonSchemaConverter struct {
+	root      *jsonSchema
+	resolving map[string]bool // References being converted, to reject cycles
+}
+
+// resolve follows the $ref of node, if any, to its definition.
+func (c *jsonSchemaConverter) resolve(node *jsonSchema) (*jsonSchema, error) {
+	seen := make(map[string]bool)
+	for node.Ref != "" {
+		if seen[node.Ref] {
+			return nil, fmt.Errorf("JSON Schema $ref %q refers to itself", node.Ref)
+		}
+		seen[node.Ref] = true
+		var defs map[string]*jsonSchema
+		var name string
+		switch {
+		case strings.HasPrefix(node.Ref, "#/$defs/"):
+			defs, name = c.root.Defs, strings.TrimPrefix(node.Ref, "#/$defs/")
+		case strings.HasPrefix(node.Ref, "#/definitions/"):
+			defs, name = c.root.Definitions, strings.TrimPrefix(node.Ref, "#/definitions/")
+		default:
+			return nil, fmt.Errorf("unsupported JSON Schema $ref %q: only local $defs are supported", node.Ref)
+		}
+		def, ok := defs[name]
+		if !ok {
+			return nil, fmt.Errorf("JSON Schema $ref %q is not defined", node.Ref)
+		}
+		node = def
+	}
+	return node, nil
+}
+
+// properties converts the properties of an object node to fields, sorted by name.
+func (c *jsonSchemaConverter) properties(path string, node *jsonSchema) ([]FieldSchema, error) {
+	names := make([]string, 0, len(node.Properties))
+	for name := range node.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	required := make(map[string]bool, len(node.Required))
+	for _, name := range node.Required {
+		required[name] = true
+	}
+
+	fields := make([]FieldSchema, 0, len(names))
+	for _, name := range names {
+		field, err := c.field(path+name, node.Properties[name])
+		if err != nil {
+			return nil, err
+		}
+		field.Name = name
+		field.Required = required[name]
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// field converts the property at path to a field. Recursive references are
+// rejected since a schema cannot declare infinitely nested fields.
+func (c *jsonSchemaConverter) field(path string, node *jsonSchema) (FieldSchema, error) {
+	if ref := node.Ref; ref != "" {
+		if c.resolving[ref] {
+			return FieldSchema{}, fmt.Errorf("property '%s': recursive JSON Schema $ref %q is not supported", path, ref)
+		}
+		c.resolving[ref] = true
+		defer delete(c.resolving, ref)
+	}
+	node, err := c.resolve(node)
+	if err != nil {
+		return FieldSchema{}, fmt.Errorf("property '%s': %w", path, err)
+	}
+
+	var field FieldSchema
+	typ := node.Type.name()
+	switch {
+	case len(node.Enum) > 0 && (typ == "" || typ == "string"):
+		field.Type = FieldTypeEnum
+		for _, value := range node.Enum {
+			if value != nil {
+				field.AllowedValues = append(field.AllowedValues, enumValue(value))
+			}
+		}
+	case typ == "object" || (typ == "" && node.Properties != nil):
+		field.Type = FieldTypeObject
+		if field.Fields, err = c.properties(path+".", node); err != nil {
+			return FieldSchema{}, err
+		}
+	case typ == "array" || (typ == "" && node.Items != nil):
+		field.Type = FieldTypeArray
+		if node.Items != nil {
+			element, err := c.field(path+"[]", node.Items)
+			if err != nil {
+				return FieldSchema{}, err
+			}
+			if element.Type == FieldTypeObject {
+				field.Fields = element.Fields
+			}
+			field.Items = element.Type
+			field.AllowedValues = element.AllowedValues
+		}
+	case typ == "string" && jsonSchemaFormats[node.Format] != "":
+		field.Type = jsonSchemaFormats[node.Format]
+	case jsonSchemaTypes[typ] != "":
+		field.Type = jsonSchemaTypes[typ]
+	case typ != "" && typ != "null":
+		return FieldSchema{}, fmt.Errorf("property '%s': unsupported JSON Schema type %q", path, typ)
+	}
+	return field, nil
+}
+
+// enumValue formats an enum value as an allowed value. Whole numbers are
+// written without an exponent, so 1000000 stays 1000000 rather than 1e+06.
+func enumValue(value any) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,170 @@
+package jsonlogic2sql
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewSchemaFromJSONSchema(t *testing.T) {
+	schema, err := NewSchemaFromJSONSchema([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["id", "status"],
+		"properties": {
+			"id": {"type": "integer"},
+			"amount": {"type": ["number", "null"]},
+			"verified": {"type": "boolean"},
+			"status": {"type": "string", "enum": ["open", "closed", null]},
+			"created_at": {"type": "string", "format": "date-time"},
+			"birthday": {"type": "string", "format": "date"},
+			"email": {"type": "string", "format": "email"},
+			"user": {
+				"type": "object",
+				"required": ["name"],
+				"properties": {
+					"name": {"type": "string"},
+					"address": {"$ref": "#/$defs/address"}
+				}
+			},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"levels": {"type": "array", "items": {"enum": [1, 2, 3]}},
+			"priority": {"type": "integer", "enum": [1, 2]},
+			"active": {"type": "boolean", "enum": [true]},
+			"limit": {"enum": [1000000, 2.5]},
+			"lines": {"type": "array", "items": {"type": "object", "properties": {"sku": {"type": "string"}, "qty": {"type": "integer"}}}},
+			"extra": {}
+		},
+		"$defs": {
+			"address": {"type": "object", "properties": {"zip": {"type": "string"}}}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("NewSchemaFromJSONSchema() error = %v", err)
+	}
+
+	tests := []struct {
+		field    string
+		expected FieldType
+	}{
+		{"id", FieldTypeInteger},
+		{"amount", FieldTypeNumber},
+		{"verified", FieldTypeBoolean},
+		{"status", FieldTypeEnum},
+		{"created_at", FieldTypeTimestamp},
+		{"birthday", FieldTypeDate},
+		{"email", FieldTypeString},
+		{"user", FieldTypeObject},
+		{"user.name", FieldTypeString},
+		{"user.address.zip", FieldTypeString},
+		{"tags", FieldTypeArray},
+		{"tags[]", FieldTypeString},
+		{"levels[]", FieldTypeEnum},
+		{"priority", FieldTypeInteger},
+		{"active", FieldTypeBoolean},
+		{"limit", FieldTypeEnum},
+		{"lines[]", FieldTypeObject},
+		{"lines[].qty", FieldTypeInteger},
+		{"extra", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if !schema.HasField(tt.field) {
+				t.Fatalf("HasField(%q) = false", tt.field)
+			}
+			if got := schema.GetFieldTypeFieldType(tt.field); got != tt.expected {
+				t.Errorf("GetFieldTypeFieldType(%q) = %q, want %q", tt.field, got, tt.expected)
+			}
+		})
+	}
+
+	if got := schema.GetAllowedValues("status"); !reflect.DeepEqual(got, []string{"open", "closed"}) {
+		t.Errorf("GetAllowedValues(status) = %v", got)
+	}
+	if got := schema.GetAllowedValues("limit"); !reflect.DeepEqual(got, []string{"1000000", "2.5"}) {
+		t.Errorf("GetAllowedValues(limit) = %v", got)
+	}
+	if err := schema.ValidateEnumValue("levels[]", "4"); err == nil {
+		t.Error("ValidateEnumValue(levels[], 4) expected an error")
+	}
+	for field, required := range map[string]bool{"id": true, "status": true, "amount": false, "user.name": true, "user.address": false} {
+		if got := schema.IsRequired(field); got != required {
+			t.Errorf("IsRequired(%q) = %v, want %v", field, got, required)
+		}
+	}
+}
+
+func TestNewSchemaFromJSONSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		errMsg string
+	}{
+		{"invalid JSON", `{"type": "object"`, "invalid JSON Schema"},
+		{"invalid type keyword", `{"type": 1}`, "type must be a string or an array of strings"},
+		{"non-object root", `{"type": "array", "items": {"type": "string"}}`, "root must be an object with properties"},
+		{"unsupported type", `{"properties": {"a": {"type": "decimal"}}}`, "property 'a': unsupported JSON Schema type \"decimal\""},
+		{"remote reference", `{"properties": {"a": {"$ref": "https://example.com/a.json"}}}`, "only local $defs are supported"},
+		{"undefined reference", `{"properties": {"a": {"$ref": "#/$defs/missing"}}}`, "JSON Schema $ref \"#/$defs/missing\" is not defined"},
+		{
+			"recursive reference",
+			`{"properties": {"node": {"$ref": "#/$defs/node"}}, "$defs": {"node": {"type": "object", "properties": {"child": {"$ref": "#/$defs/node"}}}}}`,
+			"property 'node.child': recursive JSON Schema $ref",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSchemaFromJSONSchema([]byte(tt.schema))
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("NewSchemaFromJSONSchema() error = %v, want it to contain %q", err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestNewSchemaFromJSONSchemaWithTranspiler(t *testing.T) {
+	schema, err := NewSchemaFromJSONSchema([]byte(`{
+		"type": "object",
+		"properties": {
+			"created_at": {"type": "string", "format": "date-time"},
+			"status": {"enum": ["open", "closed"]},
+			"lines": {"type": "array", "items": {"type": "object", "properties": {"qty": {"type": "integer"}}}}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("NewSchemaFromJSONSchema() error = %v", err)
+	}
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectPostgreSQL, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		expected string
+		errMsg   string
+	}{
+		{"timestamp literal", `{">": [{"var": "created_at"}, "2024-01-01T00:00:00Z"]}`, "WHERE created_at > TIMESTAMP '2024-01-01 00:00:00'", ""},
+		{"element field", `{"some": [{"var": "lines"}, {">": [{"var": "item.qty"}, 2]}]}`, "WHERE EXISTS (SELECT 1 FROM UNNEST(lines) AS elem WHERE elem.qty > 2)", ""},
+		{"enum value", `{"==": [{"var": "status"}, "pending"]}`, "", "invalid enum value 'pending' for field 'status'"},
+		{"undeclared field", `{"==": [{"var": "region"}, "eu"]}`, "", "field 'region' is not defined in schema"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.Transpile(tt.rule)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Transpile() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
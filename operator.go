@@ -252,6 +252,8 @@ func validateOperatorName(name string) error {
 		"in":  true,
 		"map": true, "filter": true, "reduce": true,
 		"all": true, "some": true, "none": true, "merge": true,
+		// The date operators (now, date_add, ...) are left out: they were added
+		// after custom operators could use their names, so those still override them.
 	}
 
 	if builtInOperators[name] {
@@ -844,7 +844,7 @@ func TestDeeplyNestedCustomOperators(t *testing.T) {
 			t.Fatalf("unexpected error: %v", err)
 		}
 		// Note: string literals come pre-quoted from the parser
-		expected := "WHERE (name LIKE ''A'%' AND (email LIKE '%'@company.com'' OR desc NOT LIKE '%'spam'%'))"
+		expected := "WHERE (name LIKE ''A'%' AND (email LIKE '%'@company.com'' OR `desc` NOT LIKE '%'spam'%'))"
 		if sql != expected {
 			t.Errorf("expected %s, got %s", expected, sql)
 		}
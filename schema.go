@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/operators"
 )
 
 // FieldType represents the type of a field in the schema.
@@ -18,18 +21,50 @@ const (
 	FieldTypeArray   FieldType = "array"
 	FieldTypeObject  FieldType = "object"
 	FieldTypeEnum    FieldType = "enum"
+	FieldTypeJSON    FieldType = "json" // JSON/JSONB column; nested var paths are extracted with JSON functions
+
+	// Temporal types compare against ISO-8601 strings rendered as typed literals.
+	// Datetime and timestamp values with an offset are converted to UTC.
+	FieldTypeDate      FieldType = "date"      // YYYY-MM-DD
+	FieldTypeDatetime  FieldType = "datetime"  // Date and time of day; DATETIME in BigQuery
+	FieldTypeTimestamp FieldType = "timestamp" // Point in time
+	FieldTypeTime      FieldType = "time"      // Time of day
 )
 
+// ColumnMapping describes the physical SQL location of a schema field.
+type ColumnMapping = operators.ColumnMapping
+
 // FieldSchema represents the schema/metadata for a single field.
 type FieldSchema struct {
 	Name          string    `json:"name"`
 	Type          FieldType `json:"type"`
 	AllowedValues []string  `json:"allowedValues,omitempty"` // For enum types: list of valid values
+	Column        string    `json:"column,omitempty"`        // Physical column path emitted instead of Name
+	Table         string    `json:"table,omitempty"`         // Table alias prefixed to the column
+	SQL           string    `json:"sql,omitempty"`           // Raw SQL expression emitted verbatim (trusted)
+	Required      bool      `json:"required,omitempty"`      // Always present in the source data; informational
+
+	// Items declares the element type of an array field, checked against
+	// {"var": ""} inside array operators. Enum elements take their values from
+	// AllowedValues. An array with Fields holds objects.
+	Items FieldType `json:"items,omitempty"`
+
+	// Fields declares the child fields of an object field, reached with dotted
+	// paths such as user.address.zip, or the fields of the elements of an
+	// array of objects, reached with item.price inside array operators.
+	// Children may declare their own Fields.
+	Fields []FieldSchema `json:"fields,omitempty"`
 }
 
+// elementSuffix marks the element schema of an array field: the elements of
+// items are declared as items[] and their fields as items[].price.
+const elementSuffix = "[]"
+
 // Schema represents the collection of field schemas.
 type Schema struct {
-	fields map[string]FieldSchema // Map field name to schema for O(1) lookup
+	fields     map[string]FieldSchema // Map field name to schema for O(1) lookup
+	tableAlias string                 // Default table alias for fields without their own Table
+	table      string                 // Table that SELECT statements read from
 }
 
 // NewSchema creates a new schema from a slice of field schemas.
@@ -38,11 +73,54 @@ func NewSchema(fields []FieldSchema) *Schema {
 		fields: make(map[string]FieldSchema),
 	}
 	for _, field := range fields {
-		s.fields[field.Name] = field
+		s.addField(field.Name, field)
 	}
 	return s
 }
 
+// addField declares a field under path along with its nested fields. Children
+// of an object with a column mapping are mapped below that column.
+func (s *Schema) addField(path string, field FieldSchema) {
+	s.fields[path] = field
+
+	parent := path
+	if field.Type == FieldTypeArray {
+		parent += elementSuffix
+		if element, ok := field.element(); ok {
+			s.fields[parent] = element
+		}
+	}
+	for _, child := range field.Fields {
+		if field.Type == FieldTypeObject && field.SQL == "" && (field.Column != "" || field.Table != "") {
+			column := field.Column
+			if column == "" {
+				column = path
+			}
+			if child.Column == "" {
+				child.Column = column + "." + child.Name
+			} else {
+				child.Column = column + "." + child.Column
+			}
+			if child.Table == "" {
+				child.Table = field.Table
+			}
+		}
+		s.addField(parent+"."+child.Name, child)
+	}
+}
+
+// element returns the schema of the elements of an array field, if declared.
+func (f FieldSchema) element() (FieldSchema, bool) {
+	element := FieldSchema{Name: f.Name + elementSuffix, Type: f.Items}
+	if element.Type == "" && len(f.Fields) > 0 {
+		element.Type = FieldTypeObject
+	}
+	if element.Type == FieldTypeEnum {
+		element.AllowedValues = f.AllowedValues
+	}
+	return element, element.Type != ""
+}
+
 // NewSchemaFromJSON creates a new schema from a JSON byte slice.
 func NewSchemaFromJSON(data []byte) (*Schema, error) {
 	var fields []FieldSchema
@@ -66,8 +144,22 @@ func (s *Schema) HasField(fieldName string) bool {
 	if s == nil {
 		return true // No schema means all fields are allowed
 	}
-	_, exists := s.fields[fieldName]
-	return exists
+	if _, exists := s.fields[fieldName]; exists {
+		return true
+	}
+	_, underJSON := s.jsonRoot(fieldName)
+	return underJSON
+}
+
+// jsonRoot returns the longest dotted prefix of fieldName declared as a JSON column.
+// Any path below a JSON column is accepted since its structure is not fixed.
+func (s *Schema) jsonRoot(fieldName string) (string, bool) {
+	for i := strings.LastIndex(fieldName, "."); i > 0; i = strings.LastIndex(fieldName[:i], ".") {
+		if s.IsJSONType(fieldName[:i]) {
+			return fieldName[:i], true
+		}
+	}
+	return "", false
 }
 
 // ValidateField checks if a field exists in the schema and returns an error if not.
@@ -81,14 +173,17 @@ func (s *Schema) ValidateField(fieldName string) error {
 	return nil
 }
 
-// GetFields returns all field names in the schema.
+// GetFields returns all field names in the schema, including the dotted
+// paths of nested object fields. Fields of array elements are left out.
 func (s *Schema) GetFields() []string {
 	if s == nil {
 		return nil
 	}
 	fields := make([]string, 0, len(s.fields))
 	for name := range s.fields {
-		fields = append(fields, name)
+		if !strings.Contains(name, elementSuffix) {
+			fields = append(fields, name)
+		}
 	}
 	return fields
 }
@@ -119,6 +214,25 @@ func (s *Schema) IsEnumType(fieldName string) bool {
 	return s.GetFieldTypeFieldType(fieldName) == FieldTypeEnum
 }
 
+// IsJSONType checks if a field is a JSON column.
+func (s *Schema) IsJSONType(fieldName string) bool {
+	return s.GetFieldTypeFieldType(fieldName) == FieldTypeJSON
+}
+
+// IsTemporalType checks if a field is of a date, datetime, timestamp or time type.
+func (s *Schema) IsTemporalType(fieldName string) bool {
+	return operators.IsTemporalType(s.GetFieldType(fieldName))
+}
+
+// IsRequired checks if a field is declared as required. Required is metadata
+// only and does not affect validation or the generated SQL.
+func (s *Schema) IsRequired(fieldName string) bool {
+	if s == nil {
+		return false
+	}
+	return s.fields[fieldName].Required
+}
+
 // GetAllowedValues returns the allowed values for an enum field
 // Returns nil if the field is not an enum or doesn't exist.
 func (s *Schema) GetAllowedValues(fieldName string) []string {
@@ -172,3 +286,54 @@ func (s *Schema) GetFieldTypeFieldType(fieldName string) FieldType {
 	}
 	return ""
 }
+
+// SetTableAlias sets the default table alias prefixed to every schema field
+// that has no Table or SQL of its own, e.g. "t" turns amount into t.amount.
+func (s *Schema) SetTableAlias(alias string) {
+	if s != nil {
+		s.tableAlias = alias
+	}
+}
+
+// SetTable sets the table that TranspileSelect and TranspileCount read from
+// when the Query names none. With a table alias set, the table is declared
+// under that alias.
+func (s *Schema) SetTable(table string) {
+	if s != nil {
+		s.table = table
+	}
+}
+
+// GetTable returns the table set with SetTable.
+func (s *Schema) GetTable() string {
+	if s == nil {
+		return ""
+	}
+	return s.table
+}
+
+// GetColumnMapping returns the column mapping for a field.
+// It returns false when the field is unknown or is emitted under its own name.
+// This implements the operators.SchemaProvider interface.
+func (s *Schema) GetColumnMapping(fieldName string) (ColumnMapping, bool) {
+	if s == nil {
+		return ColumnMapping{}, false
+	}
+	field, exists := s.fields[fieldName]
+	if !exists {
+		return ColumnMapping{}, false
+	}
+
+	mapping := ColumnMapping{
+		Table:  field.Table,
+		Column: field.Column,
+		SQL:    field.SQL,
+	}
+	if mapping.Table == "" {
+		mapping.Table = s.tableAlias
+	}
+	if mapping == (ColumnMapping{}) {
+		return mapping, false
+	}
+	return mapping, true
+}
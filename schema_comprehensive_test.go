@@ -439,7 +439,7 @@ func TestSchemaBackwardCompatibility(t *testing.T) {
 		{
 			name:      "any field without schema",
 			jsonLogic: `{"==": [{"var": "any.random.field"}, "value"]}`,
-			expected:  "WHERE any.random.field = 'value'",
+			expected:  "WHERE `any`.random.field = 'value'",
 		},
 		{
 			name:      "nested fields without schema",
@@ -792,6 +792,12 @@ func TestTypeAwareOperators(t *testing.T) {
 			expectError: true,
 			errorMsg:    "ordering comparison '>=' on incompatible field 'metadata'",
 		},
+		{
+			name:        "ordering comparison of string field with number",
+			jsonLogic:   `{">": [{"var": "name"}, 5]}`,
+			expectError: true,
+			errorMsg:    "ordering comparison '>' between string field 'name' and number 5",
+		},
 
 		// Equality operators - should work with any type (no validation)
 		{
@@ -3,6 +3,9 @@ package jsonlogic2sql
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -301,3 +304,514 @@ func TestSchemaGetAllowedValues(t *testing.T) {
 		t.Errorf("GetAllowedValues(nonexistent) should return nil for non-existent field")
 	}
 }
+
+func TestSchemaGetColumnMapping(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "customer.tier", Type: FieldTypeString, Column: "tier_code", Table: "cust_dim"},
+		{Name: "customer.email", Type: FieldTypeString, SQL: "LOWER(c.email)"},
+		{Name: "amount", Type: FieldTypeNumber},
+	})
+
+	if mapping, ok := schema.GetColumnMapping("customer.tier"); !ok || mapping.Table != "cust_dim" || mapping.Column != "tier_code" {
+		t.Errorf("GetColumnMapping(customer.tier) = %+v, %v", mapping, ok)
+	}
+	if mapping, ok := schema.GetColumnMapping("customer.email"); !ok || mapping.SQL != "LOWER(c.email)" {
+		t.Errorf("GetColumnMapping(customer.email) = %+v, %v", mapping, ok)
+	}
+	if _, ok := schema.GetColumnMapping("amount"); ok {
+		t.Error("GetColumnMapping(amount) should not have a mapping")
+	}
+	if _, ok := schema.GetColumnMapping("unknown"); ok {
+		t.Error("GetColumnMapping(unknown) should not have a mapping")
+	}
+
+	schema.SetTableAlias("t")
+	if mapping, ok := schema.GetColumnMapping("amount"); !ok || mapping.Table != "t" {
+		t.Errorf("GetColumnMapping(amount) with alias = %+v, %v", mapping, ok)
+	}
+	if mapping, _ := schema.GetColumnMapping("customer.tier"); mapping.Table != "cust_dim" {
+		t.Errorf("field Table should override default alias, got %q", mapping.Table)
+	}
+
+	var nilSchema *Schema
+	if _, ok := nilSchema.GetColumnMapping("amount"); ok {
+		t.Error("nil schema should not have mappings")
+	}
+}
+
+func TestSchemaColumnMappingWithTranspiler(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "customer.tier", Type: FieldTypeString, Column: "tier_code", Table: "cust_dim"},
+		{Name: "customer.email", Type: FieldTypeString, SQL: "LOWER(c.email)"},
+		{Name: "order.total", Type: FieldTypeNumber, Column: "order"},
+		{Name: "amount", Type: FieldTypeNumber},
+	})
+
+	tests := []struct {
+		name      string
+		dialect   Dialect
+		jsonLogic string
+		expected  string
+	}{
+		{
+			name:      "column with table alias",
+			dialect:   DialectBigQuery,
+			jsonLogic: `{"==": [{"var": "customer.tier"}, "gold"]}`,
+			expected:  "WHERE cust_dim.tier_code = 'gold'",
+		},
+		{
+			name:      "raw SQL expression",
+			dialect:   DialectPostgreSQL,
+			jsonLogic: `{"==": [{"var": "customer.email"}, "a@b.com"]}`,
+			expected:  "WHERE LOWER(c.email) = 'a@b.com'",
+		},
+		{
+			name:      "mapped column is quoted",
+			dialect:   DialectPostgreSQL,
+			jsonLogic: `{">": [{"var": "order.total"}, 100]}`,
+			expected:  `WHERE "order" > 100`,
+		},
+		{
+			name:      "unmapped field keeps its name",
+			dialect:   DialectDuckDB,
+			jsonLogic: `{">": [{"var": "amount"}, 100]}`,
+			expected:  "WHERE amount > 100",
+		},
+		{
+			name:      "mapping with default value",
+			dialect:   DialectBigQuery,
+			jsonLogic: `{"==": [{"var": ["customer.tier", "basic"]}, "gold"]}`,
+			expected:  "WHERE COALESCE(cust_dim.tier_code, 'basic') = 'gold'",
+		},
+		{
+			name:      "mapping in missing",
+			dialect:   DialectClickHouse,
+			jsonLogic: `{"missing": ["customer.tier", "amount"]}`,
+			expected:  "WHERE (cust_dim.tier_code IS NULL OR amount IS NULL)",
+		},
+		{
+			name:      "mapping inside nested expression",
+			dialect:   DialectBigQuery,
+			jsonLogic: `{"and": [{"in": [{"var": "customer.tier"}, ["gold", "silver"]]}, {">": [{"+": [{"var": "order.total"}, 1]}, 10]}]}`,
+			expected:  "WHERE (cust_dim.tier_code IN ('gold', 'silver') AND (`order` + 1) > 10)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: tt.dialect, Schema: schema})
+			if err != nil {
+				t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+			}
+			result, err := tr.Transpile(tt.jsonLogic)
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSchemaTableAliasWithTranspiler(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "amount", Type: FieldTypeNumber},
+		{Name: "customer.tier", Type: FieldTypeString, Column: "tier_code", Table: "c"},
+	})
+	schema.SetTableAlias("t")
+
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectPostgreSQL, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+
+	result, err := tr.Transpile(`{"and": [{">": [{"var": "amount"}, 10]}, {"==": [{"var": "customer.tier"}, "gold"]}]}`)
+	if err != nil {
+		t.Fatalf("Transpile() error = %v", err)
+	}
+	expected := "WHERE (t.amount > 10 AND c.tier_code = 'gold')"
+	if result != expected {
+		t.Errorf("Transpile() = %s, want %s", result, expected)
+	}
+}
+
+func TestSchemaColumnMappingFromJSON(t *testing.T) {
+	jsonData := `[
+		{"name": "customer.tier", "type": "string", "table": "cust_dim", "column": "tier_code"},
+		{"name": "customer.email", "type": "string", "sql": "LOWER(c.email)"}
+	]`
+
+	schema, err := NewSchemaFromJSON([]byte(jsonData))
+	if err != nil {
+		t.Fatalf("NewSchemaFromJSON() error = %v", err)
+	}
+	if mapping, ok := schema.GetColumnMapping("customer.tier"); !ok || mapping.Table != "cust_dim" || mapping.Column != "tier_code" {
+		t.Errorf("GetColumnMapping(customer.tier) = %+v, %v", mapping, ok)
+	}
+	if mapping, ok := schema.GetColumnMapping("customer.email"); !ok || mapping.SQL != "LOWER(c.email)" {
+		t.Errorf("GetColumnMapping(customer.email) = %+v, %v", mapping, ok)
+	}
+}
+
+func TestSchemaJSONRoot(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "attrs", Type: FieldTypeJSON},
+		{Name: "attrs.age", Type: FieldTypeInteger},
+	})
+
+	if !schema.IsJSONType("attrs") {
+		t.Error("IsJSONType(attrs) = false, want true")
+	}
+	for _, field := range []string{"attrs", "attrs.age", "attrs.address.city"} {
+		if !schema.HasField(field) {
+			t.Errorf("HasField(%q) = false, want true", field)
+		}
+	}
+	if schema.HasField("other.city") {
+		t.Error("HasField(other.city) = true, want false")
+	}
+	if schema.GetFieldTypeFieldType("attrs.address.city") != "" {
+		t.Error("undeclared JSON leaf should have no type")
+	}
+}
+
+func TestSchemaJSONPathWithTranspiler(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "customer", Type: FieldTypeJSON, Column: "attrs", Table: "c"},
+		{Name: "customer.address.city", Type: FieldTypeString},
+		{Name: "customer.age", Type: FieldTypeInteger},
+		{Name: "customer.vip", Type: FieldTypeBoolean},
+		{Name: "amount", Type: FieldTypeNumber},
+	})
+
+	jsonLogic := `{"and": [{"==": [{"var": "customer.address.city"}, "Paris"]}, {">=": [{"var": "customer.age"}, 18]}, {"!!": {"var": "customer.vip"}}]}`
+
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectBigQuery, "WHERE (JSON_VALUE(c.attrs, '$.address.city') = 'Paris' AND CAST(JSON_VALUE(c.attrs, '$.age') AS INT64) >= 18 AND CAST(JSON_VALUE(c.attrs, '$.vip') AS BOOL) IS TRUE)"},
+		{DialectSpanner, "WHERE (JSON_VALUE(c.attrs, '$.address.city') = 'Paris' AND CAST(JSON_VALUE(c.attrs, '$.age') AS INT64) >= 18 AND CAST(JSON_VALUE(c.attrs, '$.vip') AS BOOL) IS TRUE)"},
+		{DialectPostgreSQL, "WHERE (c.attrs->'address'->>'city' = 'Paris' AND CAST(c.attrs->>'age' AS BIGINT) >= 18 AND CAST(c.attrs->>'vip' AS BOOLEAN) IS TRUE)"},
+		{DialectDuckDB, "WHERE (json_extract_string(c.attrs, '$.address.city') = 'Paris' AND CAST(json_extract_string(c.attrs, '$.age') AS BIGINT) >= 18 AND CAST(json_extract_string(c.attrs, '$.vip') AS BOOLEAN) IS TRUE)"},
+		{DialectClickHouse, "WHERE (JSONExtractString(c.attrs, 'address', 'city') = 'Paris' AND JSONExtractInt(c.attrs, 'age') >= 18 AND JSONExtractBool(c.attrs, 'vip') IS TRUE)"},
+		{DialectSnowflake, "WHERE (c.attrs:address.city::STRING = 'Paris' AND c.attrs:age::NUMBER >= 18 AND COALESCE(c.attrs:vip::BOOLEAN, FALSE))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: tt.dialect, Schema: schema})
+			if err != nil {
+				t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+			}
+			result, err := tr.Transpile(jsonLogic)
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestSchemaJSONPathRequiresJSONRoot checks that a dotted var is only read as
+// a JSON path when the schema declares its root as a JSON column. Otherwise it
+// stays a qualified column reference, also on Snowflake.
+func TestSchemaJSONPathRequiresJSONRoot(t *testing.T) {
+	jsonLogic := `{"==": [{"var": "attrs.address.city"}, "Paris"]}`
+
+	tests := []struct {
+		name     string
+		schema   *Schema
+		expected string
+	}{
+		{"no schema", nil, "WHERE attrs.address.city = 'Paris'"},
+		{"JSON root", NewSchema([]FieldSchema{{Name: "attrs", Type: FieldTypeJSON}}), "WHERE attrs:address.city::STRING = 'Paris'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectSnowflake, Schema: tt.schema})
+			if err != nil {
+				t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+			}
+			result, err := tr.Transpile(jsonLogic)
+			if err != nil {
+				t.Fatalf("Transpile() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSchemaJSONPathParameterized(t *testing.T) {
+	schema := NewSchema([]FieldSchema{{Name: "attrs", Type: FieldTypeJSON}})
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectPostgreSQL, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+
+	sql, args, err := tr.TranspileParameterized(`{"==": [{"var": "attrs.address.city"}, "Paris"]}`)
+	if err != nil {
+		t.Fatalf("TranspileParameterized() error = %v", err)
+	}
+	// JSON path keys stay inline; only comparison values are bound
+	if expected := "WHERE attrs->'address'->>'city' = $1"; sql != expected {
+		t.Errorf("TranspileParameterized() sql = %s, want %s", sql, expected)
+	}
+	if len(args) != 1 || args[0] != "Paris" {
+		t.Errorf("TranspileParameterized() args = %v, want [Paris]", args)
+	}
+}
+
+func TestSchemaTemporalTypes(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "signup_date", Type: FieldTypeDate},
+		{Name: "last_seen", Type: FieldTypeTimestamp},
+		{Name: "opens_at", Type: FieldTypeTime},
+		{Name: "status", Type: FieldTypeString},
+	})
+	if !schema.IsTemporalType("signup_date") || !schema.IsTemporalType("opens_at") {
+		t.Error("IsTemporalType() = false for a temporal field, want true")
+	}
+	if schema.IsTemporalType("status") || schema.IsTemporalType("missing") {
+		t.Error("IsTemporalType() = true for a non-temporal field, want false")
+	}
+
+	rule := `{"and": [{">=": [{"var": "signup_date"}, "2024-01-01"]}, {"<": [{"var": "last_seen"}, "2024-06-01T00:00:00Z"]}]}`
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectPostgreSQL, "WHERE (signup_date >= DATE '2024-01-01' AND last_seen < TIMESTAMP '2024-06-01 00:00:00')"},
+		{DialectClickHouse, "WHERE (signup_date >= toDate('2024-01-01') AND last_seen < toDateTime('2024-06-01 00:00:00', 'UTC'))"},
+		{DialectSQLServer, "WHERE (signup_date >= CAST('2024-01-01' AS DATE) AND last_seen < CAST('2024-06-01 00:00:00' AS DATETIME2))"},
+		{dialectExasol, "WHERE (signup_date >= DATE '2024-01-01' AND last_seen < TIMESTAMP '2024-06-01 00:00:00')"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: tt.dialect, Schema: schema})
+			if err != nil {
+				t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+			}
+			got, err := tr.Transpile(rule)
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectPostgreSQL, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+	sql, args, err := tr.TranspileParameterized(`{"==": [{"var": "signup_date"}, "2024-01-01"]}`)
+	if err != nil {
+		t.Fatalf("TranspileParameterized() unexpected error: %v", err)
+	}
+	if want := "WHERE signup_date = CAST($1 AS DATE)"; sql != want {
+		t.Errorf("TranspileParameterized() sql = %q, want %q", sql, want)
+	}
+	if len(args) != 1 || args[0] != "2024-01-01" {
+		t.Errorf("TranspileParameterized() args = %v, want [2024-01-01]", args)
+	}
+
+	for _, rule := range []string{
+		`{"==": [{"var": "signup_date"}, "January 1st"]}`,
+		`{"<": [{"var": "opens_at"}, {"var": "signup_date"}]}`,
+	} {
+		if _, err := tr.Transpile(rule); err == nil {
+			t.Errorf("Transpile(%s) expected an error", rule)
+		}
+	}
+}
+
+func TestSchemaNestedFields(t *testing.T) {
+	schema, err := NewSchemaFromJSON([]byte(`[
+		{"name": "user", "type": "object", "fields": [
+			{"name": "address", "type": "object", "fields": [{"name": "zip", "type": "string"}]},
+			{"name": "tier", "type": "enum", "allowedValues": ["gold", "silver"]}
+		]},
+		{"name": "items", "type": "array", "fields": [
+			{"name": "price", "type": "number"},
+			{"name": "status", "type": "enum", "allowedValues": ["open", "closed"]}
+		]},
+		{"name": "profile", "type": "object", "table": "p", "column": "profile_data", "fields": [
+			{"name": "age", "type": "integer"},
+			{"name": "city", "type": "string", "column": "home_city"}
+		]}
+	]`))
+	if err != nil {
+		t.Fatalf("NewSchemaFromJSON() error = %v", err)
+	}
+
+	if !schema.HasField("user.address.zip") || !schema.IsStringType("user.address.zip") {
+		t.Error("user.address.zip should be a string field")
+	}
+	if schema.HasField("user.name") {
+		t.Error("user.name should not be defined")
+	}
+	if err := schema.ValidateEnumValue("user.tier", "bronze"); err == nil {
+		t.Error("ValidateEnumValue(user.tier, bronze) expected an error")
+	}
+	if schema.HasField("items.price") {
+		t.Error("element fields should not be reachable as items.price")
+	}
+	if !schema.IsNumericType("items[].price") {
+		t.Error("items[].price should be declared as the element field")
+	}
+
+	fields := schema.GetFields()
+	sort.Strings(fields)
+	if want := []string{"items", "profile", "profile.age", "profile.city", "user", "user.address", "user.address.zip", "user.tier"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("GetFields() = %v, want %v", fields, want)
+	}
+
+	mapping, ok := schema.GetColumnMapping("profile.city")
+	if !ok || mapping != (ColumnMapping{Table: "p", Column: "profile_data.home_city"}) {
+		t.Errorf("GetColumnMapping(profile.city) = %+v, %v", mapping, ok)
+	}
+}
+
+func TestSchemaNestedFieldsWithTranspiler(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "user", Type: FieldTypeObject, Fields: []FieldSchema{
+			{Name: "address", Type: FieldTypeObject, Fields: []FieldSchema{{Name: "zip", Type: FieldTypeString}}},
+			{Name: "age", Type: FieldTypeInteger},
+		}},
+		{Name: "items", Type: FieldTypeArray, Fields: []FieldSchema{
+			{Name: "price", Type: FieldTypeNumber},
+			{Name: "status", Type: FieldTypeEnum, AllowedValues: []string{"open", "closed"}},
+		}},
+	})
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectBigQuery, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		expected string
+		errMsg   string
+	}{
+		{"nested path", `{"==": [{"var": "user.address.zip"}, "10001"]}`, "WHERE user.address.zip = '10001'", ""},
+		{"element field in some", `{"some": [{"var": "items"}, {">": [{"var": "item.price"}, 100]}]}`, "WHERE EXISTS (SELECT 1 FROM UNNEST(items) AS elem WHERE elem.price > 100)", ""},
+		{
+			"element field in filter", `{"filter": [{"var": "items"}, {"==": [{"var": "item.status"}, "open"]}]}`,
+			"WHERE ARRAY(SELECT elem FROM UNNEST(items) AS elem WHERE elem.status = 'open')", "",
+		},
+		{"undeclared nested path", `{"==": [{"var": "user.address.city"}, "NYC"]}`, "", "field 'user.address.city' is not defined in schema"},
+		{"undeclared element field", `{"some": [{"var": "items"}, {"==": [{"var": "item.sku"}, "A1"]}]}`, "", "field 'item.sku' is not defined in schema"},
+		{"element enum value", `{"filter": [{"var": "items"}, {"==": [{"var": "item.status"}, "pending"]}]}`, "", "invalid enum value 'pending' for field 'item.status'"},
+		{"nested type check", `{"some": [{"var": "user.age"}, {"==": [{"var": ""}, 1]}]}`, "", "array operation on non-array field 'user.age'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.Transpile(tt.rule)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Transpile() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSchemaArrayItems(t *testing.T) {
+	schema, err := NewSchemaFromJSON([]byte(`[
+		{"name": "tags", "type": "array", "items": "string"},
+		{"name": "states", "type": "array", "items": "enum", "allowedValues": ["open", "closed"]},
+		{"name": "orders", "type": "array", "fields": [{"name": "price", "type": "number"}]},
+		{"name": "raw", "type": "array"}
+	]`))
+	if err != nil {
+		t.Fatalf("NewSchemaFromJSON() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		field    string
+		expected FieldType
+	}{
+		{"typed elements", "tags[]", FieldTypeString},
+		{"enum elements", "states[]", FieldTypeEnum},
+		{"elements with fields", "orders[]", FieldTypeObject},
+		{"untyped elements", "raw[]", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schema.GetFieldTypeFieldType(tt.field); got != tt.expected {
+				t.Errorf("GetFieldTypeFieldType(%q) = %q, want %q", tt.field, got, tt.expected)
+			}
+		})
+	}
+
+	if err := schema.ValidateEnumValue("states[]", "pending"); err == nil {
+		t.Error("ValidateEnumValue(states[], pending) expected an error")
+	}
+	fields := schema.GetFields()
+	sort.Strings(fields)
+	if want := []string{"orders", "raw", "states", "tags"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("GetFields() = %v, want %v", fields, want)
+	}
+}
+
+func TestSchemaArrayItemsWithTranspiler(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "tags", Type: FieldTypeArray, Items: FieldTypeString},
+		{Name: "flags", Type: FieldTypeArray, Items: FieldTypeBoolean},
+		{Name: "states", Type: FieldTypeArray, Items: FieldTypeEnum, AllowedValues: []string{"open", "closed"}},
+		{Name: "days", Type: FieldTypeArray, Items: FieldTypeDate},
+	})
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectBigQuery, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		expected string
+		errMsg   string
+	}{
+		{"number against string elements", `{"some": [{"var": "tags"}, {">": [{"var": ""}, 5]}]}`, "", "ordering comparison '>' between string field 'tags[]' and number 5"},
+		{"element enum value", `{"some": [{"var": "states"}, {"==": [{"var": ""}, "pending"]}]}`, "", "invalid enum value 'pending' for field 'states[]'"},
+		{"numeric operation on string elements", `{"some": [{"var": "tags"}, {">": [{"+": [{"var": ""}, 1]}, 2]}]}`, "", "numeric operation on non-numeric field 'tags[]'"},
+		{"invalid date element literal", `{"some": [{"var": "days"}, {">": [{"var": ""}, "01/02/2024"]}]}`, "", "invalid value for field 'days[]'"},
+		{"element enum in list", `{"all": [{"var": "states"}, {"in": [{"var": "item"}, ["open", "draft"]]}]}`, "", "invalid enum value 'draft' for field 'item'"},
+		{"boolean element truthiness", `{"some": [{"var": "flags"}, {"!!": {"var": ""}}]}`, "WHERE EXISTS (SELECT 1 FROM UNNEST(flags) AS elem WHERE elem IS TRUE)", ""},
+		{"date element literal", `{"some": [{"var": "days"}, {">": [{"var": ""}, "2024-01-01"]}]}`, "WHERE EXISTS (SELECT 1 FROM UNNEST(days) AS elem WHERE elem > DATE '2024-01-01')", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.Transpile(tt.rule)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Transpile() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package jsonlogic2sql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+	"github.com/h22rana/jsonlogic2sql/internal/parser"
+)
+
+// Query describes the SELECT statement that TranspileSelect and TranspileCount
+// build around a rule.
+type Query struct {
+	// Table is the table to read from. Dotted names such as "sales.orders"
+	// are quoted segment by segment. Empty uses the schema table (see
+	// Schema.SetTable).
+	Table string
+	// Columns are the fields to select, rendered as {"var": name} is, with
+	// schema validation and column mapping. Empty selects *. For
+	// TranspileCount, a single column counts its distinct values.
+	Columns []string
+	// OrderBy sorts the rows. It is not allowed in TranspileCount.
+	OrderBy []OrderBy
+	// Limit caps the number of rows; 0 means no limit. It is not allowed in
+	// TranspileCount.
+	Limit int
+}
+
+// OrderBy sorts the rows of a Query by a field, in ascending order unless
+// Desc is set.
+type OrderBy struct {
+	Field string
+	Desc  bool
+}
+
+// TranspileSelect builds a complete SELECT statement that returns the rows of
+// q.Table matching the rule. An empty rule selects every row. The row limit
+// uses the dialect's syntax: LIMIT n, TOP (n) on SQL Server and FETCH FIRST n
+// ROWS ONLY on Oracle.
+//
+// Example:
+//
+//	sql, _ := transpiler.TranspileSelect(jsonlogic2sql.Query{
+//	    Table:   "users",
+//	    Columns: []string{"id", "email"},
+//	    OrderBy: []jsonlogic2sql.OrderBy{{Field: "created_at", Desc: true}},
+//	    Limit:   100,
+//	}, `{"==": [{"var": "status"}, "active"]}`)
+//	// PostgreSQL: SELECT id, email FROM users WHERE status = 'active' ORDER BY created_at DESC LIMIT 100
+func (t *Transpiler) TranspileSelect(q Query, jsonLogic string) (string, error) {
+	return t.formatted(t.buildSelect(t.parser, q, jsonLogic, false))
+}
+
+// TranspileSelectParameterized builds a SELECT statement like TranspileSelect,
+// binding the literals of the rule as parameters. See TranspileParameterized
+// for the placeholder format.
+func (t *Transpiler) TranspileSelectParameterized(q Query, jsonLogic string) (string, []any, error) {
+	p, params := t.newParameterizedParser()
+	sql, err := t.buildSelect(p, q, jsonLogic, false)
+	if err != nil {
+		return "", nil, err
+	}
+	sql, args := params.Bind(sql)
+	return t.formatSQL(sql), args, nil
+}
+
+// TranspileCount builds a SELECT COUNT(*) statement that counts the rows of
+// q.Table matching the rule, for example to size an audience. With a single
+// column in q.Columns it counts the distinct values of that column instead.
+//
+// Example:
+//
+//	sql, _ := transpiler.TranspileCount(jsonlogic2sql.Query{
+//	    Table:   "events",
+//	    Columns: []string{"user_id"},
+//	}, `{"==": [{"var": "country"}, "US"]}`)
+//	// SELECT COUNT(DISTINCT user_id) FROM events WHERE country = 'US'
+func (t *Transpiler) TranspileCount(q Query, jsonLogic string) (string, error) {
+	return t.formatted(t.buildSelect(t.parser, q, jsonLogic, true))
+}
+
+// TranspileCountParameterized builds a count statement like TranspileCount,
+// binding the literals of the rule as parameters.
+func (t *Transpiler) TranspileCountParameterized(q Query, jsonLogic string) (string, []any, error) {
+	p, params := t.newParameterizedParser()
+	sql, err := t.buildSelect(p, q, jsonLogic, true)
+	if err != nil {
+		return "", nil, err
+	}
+	sql, args := params.Bind(sql)
+	return t.formatSQL(sql), args, nil
+}
+
+// buildSelect renders q around the condition that p generates for jsonLogic.
+func (t *Transpiler) buildSelect(p *parser.Parser, q Query, jsonLogic string, count bool) (string, error) {
+	if q.Limit < 0 {
+		return "", fmt.Errorf("query limit cannot be negative: %d", q.Limit)
+	}
+	if count && (len(q.OrderBy) > 0 || q.Limit > 0) {
+		return "", fmt.Errorf("count queries cannot have ORDER BY or a limit")
+	}
+
+	from, err := t.fromClause(q.Table)
+	if err != nil {
+		return "", err
+	}
+
+	selectList, err := projection(p, q.Columns, count)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	if q.Limit > 0 && t.config.Dialect == DialectSQLServer {
+		fmt.Fprintf(&sb, "TOP (%d) ", q.Limit)
+	}
+	sb.WriteString(selectList)
+	sb.WriteString(" FROM ")
+	sb.WriteString(from)
+
+	if strings.TrimSpace(jsonLogic) != "" {
+		var logic interface{}
+		if err := json.Unmarshal([]byte(jsonLogic), &logic); err != nil {
+			return "", tperrors.NewInvalidJSON(err)
+		}
+		where, err := p.Parse(logic)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(" ")
+		sb.WriteString(where)
+	}
+
+	if len(q.OrderBy) > 0 {
+		keys := make([]string, len(q.OrderBy))
+		for i, order := range q.OrderBy {
+			column, err := p.ParseColumn(order.Field)
+			if err != nil {
+				return "", fmt.Errorf("invalid order by field %q: %w", order.Field, err)
+			}
+			keys[i] = column
+			if order.Desc {
+				keys[i] += " DESC"
+			}
+		}
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(strings.Join(keys, ", "))
+	}
+
+	if q.Limit > 0 {
+		//nolint:exhaustive // default handles the dialects with LIMIT
+		switch t.config.Dialect {
+		case DialectSQLServer:
+			// Rendered as TOP after SELECT.
+		case DialectOracle:
+			fmt.Fprintf(&sb, " FETCH FIRST %d ROWS ONLY", q.Limit)
+		default:
+			fmt.Fprintf(&sb, " LIMIT %d", q.Limit)
+		}
+	}
+	return sb.String(), nil
+}
+
+// fromClause renders the table of a query, falling back to the schema table
+// and declaring the schema's table alias.
+func (t *Transpiler) fromClause(table string) (string, error) {
+	schema, _ := t.operatorConfig.Schema.(*Schema)
+	if table == "" {
+		table = schema.GetTable()
+	}
+	if table == "" {
+		return "", fmt.Errorf("query table is required: set Query.Table or Schema.SetTable")
+	}
+
+	from, err := t.operatorConfig.IdentifierToSQL(table)
+	if err != nil {
+		return "", fmt.Errorf("invalid table %q: %w", table, err)
+	}
+	if schema != nil && schema.tableAlias != "" {
+		// AS is left out, since Oracle does not accept it for table aliases.
+		alias, err := t.operatorConfig.IdentifierToSQL(schema.tableAlias)
+		if err != nil {
+			return "", fmt.Errorf("invalid table alias %q: %w", schema.tableAlias, err)
+		}
+		from += " " + alias
+	}
+	return from, nil
+}
+
+// projection renders the select list of a query.
+func projection(p *parser.Parser, columns []string, count bool) (string, error) {
+	if count && len(columns) > 1 {
+		return "", fmt.Errorf("count queries can count the distinct values of one column, got %d", len(columns))
+	}
+
+	rendered := make([]string, len(columns))
+	for i, name := range columns {
+		column, err := p.ParseColumn(name)
+		if err != nil {
+			return "", fmt.Errorf("invalid column %q: %w", name, err)
+		}
+		rendered[i] = column
+	}
+
+	switch {
+	case count && len(rendered) == 1:
+		return fmt.Sprintf("COUNT(DISTINCT %s)", rendered[0]), nil
+	case count:
+		return "COUNT(*)", nil
+	case len(rendered) == 0:
+		return "*", nil
+	default:
+		return strings.Join(rendered, ", "), nil
+	}
+}
@@ -0,0 +1,243 @@
+package jsonlogic2sql
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTranspileSelect(t *testing.T) {
+	query := Query{
+		Table:   "sales.orders",
+		Columns: []string{"id", "order", "amount"},
+		OrderBy: []OrderBy{{Field: "created_at", Desc: true}, {Field: "id"}},
+		Limit:   10,
+	}
+	rule := `{"and": [{"==": [{"var": "status"}, "paid"]}, {">": [{"var": "amount"}, 100]}]}`
+
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectBigQuery, "SELECT id, `order`, amount FROM sales.orders WHERE (status = 'paid' AND amount > 100) ORDER BY created_at DESC, id LIMIT 10"},
+		{DialectPostgreSQL, `SELECT id, "order", amount FROM sales.orders WHERE (status = 'paid' AND amount > 100) ORDER BY created_at DESC, id LIMIT 10`},
+		{DialectMySQL, "SELECT id, `order`, amount FROM sales.orders WHERE (status = 'paid' AND amount > 100) ORDER BY created_at DESC, id LIMIT 10"},
+		{DialectSQLServer, "SELECT TOP (10) id, [order], amount FROM sales.orders WHERE (status = 'paid' AND amount > 100) ORDER BY created_at DESC, id"},
+		{DialectOracle, `SELECT id, "order", amount FROM sales.orders WHERE (status = 'paid' AND amount > 100) ORDER BY created_at DESC, id FETCH FIRST 10 ROWS ONLY`},
+		{dialectExasol, `SELECT id, "order", amount FROM sales.orders WHERE (status = 'paid' AND amount > 100) ORDER BY created_at DESC, id LIMIT 10`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			tr, err := NewTranspiler(tt.dialect)
+			if err != nil {
+				t.Fatalf("NewTranspiler() error = %v", err)
+			}
+			got, err := tr.TranspileSelect(query, rule)
+			if err != nil {
+				t.Fatalf("TranspileSelect() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("TranspileSelect() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTranspileSelect_Defaults(t *testing.T) {
+	tr, err := NewTranspiler(DialectPostgreSQL)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		query    Query
+		rule     string
+		expected string
+	}{
+		{"all columns", Query{Table: "users"}, `{"==": [{"var": "status"}, "active"]}`, "SELECT * FROM users WHERE status = 'active'"},
+		{"no rule", Query{Table: "users", Columns: []string{"id"}}, "", "SELECT id FROM users"},
+		{"nested field", Query{Table: "users", Columns: []string{"user.name"}}, "", "SELECT user.name FROM users"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.TranspileSelect(tt.query, tt.rule)
+			if err != nil {
+				t.Fatalf("TranspileSelect() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("TranspileSelect() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTranspileSelect_Schema(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "id", Type: FieldTypeInteger},
+		{Name: "status", Type: FieldTypeString},
+		{Name: "tier", Type: FieldTypeString, Column: "tier_code"},
+	})
+	schema.SetTable("crm.customers")
+	schema.SetTableAlias("c")
+
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectOracle, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+
+	got, err := tr.TranspileSelect(Query{Columns: []string{"id", "tier"}, OrderBy: []OrderBy{{Field: "tier"}}}, `{"==": [{"var": "status"}, "active"]}`)
+	if err != nil {
+		t.Fatalf("TranspileSelect() unexpected error: %v", err)
+	}
+	if want := "SELECT c.id, c.tier_code FROM crm.customers c WHERE c.status = 'active' ORDER BY c.tier_code"; got != want {
+		t.Errorf("TranspileSelect() = %q, want %q", got, want)
+	}
+
+	got, err = tr.TranspileSelect(Query{Table: "crm.prospects", Columns: []string{"id"}}, "")
+	if err != nil {
+		t.Fatalf("TranspileSelect() unexpected error: %v", err)
+	}
+	if want := "SELECT c.id FROM crm.prospects c"; got != want {
+		t.Errorf("TranspileSelect() with Query.Table = %q, want %q", got, want)
+	}
+}
+
+func TestTranspileSelect_Errors(t *testing.T) {
+	schema := NewSchema([]FieldSchema{{Name: "status", Type: FieldTypeString}})
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectPostgreSQL, Schema: schema})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		query  Query
+		rule   string
+		errMsg string
+	}{
+		{"missing table", Query{}, "", "query table is required"},
+		{"invalid table", Query{Table: "a..b"}, "", "invalid table"},
+		{"unknown column", Query{Table: "t", Columns: []string{"email"}}, "", `invalid column "email"`},
+		{"empty column", Query{Table: "t", Columns: []string{""}}, "", "column name cannot be empty"},
+		{"unknown order field", Query{Table: "t", OrderBy: []OrderBy{{Field: "email"}}}, "", `invalid order by field "email"`},
+		{"negative limit", Query{Table: "t", Limit: -1}, "", "limit cannot be negative"},
+		{"invalid JSON", Query{Table: "t"}, `{"==":`, "invalid JSON"},
+		{"invalid rule", Query{Table: "t"}, `{"==": [{"var": "email"}, "x"]}`, "not defined in schema"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tr.TranspileSelect(tt.query, tt.rule)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("TranspileSelect() error = %v, want it to contain %q", err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestTranspileSelectParameterized(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{DialectPostgreSQL, "SELECT id FROM users WHERE (status = $1 AND age >= $2) ORDER BY id LIMIT 5"},
+		{DialectMySQL, "SELECT id FROM users WHERE (status = ? AND age >= ?) ORDER BY id LIMIT 5"},
+		{DialectSQLServer, "SELECT TOP (5) id FROM users WHERE (status = @p1 AND age >= @p2) ORDER BY id"},
+	}
+	query := Query{Table: "users", Columns: []string{"id"}, OrderBy: []OrderBy{{Field: "id"}}, Limit: 5}
+	rule := `{"and": [{"==": [{"var": "status"}, "active"]}, {">=": [{"var": "age"}, 18]}]}`
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			tr, err := NewTranspiler(tt.dialect)
+			if err != nil {
+				t.Fatalf("NewTranspiler() error = %v", err)
+			}
+			sql, args, err := tr.TranspileSelectParameterized(query, rule)
+			if err != nil {
+				t.Fatalf("TranspileSelectParameterized() unexpected error: %v", err)
+			}
+			if sql != tt.expected {
+				t.Errorf("TranspileSelectParameterized() sql = %q, want %q", sql, tt.expected)
+			}
+			if want := []any{"active", float64(18)}; !reflect.DeepEqual(args, want) {
+				t.Errorf("TranspileSelectParameterized() args = %v, want %v", args, want)
+			}
+		})
+	}
+}
+
+func TestTranspileCount(t *testing.T) {
+	tr, err := NewTranspiler(DialectBigQuery)
+	if err != nil {
+		t.Fatalf("NewTranspiler() error = %v", err)
+	}
+	rule := `{"==": [{"var": "country"}, "US"]}`
+
+	tests := []struct {
+		name     string
+		query    Query
+		expected string
+		errMsg   string
+	}{
+		{"rows", Query{Table: "events"}, "SELECT COUNT(*) FROM events WHERE country = 'US'", ""},
+		{"distinct column", Query{Table: "events", Columns: []string{"user_id"}}, "SELECT COUNT(DISTINCT user_id) FROM events WHERE country = 'US'", ""},
+		{"several columns", Query{Table: "events", Columns: []string{"a", "b"}}, "", "distinct values of one column"},
+		{"order by", Query{Table: "events", OrderBy: []OrderBy{{Field: "a"}}}, "", "cannot have ORDER BY"},
+		{"limit", Query{Table: "events", Limit: 1}, "", "cannot have ORDER BY or a limit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.TranspileCount(tt.query, rule)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("TranspileCount() error = %v, want it to contain %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TranspileCount() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("TranspileCount() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	sql, args, err := tr.TranspileCountParameterized(Query{Table: "events"}, rule)
+	if err != nil {
+		t.Fatalf("TranspileCountParameterized() unexpected error: %v", err)
+	}
+	if want := "SELECT COUNT(*) FROM events WHERE country = @p1"; sql != want {
+		t.Errorf("TranspileCountParameterized() sql = %q, want %q", sql, want)
+	}
+	if want := []any{"US"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("TranspileCountParameterized() args = %v, want %v", args, want)
+	}
+}
+
+func TestTranspileSelect_Format(t *testing.T) {
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{
+		Dialect: DialectOracle,
+		Format:  &FormatOptions{LineWidth: 40},
+	})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+	got, err := tr.TranspileSelect(
+		Query{Table: "users", Columns: []string{"id", "email"}, OrderBy: []OrderBy{{Field: "id"}}, Limit: 10},
+		`{"and": [{"==": [{"var": "status"}, "active"]}, {">": [{"var": "age"}, 18]}]}`,
+	)
+	if err != nil {
+		t.Fatalf("TranspileSelect() unexpected error: %v", err)
+	}
+	want := `SELECT id, email
+FROM users
+WHERE (status = 'active' AND age > 18)
+ORDER BY id
+FETCH FIRST 10 ROWS ONLY`
+	if got != want {
+		t.Errorf("TranspileSelect() =\n%s\nwant\n%s", got, want)
+	}
+}
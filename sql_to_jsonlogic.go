@@ -0,0 +1,49 @@
+package jsonlogic2sql
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/h22rana/jsonlogic2sql/internal/ast"
+	"github.com/h22rana/jsonlogic2sql/internal/sqlparse"
+)
+
+// SQLToJSONLogic translates a SQL WHERE clause back to JSON Logic.
+// The leading WHERE keyword is optional. The supported subset is the SQL this
+// library emits: comparisons, AND/OR/NOT, IN lists, BETWEEN, IS NULL, CASE,
+// arithmetic, CONCAT/SUBSTR and POSITION/STRPOS containment checks. String
+// literals and quoted identifiers are read with the dialect's quoting rules.
+//
+// Constructs without a JSON Logic equivalent are reported as ErrUnsupportedSQL
+// and malformed SQL as ErrInvalidSQL; both carry the Line and Column of the
+// offending token.
+//
+// Example:
+//
+//	logic, _ := jsonlogic2sql.SQLToJSONLogic(jsonlogic2sql.DialectBigQuery,
+//		"WHERE amount > 1000 AND status IN ('active', 'pending')")
+//	// logic == `{"and":[{">":[{"var":"amount"},1000]},{"in":[{"var":"status"},["active","pending"]]}]}`
+func SQLToJSONLogic(d Dialect, sql string) (string, error) {
+	logic, err := sqlparse.ToJSONLogic(d, sql)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(logic); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// SQLToNode translates a SQL WHERE clause into a JSON Logic AST.
+func SQLToNode(d Dialect, sql string) (Node, error) {
+	logic, err := sqlparse.ToJSONLogic(d, sql)
+	if err != nil {
+		return nil, err
+	}
+	return ast.Parse(logic)
+}
@@ -0,0 +1,93 @@
+package jsonlogic2sql
+
+import (
+	"testing"
+)
+
+func TestSQLToJSONLogic(t *testing.T) {
+	got, err := SQLToJSONLogic(DialectBigQuery, "WHERE amount > 1000 AND status IN ('active', 'pending')")
+	if err != nil {
+		t.Fatalf("SQLToJSONLogic() error = %v", err)
+	}
+	want := `{"and":[{">":[{"var":"amount"},1000]},{"in":[{"var":"status"},["active","pending"]]}]}`
+	if got != want {
+		t.Errorf("SQLToJSONLogic() = %s, want %s", got, want)
+	}
+}
+
+func TestSQLToJSONLogic_Unsupported(t *testing.T) {
+	_, err := SQLToJSONLogic(DialectPostgreSQL, "WHERE amount > 1000\n  AND name ILIKE 'a%'")
+	tpErr, ok := AsTranspileError(err)
+	if !ok {
+		t.Fatalf("SQLToJSONLogic() error = %v, want TranspileError", err)
+	}
+	if tpErr.Code != ErrUnsupportedSQL || tpErr.Line != 2 || tpErr.Column != 12 || tpErr.Operator != "ILIKE" {
+		t.Errorf("error = %+v, want E103 ILIKE at 2:12", tpErr)
+	}
+}
+
+// TestSQLToJSONLogic_RoundTrip checks that SQL generated by the transpiler
+// translates back to JSON Logic that generates the same SQL.
+func TestSQLToJSONLogic_RoundTrip(t *testing.T) {
+	rules := []string{
+		`{"==": [{"var": "status"}, "active"]}`,
+		`{"!==": [{"var": "status"}, "closed"]}`,
+		`{"and": [{">": [{"var": "amount"}, 1000]}, {"<=": [{"var": "age"}, 65.5]}]}`,
+		`{"or": [{"==": [{"var": "a"}, null]}, {"!=": [{"var": "b"}, null]}]}`,
+		`{"!": {"in": [{"var": "country"}, ["US", "CA"]]}}`,
+		`{"<": [18, {"var": "age"}, 65]}`,
+		`{"in": ["vip", {"var": "name"}]}`,
+		`{"in": [{"var": "tag"}, {"var": "tags"}]}`,
+		`{"==": [{"var": ["status", "new"]}, "new"]}`,
+		`{">": [{"+": [{"var": "a"}, {"*": [{"var": "b"}, 2]}, 1]}, {"-": [{"var": "c"}]}]}`,
+		`{"==": [{"%": [{"var": "n"}, 2]}, 0]}`,
+		`{">": [{"max": [{"var": "a"}, {"var": "b"}]}, {"min": [{"var": "c"}, 10]}]}`,
+		`{"==": [{"cat": [{"var": "first"}, " ", {"var": "last"}]}, "Ada Lovelace"]}`,
+		`{"==": [{"substr": [{"var": "code"}, 0, 3]}, "ABC"]}`,
+		`{"==": [{"substr": [{"var": "code"}, {"var": "offset"}]}, "Z"]}`,
+		`{"==": [{"if": [{">": [{"var": "score"}, 90]}, "A", {">": [{"var": "score"}, 80]}, "B", "C"]}, "A"]}`,
+		`{"==": [{"var": "note"}, "it's \"quoted\" \\ here"]}`,
+		`{"!!": {"var": "flag"}}`,
+	}
+	dialects := []Dialect{DialectBigQuery, DialectSpanner, DialectPostgreSQL, DialectDuckDB, DialectClickHouse, DialectMySQL, DialectSQLite, DialectSnowflake, DialectSQLServer, DialectTrino, DialectOracle, DialectSparkSQL}
+
+	for _, d := range dialects {
+		transpiler, err := NewTranspiler(d)
+		if err != nil {
+			t.Fatalf("NewTranspiler(%s) error = %v", d, err)
+		}
+		for _, rule := range rules {
+			sql, err := transpiler.Transpile(rule)
+			if err != nil {
+				t.Fatalf("%s: Transpile(%s) error = %v", d, rule, err)
+			}
+			logic, err := SQLToJSONLogic(d, sql)
+			if err != nil {
+				t.Errorf("%s: SQLToJSONLogic(%s) error = %v", d, sql, err)
+				continue
+			}
+			roundTrip, err := transpiler.Transpile(logic)
+			if err != nil {
+				t.Errorf("%s: Transpile(%s) error = %v", d, logic, err)
+				continue
+			}
+			if roundTrip != sql {
+				t.Errorf("%s: round trip of %s\n  sql:   %s\n  logic: %s\n  again: %s", d, rule, sql, logic, roundTrip)
+			}
+		}
+	}
+}
+
+func TestSQLToNode(t *testing.T) {
+	node, err := SQLToNode(DialectDuckDB, `"order total" >= 10`)
+	if err != nil {
+		t.Fatalf("SQLToNode() error = %v", err)
+	}
+	op, ok := node.(*OpNode)
+	if !ok || op.Operator != ">=" {
+		t.Fatalf("node = %#v, want >= OpNode", node)
+	}
+	if v, ok := op.Args[0].(*VarNode); !ok || v.Name != "order total" {
+		t.Errorf("left = %#v, want var \"order total\"", op.Args[0])
+	}
+}
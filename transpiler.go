@@ -6,6 +6,7 @@ import (
 
 	"github.com/h22rana/jsonlogic2sql/internal/dialect"
 	tperrors "github.com/h22rana/jsonlogic2sql/internal/errors"
+	"github.com/h22rana/jsonlogic2sql/internal/format"
 	"github.com/h22rana/jsonlogic2sql/internal/operators"
 	"github.com/h22rana/jsonlogic2sql/internal/parser"
 )
@@ -17,6 +18,13 @@ const (
 	DialectPostgreSQL = dialect.DialectPostgreSQL
 	DialectDuckDB     = dialect.DialectDuckDB
 	DialectClickHouse = dialect.DialectClickHouse
+	DialectMySQL      = dialect.DialectMySQL
+	DialectSQLite     = dialect.DialectSQLite
+	DialectSnowflake  = dialect.DialectSnowflake
+	DialectSQLServer  = dialect.DialectSQLServer
+	DialectTrino      = dialect.DialectTrino
+	DialectOracle     = dialect.DialectOracle
+	DialectSparkSQL   = dialect.DialectSparkSQL
 )
 
 // Dialect is the type for SQL dialect selection.
@@ -26,6 +34,26 @@ type Dialect = dialect.Dialect
 type TranspilerConfig struct {
 	Dialect Dialect // Required: target SQL dialect
 	Schema  *Schema // Optional schema for field validation
+
+	// QuoteIdentifiers quotes every segment of a var name with the dialect's
+	// identifier quotes. By default only reserved words and unsafe names are quoted.
+	// On Oracle and Snowflake, segments that would otherwise be bare are
+	// upper-cased, since those dialects store unquoted names in upper case.
+	QuoteIdentifiers bool
+	// StrictIdentifiers rejects var names whose segments fall outside the safe
+	// identifier grammar [A-Za-z_][A-Za-z0-9_]* instead of quoting them.
+	StrictIdentifiers bool
+	// Optimize simplifies rules before SQL is generated: literal arithmetic and
+	// comparisons are folded, true and false are removed from and/or, nested
+	// and/or chains are flattened, !/!! chains are collapsed and if branches with
+	// a literal condition are resolved. Equality ORs on one column become IN
+	// lists, >= and <= pairs become BETWEEN, and redundant or contradictory
+	// bounds are removed. Rules are still checked as written, so errors in
+	// removed branches are reported.
+	Optimize bool
+	// Format lays out the generated SQL over several lines with the given
+	// indentation, keyword case and line width. Nil keeps the SQL on one line.
+	Format *FormatOptions
 }
 
 // Transpiler provides the main API for converting JSON Logic to SQL WHERE clauses.
@@ -44,7 +72,7 @@ func (t *Transpiler) SetSchema(schema *Schema) {
 }
 
 // NewTranspiler creates a new transpiler instance with the specified dialect.
-// Dialect is required - use DialectBigQuery, DialectSpanner, DialectPostgreSQL, or DialectDuckDB.
+// Dialect is required - use a built-in dialect such as DialectBigQuery, or one added with RegisterDialect.
 func NewTranspiler(d Dialect) (*Transpiler, error) {
 	if err := d.Validate(); err != nil {
 		return nil, err
@@ -64,7 +92,7 @@ func NewTranspiler(d Dialect) (*Transpiler, error) {
 }
 
 // NewTranspilerWithConfig creates a new transpiler instance with custom configuration.
-// Config.Dialect is required - use DialectBigQuery or DialectSpanner.
+// Config.Dialect is required - use a built-in dialect such as DialectBigQuery, or one added with RegisterDialect.
 func NewTranspilerWithConfig(config *TranspilerConfig) (*Transpiler, error) {
 	if config == nil {
 		return nil, fmt.Errorf("config cannot be nil")
@@ -72,28 +100,71 @@ func NewTranspilerWithConfig(config *TranspilerConfig) (*Transpiler, error) {
 	if err := config.Dialect.Validate(); err != nil {
 		return nil, err
 	}
+	if config.Format != nil && (config.Format.Indent < 0 || config.Format.LineWidth < 0) {
+		return nil, fmt.Errorf("format indent and line width cannot be negative")
+	}
 
 	opConfig := operators.NewOperatorConfig(config.Dialect, config.Schema)
+	opConfig.QuoteIdentifiers = config.QuoteIdentifiers
+	opConfig.StrictIdentifiers = config.StrictIdentifiers
+	opConfig.Between = config.Optimize
 	t := &Transpiler{
 		parser:          parser.NewParser(opConfig),
 		operatorConfig:  opConfig,
 		config:          config,
 		customOperators: NewOperatorRegistry(),
 	}
+	t.parser.SetOptimize(config.Optimize)
 	t.setupCustomOperatorLookup()
 	return t, nil
 }
 
 // setupCustomOperatorLookup configures the parser to use our custom operator registry.
 func (t *Transpiler) setupCustomOperatorLookup() {
-	t.parser.SetCustomOperatorLookup(func(operatorName string) (parser.CustomOperatorHandler, bool) {
-		handler, ok := t.customOperators.Get(operatorName)
-		if !ok {
-			return nil, false
-		}
-		// Wrap the public OperatorHandler to implement parser.CustomOperatorHandler
-		return handler, true
-	})
+	t.parser.SetCustomOperatorLookup(t.lookupCustomOperator)
+}
+
+// lookupCustomOperator resolves a custom operator from the registry for the parser.
+func (t *Transpiler) lookupCustomOperator(operatorName string) (parser.CustomOperatorHandler, bool) {
+	handler, ok := t.customOperators.Get(operatorName)
+	if !ok {
+		return nil, false
+	}
+	// Wrap the public OperatorHandler to implement parser.CustomOperatorHandler
+	return handler, true
+}
+
+// newParameterizedParser creates a parser that binds literal values as parameters.
+// A fresh parser and config are used per call so that concurrent transpilations
+// never share a parameter collector.
+func (t *Transpiler) newParameterizedParser() (*parser.Parser, *operators.ParamCollector) {
+	params := operators.NewParamCollector(t.operatorConfig.Dialect)
+	opConfig := operators.NewOperatorConfig(t.operatorConfig.Dialect, t.operatorConfig.Schema)
+	opConfig.QuoteIdentifiers = t.operatorConfig.QuoteIdentifiers
+	opConfig.StrictIdentifiers = t.operatorConfig.StrictIdentifiers
+	opConfig.Between = t.operatorConfig.Between
+	opConfig.Params = params
+
+	p := parser.NewParser(opConfig)
+	p.SetCustomOperatorLookup(t.lookupCustomOperator)
+	p.SetOptimize(t.config.Optimize)
+	return p, params
+}
+
+// formatSQL applies the configured output format to generated SQL.
+func (t *Transpiler) formatSQL(sql string) string {
+	if t.config.Format == nil {
+		return sql
+	}
+	return format.SQL(sql, t.config.Dialect, *t.config.Format)
+}
+
+// formatted applies the configured output format to the result of a parse.
+func (t *Transpiler) formatted(sql string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return t.formatSQL(sql), nil
 }
 
 // GetDialect returns the configured dialect.
@@ -220,17 +291,17 @@ func (t *Transpiler) Transpile(jsonLogic string) (string, error) {
 		return "", tperrors.NewInvalidJSON(err)
 	}
 
-	return t.parser.Parse(logic)
+	return t.formatted(t.parser.Parse(logic))
 }
 
 // TranspileFromMap converts a pre-parsed JSON Logic map to a SQL WHERE clause.
 func (t *Transpiler) TranspileFromMap(logic map[string]interface{}) (string, error) {
-	return t.parser.Parse(logic)
+	return t.formatted(t.parser.Parse(logic))
 }
 
 // TranspileFromInterface converts any JSON Logic interface{} to a SQL WHERE clause.
 func (t *Transpiler) TranspileFromInterface(logic interface{}) (string, error) {
-	return t.parser.Parse(logic)
+	return t.formatted(t.parser.Parse(logic))
 }
 
 // TranspileCondition converts a JSON Logic string to a SQL condition without the WHERE keyword.
@@ -241,17 +312,74 @@ func (t *Transpiler) TranspileCondition(jsonLogic string) (string, error) {
 		return "", tperrors.NewInvalidJSON(err)
 	}
 
-	return t.parser.ParseCondition(logic)
+	return t.formatted(t.parser.ParseCondition(logic))
 }
 
 // TranspileConditionFromMap converts a pre-parsed JSON Logic map to a SQL condition without the WHERE keyword.
 func (t *Transpiler) TranspileConditionFromMap(logic map[string]interface{}) (string, error) {
-	return t.parser.ParseCondition(logic)
+	return t.formatted(t.parser.ParseCondition(logic))
 }
 
 // TranspileConditionFromInterface converts any JSON Logic interface{} to a SQL condition without the WHERE keyword.
 func (t *Transpiler) TranspileConditionFromInterface(logic interface{}) (string, error) {
-	return t.parser.ParseCondition(logic)
+	return t.formatted(t.parser.ParseCondition(logic))
+}
+
+// TranspileParameterized converts a JSON Logic string to a parameterized SQL WHERE clause.
+// Literal values are replaced by dialect-specific placeholders and returned as bind
+// arguments in placeholder order:
+//   - BigQuery/Spanner/SQLServer: @p1, @p2, ...
+//   - PostgreSQL/DuckDB: $1, $2, ...
+//   - ClickHouse: {p1:String}, {p2:Int64}, ...
+//   - MySQL/Trino/SparkSQL: ?, ?, ... (a repeated fragment repeats its argument)
+//   - SQLite: ?1, ?2, ...
+//   - Snowflake/Oracle: :1, :2, ...
+//
+// Example:
+//
+//	sql, args, _ := transpiler.TranspileParameterized(`{"==": [{"var": "name"}, "O'Brien"]}`)
+//	// PostgreSQL: sql = "WHERE name = $1", args = []any{"O'Brien"}
+func (t *Transpiler) TranspileParameterized(jsonLogic string) (string, []any, error) {
+	var logic interface{}
+	if err := json.Unmarshal([]byte(jsonLogic), &logic); err != nil {
+		return "", nil, tperrors.NewInvalidJSON(err)
+	}
+
+	return t.TranspileParameterizedFromInterface(logic)
+}
+
+// TranspileParameterizedFromInterface converts any JSON Logic interface{} to a parameterized SQL WHERE clause.
+func (t *Transpiler) TranspileParameterizedFromInterface(logic interface{}) (string, []any, error) {
+	p, params := t.newParameterizedParser()
+	sql, err := p.Parse(logic)
+	if err != nil {
+		return "", nil, err
+	}
+	sql, args := params.Bind(sql)
+	return t.formatSQL(sql), args, nil
+}
+
+// TranspileConditionParameterized converts a JSON Logic string to a parameterized SQL condition
+// without the WHERE keyword. See TranspileParameterized for the placeholder format.
+func (t *Transpiler) TranspileConditionParameterized(jsonLogic string) (string, []any, error) {
+	var logic interface{}
+	if err := json.Unmarshal([]byte(jsonLogic), &logic); err != nil {
+		return "", nil, tperrors.NewInvalidJSON(err)
+	}
+
+	return t.TranspileConditionParameterizedFromInterface(logic)
+}
+
+// TranspileConditionParameterizedFromInterface converts any JSON Logic interface{} to a parameterized
+// SQL condition without the WHERE keyword.
+func (t *Transpiler) TranspileConditionParameterizedFromInterface(logic interface{}) (string, []any, error) {
+	p, params := t.newParameterizedParser()
+	sql, err := p.ParseCondition(logic)
+	if err != nil {
+		return "", nil, err
+	}
+	sql, args := params.Bind(sql)
+	return t.formatSQL(sql), args, nil
 }
 
 // Convenience functions for direct usage without creating a Transpiler instance
@@ -315,3 +443,24 @@ func TranspileConditionFromInterface(d Dialect, logic interface{}) (string, erro
 	}
 	return t.TranspileConditionFromInterface(logic)
 }
+
+// TranspileParameterized converts a JSON Logic string to a parameterized SQL WHERE clause.
+// Dialect is required - use DialectBigQuery, DialectSpanner, DialectPostgreSQL, DialectDuckDB, DialectClickHouse, DialectMySQL, DialectSQLite, DialectSnowflake, DialectSQLServer, DialectTrino, DialectOracle, or DialectSparkSQL.
+func TranspileParameterized(d Dialect, jsonLogic string) (string, []any, error) {
+	t, err := NewTranspiler(d)
+	if err != nil {
+		return "", nil, err
+	}
+	return t.TranspileParameterized(jsonLogic)
+}
+
+// TranspileConditionParameterized converts a JSON Logic string to a parameterized SQL condition
+// without the WHERE keyword.
+// Dialect is required - use DialectBigQuery, DialectSpanner, DialectPostgreSQL, DialectDuckDB, DialectClickHouse, DialectMySQL, DialectSQLite, DialectSnowflake, DialectSQLServer, DialectTrino, DialectOracle, or DialectSparkSQL.
+func TranspileConditionParameterized(d Dialect, jsonLogic string) (string, []any, error) {
+	t, err := NewTranspiler(d)
+	if err != nil {
+		return "", nil, err
+	}
+	return t.TranspileConditionParameterized(jsonLogic)
+}
@@ -1,6 +1,8 @@
 package jsonlogic2sql
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -683,7 +685,7 @@ func TestComprehensiveNestedExpressions(t *testing.T) {
 		{
 			name:     "deeply nested reduce filter",
 			input:    `{"reduce": [{"filter": [{"var": "data"}, {"and": [{"some": [{"var": "tags"}, {"==": [{"var": "elem"}, "important"]}]}, {">": [{"var": "value"}, 0]}]}]}, {"+": [{"var": "accumulator"}, {"reduce": [{"var": "current.subitems"}, {"+": [{"var": "acc"}, {"var": "item"}]}, 0]}]}, 0]}`,
-			expected: "WHERE (SELECT (0 + (SELECT (acc + elem) FROM UNNEST(elem.subelems) AS elem)) FROM UNNEST(ARRAY(SELECT elem FROM UNNEST(data) AS elem WHERE (EXISTS (SELECT 1 FROM UNNEST(tags) AS elem WHERE elem = 'important') AND value > 0))) AS elem)",
+			expected: "WHERE (SELECT (0 + (SELECT (acc + elem) FROM UNNEST(elem.subitems) AS elem)) FROM UNNEST(ARRAY(SELECT elem FROM UNNEST(data) AS elem WHERE (EXISTS (SELECT 1 FROM UNNEST(tags) AS elem WHERE elem = 'important') AND value > 0))) AS elem)",
 			hasError: false,
 		},
 		{
@@ -695,7 +697,7 @@ func TestComprehensiveNestedExpressions(t *testing.T) {
 		{
 			name:     "nested comparison in numeric",
 			input:    `{"+": [{">": [{"var": "a"}, 5]}, {"<": [{"var": "b"}, 10]}]}`,
-			expected: "WHERE ('a' > '5' + 'b' < '10')",
+			expected: "WHERE (a > 5 + b < 10)",
 			hasError: false,
 		},
 		{
@@ -749,7 +751,7 @@ func TestComprehensiveNestedExpressions(t *testing.T) {
 		{
 			name:     "very deeply nested",
 			input:    `{"and": [{"some": [{"filter": [{"var": "data"}, {">": [{"var": "value"}, 0]}]}, {"all": [{"var": "elem.items"}, {">=": [{"var": "elem.score"}, 50]}]}]}, {">": [{"reduce": [{"var": "totals"}, {"+": [{"var": "accumulator"}, {"*": [{"var": "current"}, {"if": [{">": [{"var": "current"}, 100]}, 2, 1]}]}]}, 0]}, 1000]}]}`,
-			expected: "WHERE (EXISTS (SELECT 1 FROM UNNEST(ARRAY(SELECT elem FROM UNNEST(data) AS elem WHERE value > 0)) AS elem WHERE NOT EXISTS (SELECT 1 FROM UNNEST(elem.elems) AS elem WHERE NOT (elem.score >= 50))) AND (SELECT (0 + (elem * CASE WHEN elem > 100 THEN 2 ELSE 1 END)) FROM UNNEST(totals) AS elem) > 1000)",
+			expected: "WHERE (EXISTS (SELECT 1 FROM UNNEST(ARRAY(SELECT elem FROM UNNEST(data) AS elem WHERE value > 0)) AS elem WHERE NOT EXISTS (SELECT 1 FROM UNNEST(elem.items) AS elem WHERE NOT (elem.score >= 50))) AND (SELECT (0 + (elem * CASE WHEN elem > 100 THEN 2 ELSE 1 END)) FROM UNNEST(totals) AS elem) > 1000)",
 			hasError: false,
 		},
 		{
@@ -1066,7 +1068,7 @@ func TestAdditionalEdgeCases(t *testing.T) {
 		{
 			name:     "string with parentheses should be quoted",
 			input:    `{"==": [{"var": "desc"}, "Item (Large)"]}`,
-			expected: "WHERE desc = 'Item (Large)'",
+			expected: "WHERE `desc` = 'Item (Large)'",
 			hasError: false,
 		},
 		{
@@ -1212,14 +1214,14 @@ func TestArrayOperatorsDialectSupport(t *testing.T) {
 				{
 					name:     "reduce with MIN pattern",
 					input:    `{"reduce": [{"var": "values"}, {"min": [{"var": "accumulator"}, {"var": "current"}]}, 999999]}`,
-					expected: "WHERE 999999 + COALESCE((SELECT MIN(elem) FROM UNNEST(values) AS elem), 0)",
+					expected: "WHERE LEAST(999999, COALESCE((SELECT MIN(elem) FROM UNNEST(values) AS elem), 999999))",
 				},
 
 				// Reduce operator tests - MAX pattern
 				{
 					name:     "reduce with MAX pattern",
 					input:    `{"reduce": [{"var": "values"}, {"max": [{"var": "accumulator"}, {"var": "current"}]}, 0]}`,
-					expected: "WHERE 0 + COALESCE((SELECT MAX(elem) FROM UNNEST(values) AS elem), 0)",
+					expected: "WHERE GREATEST(0, COALESCE((SELECT MAX(elem) FROM UNNEST(values) AS elem), 0))",
 				},
 
 				// Reduce operator tests - general pattern
@@ -1504,6 +1506,41 @@ func TestNewTranspilerWithConfig(t *testing.T) {
 			config:    &TranspilerConfig{Dialect: DialectClickHouse},
 			wantError: false,
 		},
+		{
+			name:      "MySQL dialect",
+			config:    &TranspilerConfig{Dialect: DialectMySQL},
+			wantError: false,
+		},
+		{
+			name:      "SQLite dialect",
+			config:    &TranspilerConfig{Dialect: DialectSQLite},
+			wantError: false,
+		},
+		{
+			name:      "Snowflake dialect",
+			config:    &TranspilerConfig{Dialect: DialectSnowflake},
+			wantError: false,
+		},
+		{
+			name:      "SQLServer dialect",
+			config:    &TranspilerConfig{Dialect: DialectSQLServer},
+			wantError: false,
+		},
+		{
+			name:      "Trino dialect",
+			config:    &TranspilerConfig{Dialect: DialectTrino},
+			wantError: false,
+		},
+		{
+			name:      "Oracle dialect",
+			config:    &TranspilerConfig{Dialect: DialectOracle},
+			wantError: false,
+		},
+		{
+			name:      "SparkSQL dialect",
+			config:    &TranspilerConfig{Dialect: DialectSparkSQL},
+			wantError: false,
+		},
 		{
 			name:      "unspecified dialect",
 			config:    &TranspilerConfig{},
@@ -1535,6 +1572,213 @@ func TestNewTranspilerWithConfig(t *testing.T) {
 	}
 }
 
+func TestNewTranspilerWithConfig_Identifiers(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *TranspilerConfig
+		jsonLogic string
+		expected  string
+		wantError bool
+	}{
+		{
+			name:      "quote as needed by default",
+			config:    &TranspilerConfig{Dialect: DialectPostgreSQL},
+			jsonLogic: `{"==": [{"var": "user.order"}, 1]}`,
+			expected:  `WHERE user."order" = 1`,
+		},
+		{
+			name:      "quote all identifiers",
+			config:    &TranspilerConfig{Dialect: DialectPostgreSQL, QuoteIdentifiers: true},
+			jsonLogic: `{"==": [{"var": "user.order"}, 1]}`,
+			expected:  `WHERE "user"."order" = 1`,
+		},
+		{
+			name:      "quote all keeps lambda variables bare",
+			config:    &TranspilerConfig{Dialect: DialectBigQuery, QuoteIdentifiers: true},
+			jsonLogic: `{"some": [{"var": "items"}, {">": [{"var": "item.price"}, 10]}]}`,
+			expected:  "WHERE EXISTS (SELECT 1 FROM UNNEST(`items`) AS elem WHERE elem.`price` > 10)",
+		},
+		{
+			name:      "quote all in reduce field",
+			config:    &TranspilerConfig{Dialect: DialectBigQuery, QuoteIdentifiers: true},
+			jsonLogic: `{">": [{"reduce": [{"var": "items"}, {"+": [{"var": "accumulator"}, {"var": "current.price"}]}, 0]}, 10]}`,
+			expected:  "WHERE 0 + COALESCE((SELECT SUM(elem.`price`) FROM UNNEST(`items`) AS elem), 0) > 10",
+		},
+		{
+			name:      "strict rejects unsafe name",
+			config:    &TranspilerConfig{Dialect: DialectBigQuery, StrictIdentifiers: true},
+			jsonLogic: `{"==": [{"var": "name; DROP TABLE users"}, 1]}`,
+			wantError: true,
+		},
+		{
+			name:      "strict rejects unsafe name in missing",
+			config:    &TranspilerConfig{Dialect: DialectBigQuery, StrictIdentifiers: true},
+			jsonLogic: `{"missing": ["ok", "not-ok"]}`,
+			wantError: true,
+		},
+		{
+			name:      "strict quotes reserved words",
+			config:    &TranspilerConfig{Dialect: DialectClickHouse, StrictIdentifiers: true},
+			jsonLogic: `{"==": [{"var": "group"}, "a"]}`,
+			expected:  "WHERE `group` = 'a'",
+		},
+		{
+			name:      "empty path segment",
+			config:    &TranspilerConfig{Dialect: DialectDuckDB},
+			jsonLogic: `{"==": [{"var": "user..name"}, 1]}`,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := NewTranspilerWithConfig(tt.config)
+			if err != nil {
+				t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+			}
+			result, err := tr.Transpile(tt.jsonLogic)
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Transpile() expected error, got %s", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transpile() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewTranspilerWithConfig_Optimize(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   Dialect
+		jsonLogic string
+		expected  string
+		optimized string
+	}{
+		{
+			name:      "identity elements and folding",
+			dialect:   DialectPostgreSQL,
+			jsonLogic: `{"and": [true, {">": [{"var": "amount"}, {"*": [10, 100]}]}, {"or": [false, {"==": [{"var": "status"}, "active"]}]}]}`,
+			expected:  "WHERE (TRUE AND amount > (10 * 100) AND (FALSE OR status = 'active'))",
+			optimized: "WHERE (amount > 1000 AND status = 'active')",
+		},
+		{
+			name:      "nested and is flattened",
+			dialect:   DialectBigQuery,
+			jsonLogic: `{"and": [{"==": [{"var": "a"}, 1]}, {"and": [{"==": [{"var": "b"}, 2]}, {"==": [{"var": "c"}, 3]}]}]}`,
+			expected:  "WHERE (a = 1 AND (b = 2 AND c = 3))",
+			optimized: "WHERE (a = 1 AND b = 2 AND c = 3)",
+		},
+		{
+			name:      "if with a literal condition",
+			dialect:   DialectMySQL,
+			jsonLogic: `{"==": [{"if": [true, {"var": "a"}, {"var": "b"}]}, 1]}`,
+			expected:  "WHERE CASE WHEN TRUE THEN a ELSE b END = 1",
+			optimized: "WHERE a = 1",
+		},
+		{
+			name:      "not chain",
+			dialect:   DialectDuckDB,
+			jsonLogic: `{"!": {"!": {"<": [{"var": "a"}, 5]}}}`,
+			expected:  "WHERE NOT (NOT (a < 5))",
+			optimized: "WHERE a < 5",
+		},
+		{
+			name:      "constant rule",
+			dialect:   DialectSQLServer,
+			jsonLogic: `{"or": [{"var": "a"}, {">": [2, 1]}]}`,
+			expected:  "WHERE (a = 1 OR 2 > 1)",
+			optimized: "WHERE 1 = 1",
+		},
+		{
+			name:      "equality disjunction becomes IN",
+			dialect:   DialectBigQuery,
+			jsonLogic: `{"or": [{"==": [{"var": "status"}, "new"]}, {"==": [{"var": "status"}, "open"]}, {"==": [{"var": "status"}, "held"]}]}`,
+			expected:  "WHERE (status = 'new' OR status = 'open' OR status = 'held')",
+			optimized: "WHERE status IN ('new', 'open', 'held')",
+		},
+		{
+			name:      "range pair becomes BETWEEN",
+			dialect:   DialectPostgreSQL,
+			jsonLogic: `{"and": [{">=": [{"var": "amount"}, 100]}, {"<=": [{"var": "amount"}, 500]}, {">": [{"var": "amount"}, 50]}]}`,
+			expected:  "WHERE (amount >= 100 AND amount <= 500 AND amount > 50)",
+			optimized: "WHERE amount BETWEEN 100 AND 500",
+		},
+		{
+			name:      "contradictory range",
+			dialect:   DialectOracle,
+			jsonLogic: `{"and": [{">": [{"var": "amount"}, 500]}, {"<": [{"var": "amount"}, 100]}]}`,
+			expected:  "WHERE (amount > 500 AND amount < 100)",
+			optimized: "WHERE 1 = 0",
+		},
+		{
+			name:      "rule folding to a non-boolean is unchanged",
+			dialect:   DialectPostgreSQL,
+			jsonLogic: `{"+": [1, 2]}`,
+			expected:  "WHERE (1 + 2)",
+			optimized: "WHERE (1 + 2)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, optimize := range []bool{false, true} {
+				tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: tt.dialect, Optimize: optimize})
+				if err != nil {
+					t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+				}
+				result, err := tr.Transpile(tt.jsonLogic)
+				if err != nil {
+					t.Fatalf("Transpile() unexpected error: %v", err)
+				}
+				want := tt.expected
+				if optimize {
+					want = tt.optimized
+				}
+				if result != want {
+					t.Errorf("Transpile() with Optimize=%v = %s, want %s", optimize, result, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewTranspilerWithConfig_OptimizeParameterized(t *testing.T) {
+	tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: DialectPostgreSQL, Optimize: true})
+	if err != nil {
+		t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+	}
+	sql, args, err := tr.TranspileParameterized(`{"and": [true, {">": [{"var": "amount"}, {"+": [400, 600]}]}]}`)
+	if err != nil {
+		t.Fatalf("TranspileParameterized() unexpected error: %v", err)
+	}
+	if want := "WHERE amount > $1"; sql != want {
+		t.Errorf("TranspileParameterized() sql = %q, want %q", sql, want)
+	}
+	if len(args) != 1 || args[0] != float64(1000) {
+		t.Errorf("TranspileParameterized() args = %v, want [1000]", args)
+	}
+
+	// Errors are reported for the rule as written, even in removed branches.
+	for _, rule := range []string{
+		`{"and": [false, {"unknown": [1]}]}`,
+		`{"if": [true, {"var": "a"}, {"==": [1]}]}`,
+	} {
+		if _, err := tr.Transpile(rule); err == nil {
+			t.Errorf("Transpile(%s) expected an error in a removed branch", rule)
+		}
+		if _, _, err := tr.TranspileParameterized(rule); err == nil {
+			t.Errorf("TranspileParameterized(%s) expected an error in a removed branch", rule)
+		}
+	}
+}
+
 func TestNewTranspilerWithConfig_WithSchema(t *testing.T) {
 	schema := NewSchema([]FieldSchema{
 		{Name: "amount", Type: FieldTypeInteger},
@@ -1577,6 +1821,13 @@ func TestTranspiler_GetDialect(t *testing.T) {
 		{"PostgreSQL", DialectPostgreSQL},
 		{"DuckDB", DialectDuckDB},
 		{"ClickHouse", DialectClickHouse},
+		{"MySQL", DialectMySQL},
+		{"SQLite", DialectSQLite},
+		{"Snowflake", DialectSnowflake},
+		{"SQLServer", DialectSQLServer},
+		{"Trino", DialectTrino},
+		{"Oracle", DialectOracle},
+		{"SparkSQL", DialectSparkSQL},
 	}
 
 	for _, tt := range tests {
@@ -1878,3 +2129,365 @@ func TestPackageLevel_TranspileConditionFromInterface(t *testing.T) {
 		})
 	}
 }
+
+func TestTranspiler_TranspileParameterized(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		input    string
+		expected string
+		args     []any
+	}{
+		{
+			name:     "PostgreSQL comparison",
+			dialect:  DialectPostgreSQL,
+			input:    `{"and": [{">": [{"var": "amount"}, 1000]}, {"==": [{"var": "name"}, "O'Brien"]}]}`,
+			expected: "WHERE (amount > $1 AND name = $2)",
+			args:     []any{float64(1000), "O'Brien"},
+		},
+		{
+			name:     "comparison nested in arithmetic",
+			dialect:  DialectPostgreSQL,
+			input:    `{"+": [{">": [{"var": "a"}, 5]}, 1]}`,
+			expected: "WHERE (a > $1 + $2)",
+			args:     []any{float64(5), float64(1)},
+		},
+		{
+			name:     "DuckDB in list",
+			dialect:  DialectDuckDB,
+			input:    `{"in": [{"var": "country"}, ["CN", "RU"]]}`,
+			expected: "WHERE country IN ($1, $2)",
+			args:     []any{"CN", "RU"},
+		},
+		{
+			name:     "BigQuery named parameters",
+			dialect:  DialectBigQuery,
+			input:    `{"==": [{"var": ["status", "pending"]}, "active"]}`,
+			expected: "WHERE COALESCE(status, @p1) = @p2",
+			args:     []any{"pending", "active"},
+		},
+		{
+			name:     "Spanner string containment",
+			dialect:  DialectSpanner,
+			input:    `{"in": ["admin", {"var": "roles"}]}`,
+			expected: "WHERE STRPOS(roles, @p1) > 0",
+			args:     []any{"admin"},
+		},
+		{
+			name:     "ClickHouse typed placeholders",
+			dialect:  DialectClickHouse,
+			input:    `{"==": [{"substr": [{"var": "code"}, 0, 2]}, "US"]}`,
+			expected: "WHERE substring(code, {p1:Int64}, {p2:Int64}) = {p3:String}",
+			args:     []any{1, 2, "US"},
+		},
+		{
+			name:     "PostgreSQL cat and if",
+			dialect:  DialectPostgreSQL,
+			input:    `{"==": [{"cat": [{"var": "first"}, " ", {"var": "last"}]}, {"if": [{"var": "vip"}, "VIP", "Regular"]}]}`,
+			expected: "WHERE CONCAT(first, $1, last) = CASE WHEN vip THEN $2 ELSE $3 END",
+			args:     []any{" ", "VIP", "Regular"},
+		},
+		{
+			name:     "PostgreSQL array operator",
+			dialect:  DialectPostgreSQL,
+			input:    `{"all": [{"var": "scores"}, {">=": [{"var": ""}, 70]}]}`,
+			expected: "WHERE NOT EXISTS (SELECT 1 FROM UNNEST(scores) AS elem WHERE NOT (elem >= $1))",
+			args:     []any{float64(70)},
+		},
+		{
+			name:     "MySQL positional placeholders",
+			dialect:  DialectMySQL,
+			input:    `{"and": [{"==": [{"var": "name"}, "O'Brien"]}, {"in": [{"var": "country"}, ["CN", "RU"]]}]}`,
+			expected: "WHERE (name = ? AND country IN (?, ?))",
+			args:     []any{"O'Brien", "CN", "RU"},
+		},
+		{
+			name:     "MySQL repeats arguments of repeated fragments",
+			dialect:  DialectMySQL,
+			input:    `{"<": [{"var": "low"}, 5, {"var": "high"}]}`,
+			expected: "WHERE (low < ? AND ? < high)",
+			args:     []any{float64(5), float64(5)},
+		},
+		{
+			name:     "MySQL reduce binds the initial value",
+			dialect:  DialectMySQL,
+			input:    `{">": [{"reduce": [{"var": "xs"}, {"+": [{"var": "current"}, {"var": "accumulator"}]}, 2]}, 10]}`,
+			expected: "WHERE ? + COALESCE((SELECT SUM(elem) FROM JSON_TABLE(xs, '$[*]' COLUMNS (elem DOUBLE PATH '$')) AS jt), 0) > ?",
+			args:     []any{float64(2), float64(10)},
+		},
+		{
+			name:     "MySQL reduce MIN binds the initial value twice",
+			dialect:  DialectMySQL,
+			input:    `{">": [{"reduce": [{"var": "xs"}, {"min": [{"var": "current"}, {"var": "accumulator"}]}, 2]}, 10]}`,
+			expected: "WHERE LEAST(?, COALESCE((SELECT MIN(elem) FROM JSON_TABLE(xs, '$[*]' COLUMNS (elem DOUBLE PATH '$')) AS jt), ?)) > ?",
+			args:     []any{float64(2), float64(2), float64(10)},
+		},
+		{
+			name:     "SQLite numbered placeholders",
+			dialect:  DialectSQLite,
+			input:    `{"<": [{"var": "low"}, 5, {"var": "high"}]}`,
+			expected: "WHERE (low < ?1 AND ?1 < high)",
+			args:     []any{float64(5)},
+		},
+		{
+			name:     "SQLite array operator",
+			dialect:  DialectSQLite,
+			input:    `{"some": [{"var": "items"}, {">": [{"var": "item.price"}, 100]}]}`,
+			expected: "WHERE EXISTS (SELECT 1 FROM json_each(items) AS je WHERE json_extract(je.value, '$.price') > ?1)",
+			args:     []any{float64(100)},
+		},
+		{
+			name:     "Snowflake numbered placeholders",
+			dialect:  DialectSnowflake,
+			input:    `{"in": [{"var": "status"}, ["a", "b"]]}`,
+			expected: "WHERE status IN (:1, :2)",
+			args:     []any{"a", "b"},
+		},
+		{
+			name:     "Snowflake reduce keeps the accumulator",
+			dialect:  DialectSnowflake,
+			input:    `{">": [{"reduce": [{"var": "xs"}, {"*": [{"var": "accumulator"}, {"var": "current"}]}, 2]}, 10]}`,
+			expected: "WHERE REDUCE(xs, :1, (acc, elem) -> (acc * elem)) > :2",
+			args:     []any{float64(2), float64(10)},
+		},
+		{
+			name:     "SQLServer named placeholders",
+			dialect:  DialectSQLServer,
+			input:    `{"and": [{"==": [{"var": "active"}, true]}, {"some": [{"var": "items"}, {">": [{"var": "item.price"}, 100]}]}]}`,
+			expected: "WHERE (active = @p1 AND EXISTS (SELECT 1 FROM OPENJSON(items) AS je WHERE JSON_VALUE(je.value, '$.price') > @p2))",
+			args:     []any{true, float64(100)},
+		},
+		{
+			name:     "Trino positional placeholders follow the final SQL",
+			dialect:  DialectTrino,
+			input:    `{"and": [{"<": [1, {"var": "score"}, 10]}, {"some": [{"var": "items"}, {">": [{"var": "item.price"}, 100]}]}]}`,
+			expected: "WHERE ((? < score AND score < ?) AND any_match(items, elem -> elem.price > ?))",
+			args:     []any{float64(1), float64(10), float64(100)},
+		},
+		{
+			name:     "Oracle numbered placeholders",
+			dialect:  DialectOracle,
+			input:    `{"==": [{"%": [{"var": "n"}, 2]}, 0]}`,
+			expected: "WHERE MOD(n, :1) = :2",
+			args:     []any{float64(2), float64(0)},
+		},
+		{
+			name:     "Oracle empty string is never bound",
+			dialect:  DialectOracle,
+			input:    `{"and": [{"!=": [{"var": "name"}, ""]}, {">": [{"var": "age"}, 18]}]}`,
+			expected: "WHERE (name IS NOT NULL AND age > :1)",
+			args:     []any{float64(18)},
+		},
+		{
+			name:     "SparkSQL positional placeholders",
+			dialect:  DialectSparkSQL,
+			input:    `{"and": [{"in": ["vip", {"var": "name"}]}, {"all": [{"var": "scores"}, {">=": [{"var": ""}, 50]}]}]}`,
+			expected: "WHERE (instr(name, ?) > 0 AND forall(scores, elem -> elem >= ?))",
+			args:     []any{"vip", float64(50)},
+		},
+		{
+			name:     "NULL is never bound",
+			dialect:  DialectPostgreSQL,
+			input:    `{"!=": [{"var": "deleted_at"}, null]}`,
+			expected: "WHERE deleted_at IS NOT NULL",
+			args:     []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := NewTranspiler(tt.dialect)
+			if err != nil {
+				t.Fatalf("NewTranspiler() error: %v", err)
+			}
+			sql, args, err := tr.TranspileParameterized(tt.input)
+			if err != nil {
+				t.Fatalf("TranspileParameterized() error: %v", err)
+			}
+			if sql != tt.expected {
+				t.Errorf("TranspileParameterized() sql = %q, want %q", sql, tt.expected)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("TranspileParameterized() args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestTranspiler_TranspileParameterized_CustomOperator(t *testing.T) {
+	tr, _ := NewTranspiler(DialectPostgreSQL)
+	_ = tr.RegisterOperatorFunc("startsWith", func(_ string, args []interface{}) (string, error) {
+		return fmt.Sprintf("STARTS_WITH(%s, %s)", args[0], args[1]), nil
+	})
+
+	sql, args, err := tr.TranspileConditionParameterized(`{"startsWith": [{"var": "name"}, "Jo"]}`)
+	if err != nil {
+		t.Fatalf("TranspileConditionParameterized() error: %v", err)
+	}
+	if sql != "STARTS_WITH(name, $1)" {
+		t.Errorf("sql = %q, want %q", sql, "STARTS_WITH(name, $1)")
+	}
+	if !reflect.DeepEqual(args, []any{"Jo"}) {
+		t.Errorf("args = %#v, want %#v", args, []any{"Jo"})
+	}
+
+	// The non-parameterized API must keep inlining literals.
+	inline, err := tr.TranspileCondition(`{"startsWith": [{"var": "name"}, "Jo"]}`)
+	if err != nil {
+		t.Fatalf("TranspileCondition() error: %v", err)
+	}
+	if inline != "STARTS_WITH(name, 'Jo')" {
+		t.Errorf("TranspileCondition() = %q, want %q", inline, "STARTS_WITH(name, 'Jo')")
+	}
+}
+
+func TestTranspileParameterized_PackageLevel(t *testing.T) {
+	sql, args, err := TranspileConditionParameterized(DialectDuckDB, `{"<": [18, {"var": "age"}, 65]}`)
+	if err != nil {
+		t.Fatalf("TranspileConditionParameterized() error: %v", err)
+	}
+	if sql != "($1 < age AND age < $2)" {
+		t.Errorf("sql = %q, want %q", sql, "($1 < age AND age < $2)")
+	}
+	if !reflect.DeepEqual(args, []any{float64(18), float64(65)}) {
+		t.Errorf("args = %#v", args)
+	}
+
+	if _, _, err := TranspileParameterized(DialectPostgreSQL, `{invalid`); !IsErrorCode(err, ErrInvalidJSON) {
+		t.Errorf("expected ErrInvalidJSON, got %v", err)
+	}
+	if _, _, err := TranspileParameterized(Dialect(0), `{"var": "x"}`); err == nil {
+		t.Error("expected error for unspecified dialect")
+	}
+}
+
+func TestTranspiler_DateOperators(t *testing.T) {
+	schema := NewSchema([]FieldSchema{
+		{Name: "signup_date", Type: FieldTypeDate},
+		{Name: "created_at", Type: FieldTypeTimestamp},
+		{Name: "trial_days", Type: FieldTypeInteger},
+	})
+
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		rule     string
+		expected string
+	}{
+		{
+			"signed up within the last 30 days", DialectBigQuery,
+			`{"within_last": [{"var": "signup_date"}, 30]}`,
+			"WHERE (signup_date >= DATE_SUB(CURRENT_DATE(), INTERVAL 30 DAY) AND signup_date <= CURRENT_DATE())",
+		},
+		{
+			"days_ago shorthand", DialectPostgreSQL,
+			`{">": [{"var": "created_at"}, {"days_ago": 7}]}`,
+			"WHERE created_at > (CURRENT_TIMESTAMP - 7 * INTERVAL '1' DAY)",
+		},
+		{
+			"date_diff in comparison", DialectSnowflake,
+			`{">=": [{"date_diff": [{"now": []}, {"var": "created_at"}, "month"]}, 3]}`,
+			"WHERE DATEDIFF(month, created_at, CURRENT_TIMESTAMP()) >= 3",
+		},
+		{
+			"nested date operators", DialectBigQuery,
+			`{"==": [{"date_trunc": [{"var": "signup_date"}, "month"]}, {"date_trunc": [{"date_sub": ["2024-03-15", 1, "month"]}, "month"]}]}`,
+			"WHERE DATE_TRUNC(signup_date, MONTH) = DATE_TRUNC(DATE_SUB(DATE '2024-03-15', INTERVAL 1 MONTH), MONTH)",
+		},
+		{
+			"interval from a field", DialectMySQL,
+			`{"<": [{"date_add": [{"var": "signup_date"}, {"var": "trial_days"}, "day"]}, {"now": []}]}`,
+			"WHERE DATE_ADD(signup_date, INTERVAL trial_days DAY) < CURRENT_TIMESTAMP",
+		},
+		{
+			"within logical operators", DialectSQLServer,
+			`{"and": [{"within_last": [{"var": "created_at"}, 2, "hour"]}, {"!": {"within_last": [{"var": "signup_date"}, 1, "year"]}}]}`,
+			"WHERE ((created_at >= DATEADD(hour, -2, CURRENT_TIMESTAMP) AND created_at <= CURRENT_TIMESTAMP) AND " +
+				"NOT ((signup_date >= DATEADD(year, -1, CAST(GETDATE() AS DATE)) AND signup_date <= CAST(GETDATE() AS DATE))))",
+		},
+		{
+			"date arithmetic against a date literal", DialectPostgreSQL,
+			`{"<": [{"date_sub": [{"now": []}, 30, "day"]}, "2024-01-01"]}`,
+			"WHERE (CURRENT_TIMESTAMP - 30 * INTERVAL '1' DAY) < TIMESTAMP '2024-01-01 00:00:00'",
+		},
+		{
+			"date literal on the left", DialectSQLServer,
+			`{"==": ["2024-01-01", {"date_trunc": [{"var": "signup_date"}, "month"]}]}`,
+			"WHERE CAST('2024-01-01' AS DATE) = DATETRUNC(month, signup_date)",
+		},
+		{
+			"date arithmetic between date literals", DialectBigQuery,
+			`{"<=": ["2024-01-01", {"date_add": [{"var": "signup_date"}, 7, "day"]}, "2024-12-31"]}`,
+			"WHERE (DATE '2024-01-01' <= DATE_ADD(signup_date, INTERVAL 7 DAY) AND DATE_ADD(signup_date, INTERVAL 7 DAY) <= DATE '2024-12-31')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := NewTranspilerWithConfig(&TranspilerConfig{Dialect: tt.dialect, Schema: schema})
+			if err != nil {
+				t.Fatalf("NewTranspilerWithConfig() error = %v", err)
+			}
+			got, err := tr.Transpile(tt.rule)
+			if err != nil {
+				t.Fatalf("Transpile() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transpile() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("parameterized", func(t *testing.T) {
+		tr, _ := NewTranspiler(DialectPostgreSQL)
+		sql, args, err := tr.TranspileParameterized(`{"and": [{"==": [{"var": "status"}, "active"]}, {"within_last": [{"var": "created_at"}, 30]}]}`)
+		if err != nil {
+			t.Fatalf("TranspileParameterized() error: %v", err)
+		}
+		want := "WHERE (status = $1 AND (created_at >= (CURRENT_TIMESTAMP - $2 * INTERVAL '1' DAY) AND created_at <= CURRENT_TIMESTAMP))"
+		if sql != want {
+			t.Errorf("sql = %q, want %q", sql, want)
+		}
+		if !reflect.DeepEqual(args, []any{"active", int64(30)}) {
+			t.Errorf("args = %#v, want %#v", args, []any{"active", int64(30)})
+		}
+	})
+
+	t.Run("parameterized date literal", func(t *testing.T) {
+		tr, _ := NewTranspiler(DialectMySQL)
+		sql, args, err := tr.TranspileParameterized(`{"<": [{"date_sub": [{"now": []}, 30, "day"]}, "2024-01-01"]}`)
+		if err != nil {
+			t.Fatalf("TranspileParameterized() error: %v", err)
+		}
+		if want := "WHERE DATE_SUB(CURRENT_TIMESTAMP, INTERVAL ? DAY) < CAST(? AS DATETIME)"; sql != want {
+			t.Errorf("sql = %q, want %q", sql, want)
+		}
+		if !reflect.DeepEqual(args, []any{int64(30), "2024-01-01 00:00:00"}) {
+			t.Errorf("args = %#v, want %#v", args, []any{int64(30), "2024-01-01 00:00:00"})
+		}
+	})
+
+	t.Run("custom operator takes precedence", func(t *testing.T) {
+		tr, _ := NewTranspiler(DialectPostgreSQL)
+		err := tr.RegisterOperatorFunc("now", func(_ string, _ []interface{}) (string, error) {
+			return "app_now()", nil
+		})
+		if err != nil {
+			t.Fatalf("RegisterOperatorFunc() error: %v", err)
+		}
+		sql, err := tr.Transpile(`{"<": [{"var": "expires_at"}, {"now": []}]}`)
+		if err != nil {
+			t.Fatalf("Transpile() error: %v", err)
+		}
+		if want := "WHERE expires_at < app_now()"; sql != want {
+			t.Errorf("Transpile() = %q, want %q", sql, want)
+		}
+	})
+
+	t.Run("invalid unit", func(t *testing.T) {
+		tr, _ := NewTranspiler(DialectPostgreSQL)
+		if _, err := tr.Transpile(`{"within_last": [{"var": "created_at"}, 30, "days"]}`); err == nil {
+			t.Error("expected error for unsupported date unit")
+		}
+	})
+}